@@ -40,9 +40,29 @@ func ValidatePrefix(message, key []byte, signature string) bool {
 	}
 }
 
-func validate(h func() hash.Hash, message, key, signature []byte) bool {
+// Sign returns the hex encoded hmac signature of the
+// message.
+func Sign(h func() hash.Hash, message, key []byte) string {
+	return hex.EncodeToString(sign(h, message, key))
+}
+
+// SignPrefix returns the hex encoded hmac signature of the
+// message prefixed with the signing algorithm, eg sha256=.
+// The algorithm must be sha1 or sha256.
+func SignPrefix(algorithm string, message, key []byte) string {
+	h := sha256.New
+	if algorithm == "sha1" {
+		h = sha1.New
+	}
+	return algorithm + "=" + Sign(h, message, key)
+}
+
+func sign(h func() hash.Hash, message, key []byte) []byte {
 	mac := hmac.New(h, key)
 	mac.Write(message) // #nosec
-	sum := mac.Sum(nil)
-	return hmac.Equal(signature, sum)
+	return mac.Sum(nil)
+}
+
+func validate(h func() hash.Hash, message, key, signature []byte) bool {
+	return hmac.Equal(signature, sign(h, message, key))
 }
@@ -166,3 +166,21 @@ func TestValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestSign(t *testing.T) {
+	msg, key := []byte("bonjour monde"), []byte("topsecret")
+
+	want := "sha256=8ca57e2afbad9fea8860404575c2d61827995c62aacd4c514eae4c404896390b"
+	if got := SignPrefix("sha256", msg, key); got != want {
+		t.Errorf("Want signature %s, got %s", want, got)
+	}
+	for _, algorithm := range []string{"sha1", "sha256"} {
+		sig := SignPrefix(algorithm, msg, key)
+		if !ValidatePrefix(msg, key, sig) {
+			t.Errorf("Expected %s signature %s to validate", algorithm, sig)
+		}
+	}
+	if sig := Sign(sha1.New, msg, key); !Validate(sha1.New, msg, key, sig) {
+		t.Errorf("Expected signature %s to validate", sig)
+	}
+}
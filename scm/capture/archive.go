@@ -0,0 +1,184 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package capture records raw webhook deliveries to disk so
+// they can be inspected and replayed against a handler or a
+// driver webhook parser.
+package capture
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned when a delivery is not archived.
+var ErrNotFound = errors.New("Delivery not found")
+
+// Delivery is a raw webhook request.
+type Delivery struct {
+	ID       string      `json:"id"`
+	Received time.Time   `json:"received"`
+	Method   string      `json:"method"`
+	URL      string      `json:"url"`
+	Header   http.Header `json:"header"`
+	Body     []byte      `json:"body"`
+
+	// Truncated is true if the body exceeded MaxBodySize
+	// and only the first MaxBodySize bytes were archived.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Request reconstructs the http request of the delivery.
+func (d *Delivery) Request() (*http.Request, error) {
+	method := d.Method
+	if method == "" {
+		method = "POST"
+	}
+	req, err := http.NewRequest(method, d.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range d.Header {
+		req.Header[k] = append([]string(nil), v...)
+	}
+	return req, nil
+}
+
+// WithSecret returns a copy of the delivery with the redacted
+// GitLab token and secret query parameters set to the secret.
+func (d *Delivery) WithSecret(secret string) (*Delivery, error) {
+	out := *d
+	out.Header = d.Header.Clone()
+	if out.Header.Get("X-Gitlab-Token") == Redacted {
+		out.Header.Set("X-Gitlab-Token", secret)
+	}
+	u, err := url.Parse(d.URL)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	for _, k := range DefaultRedactQuery {
+		if query.Get(k) == Redacted {
+			query.Set(k, secret)
+			u.RawQuery = query.Encode()
+		}
+	}
+	out.URL = u.String()
+	return &out, nil
+}
+
+// Archive stores deliveries as json files in a directory.
+type Archive struct {
+	dir string
+}
+
+// NewArchive returns an Archive that stores deliveries in the
+// given directory.
+func NewArchive(dir string) *Archive {
+	return &Archive{dir: dir}
+}
+
+// Save writes the delivery to the archive.
+func (a *Archive) Save(d *Delivery) error {
+	if d.ID == "" || strings.ContainsAny(d.ID, `/\`) {
+		return fmt.Errorf("Invalid delivery id %q", d.ID)
+	}
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(a.dir, 0700); err != nil {
+		return err
+	}
+	// write to a temporary file first so a crash cannot
+	// leave a partially written delivery behind.
+	tmp, err := ioutil.TempFile(a.dir, ".delivery")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	name := fmt.Sprintf("%d-%s.json", d.Received.UnixNano(), d.ID)
+	return os.Rename(tmp.Name(), filepath.Join(a.dir, name))
+}
+
+// List returns the archived deliveries, oldest first.
+func (a *Archive) List() ([]*Delivery, error) {
+	files, err := a.files("*")
+	if err != nil {
+		return nil, err
+	}
+	var out []*Delivery
+	for _, file := range files {
+		d, err := a.read(file)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, d)
+	}
+	return out, nil
+}
+
+// Find returns the archived delivery with the given id.
+func (a *Archive) Find(id string) (*Delivery, error) {
+	if strings.ContainsAny(id, `/\*?[`) {
+		return nil, ErrNotFound
+	}
+	files, err := a.files(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, ErrNotFound
+	}
+	return a.read(files[0])
+}
+
+// helper function returns the archive files of the deliveries
+// matching the id pattern, sorted by the time received.
+func (a *Archive) files(id string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(a.dir, "*-"+id+".json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return received(files[i]) < received(files[j])
+	})
+	return files, nil
+}
+
+func (a *Archive) read(file string) (*Delivery, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	out := new(Delivery)
+	err = json.Unmarshal(data, out)
+	return out, err
+}
+
+// helper function returns the received timestamp prefix of
+// the archive file name.
+func received(file string) int64 {
+	var ts int64
+	fmt.Sscanf(filepath.Base(file), "%d-", &ts) // #nosec
+	return ts
+}
@@ -0,0 +1,135 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package capture
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// MaxBodySize is the maximum number of body bytes archived,
+// matching the limit applied by the driver webhook parsers.
+const MaxBodySize = 10000000
+
+// Redacted replaces the values of redacted headers.
+const Redacted = "REDACTED"
+
+// DefaultRedact are the headers that are always redacted.
+// Signature headers are kept so replayed deliveries can be
+// verified with the original secret. The GitLab token is the
+// secret itself, so it is redacted and must be restored with
+// Delivery.WithSecret before a replay is verified.
+var DefaultRedact = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"X-Gitlab-Token",
+}
+
+// DefaultRedactQuery are the query parameters that are always
+// redacted. Bitbucket Cloud and Gitea hook URLs carry the
+// plaintext secret as a query parameter.
+var DefaultRedactQuery = []string{
+	"secret",
+}
+
+// Handler returns middleware that archives every request
+// before passing it to the next handler. The values of the
+// default and the given headers and query parameters are
+// redacted. Archive errors do not affect the delivery to the
+// next handler.
+func Handler(archive *Archive, next http.Handler, redact ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, MaxBodySize+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		d := &Delivery{
+			ID:       scm.NewDeliveryID(),
+			Received: time.Now().UTC(),
+			Method:   r.Method,
+			URL:      redactURL(r.URL, redact),
+			Header:   redactHeader(r.Header, redact),
+			Body:     body,
+		}
+		if len(body) > MaxBodySize {
+			d.Body = body[:MaxBodySize]
+			d.Truncated = true
+		}
+		archive.Save(d) // #nosec
+
+		// the next handler reads the buffered body followed by
+		// the remainder of the request body, if any.
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Replay sends the delivery to the handler and returns the
+// recorded response. Deliveries authenticated with a GitLab
+// token or a secret query parameter only validate once the
+// secret is restored with Delivery.WithSecret.
+func Replay(d *Delivery, h http.Handler) (*http.Response, error) {
+	req, err := d.Request()
+	if err != nil {
+		return nil, err
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w.Result(), nil
+}
+
+// Parse parses the delivery with the webhook service of a
+// driver, see factory.NewWebHookService. As with Replay, the
+// redacted secrets are not restored.
+func Parse(d *Delivery, service scm.WebhookService, fn scm.SecretFunc) (scm.Webhook, error) {
+	req, err := d.Request()
+	if err != nil {
+		return nil, err
+	}
+	return service.Parse(req, fn)
+}
+
+func redactHeader(header http.Header, redact []string) http.Header {
+	out := header.Clone()
+	for _, keys := range [][]string{DefaultRedact, redact} {
+		for _, k := range keys {
+			if out.Get(k) != "" {
+				out.Set(k, Redacted)
+			}
+		}
+	}
+	return out
+}
+
+func redactURL(u *url.URL, redact []string) string {
+	query := u.Query()
+	changed := false
+	for _, keys := range [][]string{DefaultRedactQuery, redact} {
+		for _, k := range keys {
+			if _, ok := query[k]; ok {
+				query.Set(k, Redacted)
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+	out := *u
+	out.RawQuery = query.Encode()
+	return out.String()
+}
@@ -0,0 +1,217 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package capture
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/bitbucket"
+	"github.com/jenkins-x/go-scm/scm/driver/github"
+	"github.com/jenkins-x/go-scm/scm/driver/gitlab"
+)
+
+func secretFunc(scm.Webhook) (string, error) {
+	return "topsecret", nil
+}
+
+func TestCaptureReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	service := github.NewWebHookService()
+	want := &scm.PushHook{
+		Ref:    "refs/heads/master",
+		Before: "0000000000000000000000000000000000000000",
+		After:  "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		GUID:   "f2467dea-70d6-11e8-8955-3c83993e0aef",
+		Repo:   scm.Repository{Namespace: "octocat", Name: "hello-world", FullName: "octocat/hello-world"},
+		Sender: scm.User{Login: "octocat"},
+	}
+	req, err := service.(scm.WebhookGenerator).Generate(want, "topsecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "token 12345")
+
+	var got scm.Webhook
+	archive := NewArchive(dir)
+	h := Handler(archive, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, err = service.Parse(r, secretFunc)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}), "X-Custom")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Want status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	deliveries, err := archive.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("Want 1 archived delivery, got %d", len(deliveries))
+	}
+	d, err := archive.Find(deliveries[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.Header.Get("Authorization"), Redacted; got != want {
+		t.Errorf("Want Authorization header %q, got %q", want, got)
+	}
+	if got := d.Header.Get("X-Hub-Signature-256"); got == "" || got == Redacted {
+		t.Errorf("Want signature header archived, got %q", got)
+	}
+
+	replayed, err := Parse(d, service, secretFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, replayed); diff != "" {
+		t.Errorf("Replayed webhook does not match the delivered webhook")
+		t.Log(diff)
+	}
+
+	res, err := Replay(d, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("Want replay status 200, got %d", res.StatusCode)
+	}
+	if deliveries, _ = archive.List(); len(deliveries) != 2 {
+		t.Errorf("Want the replay archived, got %d deliveries", len(deliveries))
+	}
+}
+
+func TestCaptureSecrets(t *testing.T) {
+	tests := []struct {
+		name    string
+		service scm.WebhookService
+	}{
+		{"gitlab", gitlab.NewWebHookService()},
+		{"bitbucket", bitbucket.NewWebHookService()},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "capture")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			hook := &scm.PushHook{
+				Ref:   "refs/heads/master",
+				After: "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+				Repo:  scm.Repository{Namespace: "octocat", Name: "hello-world", FullName: "octocat/hello-world"},
+			}
+			req, err := test.service.(scm.WebhookGenerator).Generate(hook, "topsecret")
+			if err != nil {
+				t.Fatal(err)
+			}
+			archive := NewArchive(dir)
+			Handler(archive, http.NotFoundHandler()).ServeHTTP(httptest.NewRecorder(), req)
+
+			deliveries, err := archive.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := deliveries[0]
+			if strings.Contains(d.URL, "topsecret") || d.Header.Get("X-Gitlab-Token") == "topsecret" {
+				t.Errorf("Want the secret redacted, got %s %v", d.URL, d.Header)
+			}
+			if _, err := Parse(d, test.service, secretFunc); err == nil {
+				t.Errorf("Want the redacted delivery to fail validation")
+			}
+
+			d, err = d.WithSecret("topsecret")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Parse(d, test.service, secretFunc); err != nil {
+				t.Errorf("Want the restored delivery to validate, got %s", err)
+			}
+		})
+	}
+}
+
+func TestCaptureRedactQuery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	archive := NewArchive(dir)
+	h := Handler(archive, http.NotFoundHandler(), "token")
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/hook?secret=a&token=b&id=1", nil))
+
+	deliveries, err := archive.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := deliveries[0].URL, "/hook?id=1&secret=REDACTED&token=REDACTED"; got != want {
+		t.Errorf("Want url %q, got %q", want, got)
+	}
+}
+
+func TestCaptureTruncated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	body := bytes.Repeat([]byte("a"), MaxBodySize+10)
+	var read int
+	archive := NewArchive(dir)
+	h := Handler(archive, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		read = len(data)
+	}))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/", bytes.NewReader(body)))
+
+	if read != len(body) {
+		t.Errorf("Want next handler to read %d bytes, got %d", len(body), read)
+	}
+	deliveries, err := archive.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("Want 1 archived delivery, got %d", len(deliveries))
+	}
+	if d := deliveries[0]; !d.Truncated || len(d.Body) != MaxBodySize {
+		t.Errorf("Want truncated body of %d bytes, got %d, truncated %v", MaxBodySize, len(d.Body), d.Truncated)
+	}
+}
+
+func TestArchiveNotFound(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := NewArchive(dir).Find("unknown"); err != ErrNotFound {
+		t.Errorf("Want ErrNotFound, got %v", err)
+	}
+	if _, err := NewArchive(dir).Find("*"); err != ErrNotFound {
+		t.Errorf("Want ErrNotFound for a pattern, got %v", err)
+	}
+}
@@ -0,0 +1,426 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrChecksDegraded is returned when drivers without native
+// checks support create or update a check run, once it has
+// been reported as a commit status, and a summary comment if
+// the input names a pull request. The returned check run is
+// still valid.
+var ErrChecksDegraded = errors.New("Checks not supported, reported as a commit status")
+
+type (
+	// CheckRun represents a single check run against a
+	// commit, eg a GitHub check run.
+	CheckRun struct {
+		ID           int64
+		Name         string
+		HeadSha      string
+		ExternalID   string
+		Status       string
+		Conclusion   string
+		Link         string
+		DetailsURL   string
+		Output       CheckRunOutput
+		App          App
+		CheckSuite   *CheckSuite
+		PullRequests []*PullRequest
+		Started      time.Time
+		Completed    time.Time
+	}
+
+	// CheckRunOutput represents the output reported by a
+	// check run.
+	CheckRunOutput struct {
+		Title            string
+		Summary          string
+		Text             string
+		AnnotationsCount int
+		AnnotationsURL   string
+	}
+
+	// CheckSuite represents a collection of check runs
+	// created by a single app for a commit.
+	CheckSuite struct {
+		ID           int64
+		HeadBranch   string
+		HeadSha      string
+		Status       string
+		Conclusion   string
+		Before       string
+		After        string
+		HeadCommit   *Commit
+		App          App
+		PullRequests []*PullRequest
+		Created      time.Time
+		Updated      time.Time
+	}
+
+	// CheckRunAnnotation represents a line level annotation
+	// reported by a check run. The level is one of notice,
+	// warning or failure.
+	CheckRunAnnotation struct {
+		Path        string
+		StartLine   int
+		EndLine     int
+		StartColumn int
+		EndColumn   int
+		Level       string
+		Title       string
+		Message     string
+		RawDetails  string
+	}
+
+	// CheckRunAction represents a button that requests an
+	// additional task from the app that created the check run.
+	CheckRunAction struct {
+		Label       string
+		Description string
+		Identifier  string
+	}
+
+	// CheckRunInput provides the input fields required for
+	// creating or updating a check run. The status is one of
+	// queued, in_progress or completed, and the conclusion is
+	// required once the check run is completed.
+	CheckRunInput struct {
+		Name       string
+		HeadSha    string
+		ExternalID string
+		DetailsURL string
+		Status     string
+		Conclusion string
+		Started    time.Time
+		Completed  time.Time
+		Output     *CheckRunOutputInput
+		Actions    []*CheckRunAction
+
+		// PullRequest is the pull request number the summary
+		// is commented on by drivers without native checks.
+		PullRequest int
+	}
+
+	// CheckRunOutputInput provides the output of a check run.
+	CheckRunOutputInput struct {
+		Title       string
+		Summary     string
+		Text        string
+		Annotations []*CheckRunAnnotation
+	}
+
+	// CheckRunListOptions provides options for querying a
+	// list of check runs.
+	CheckRunListOptions struct {
+		Name   string
+		Status string
+		Page   int
+		Size   int
+	}
+
+	// ChecksService provides access to check runs and check
+	// suites. Drivers without native checks support report
+	// check runs as commit statuses and return
+	// ErrChecksDegraded when writing them.
+	ChecksService interface {
+		// CreateCheckRun creates a check run. Annotations
+		// above the provider limit per request are sent in
+		// batches.
+		CreateCheckRun(ctx context.Context, repo string, input *CheckRunInput) (*CheckRun, *Response, error)
+
+		// UpdateCheckRun updates a check run. Drivers without
+		// native checks require the name and head sha.
+		UpdateCheckRun(ctx context.Context, repo string, id int64, input *CheckRunInput) (*CheckRun, *Response, error)
+
+		// FindCheckRun returns a check run by id.
+		FindCheckRun(ctx context.Context, repo string, id int64) (*CheckRun, *Response, error)
+
+		// ListCheckRuns returns the check runs for a ref.
+		ListCheckRuns(ctx context.Context, repo, ref string, opts CheckRunListOptions) ([]*CheckRun, *Response, error)
+
+		// ListCheckSuites returns the check suites for a ref.
+		ListCheckSuites(ctx context.Context, repo, ref string, opts ListOptions) ([]*CheckSuite, *Response, error)
+
+		// ListAnnotations returns the annotations of a check run.
+		ListAnnotations(ctx context.Context, repo string, id int64, opts ListOptions) ([]*CheckRunAnnotation, *Response, error)
+
+		// RerequestCheckRun requests the app to run the check
+		// run again.
+		RerequestCheckRun(ctx context.Context, repo string, id int64) (*Response, error)
+
+		// RerequestCheckSuite requests the app to run the check
+		// suite again.
+		RerequestCheckSuite(ctx context.Context, repo string, id int64) (*Response, error)
+	}
+
+	// App represents the integration that reported a
+	// check, eg a GitHub App.
+	App struct {
+		ID          int64
+		Slug        string
+		Name        string
+		Description string
+		Link        string
+		Owner       User
+	}
+)
+
+// NewStatusChecksService returns a ChecksService for drivers
+// without native checks support. Check runs are reported as
+// commit statuses, labeled with the check run name, and the
+// output of completed check runs is commented on the pull
+// request named in the input, editing the comment of an
+// earlier update of the check run.
+func NewStatusChecksService(client *Client) ChecksService {
+	return &statusChecksService{client}
+}
+
+type statusChecksService struct {
+	client *Client
+}
+
+func (s *statusChecksService) CreateCheckRun(ctx context.Context, repo string, input *CheckRunInput) (*CheckRun, *Response, error) {
+	if input.Name == "" || input.HeadSha == "" {
+		return nil, nil, errors.New("Check run name and head sha are required")
+	}
+	status := &StatusInput{
+		State:  convertCheckRunState(input.Status, input.Conclusion),
+		Label:  input.Name,
+		Desc:   checkRunDescription(input.Output),
+		Target: input.DetailsURL,
+	}
+	_, res, err := s.client.Repositories.CreateStatus(ctx, repo, input.HeadSha, status)
+	if err != nil {
+		return nil, res, err
+	}
+	if input.Status == "completed" && input.Output != nil && input.PullRequest != 0 {
+		res, err = s.commentSummary(ctx, repo, input)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	run := &CheckRun{
+		Name:       input.Name,
+		HeadSha:    input.HeadSha,
+		ExternalID: input.ExternalID,
+		Status:     input.Status,
+		Conclusion: input.Conclusion,
+		DetailsURL: input.DetailsURL,
+		Started:    input.Started,
+		Completed:  input.Completed,
+	}
+	if input.Output != nil {
+		run.Output = CheckRunOutput{
+			Title:            input.Output.Title,
+			Summary:          input.Output.Summary,
+			Text:             input.Output.Text,
+			AnnotationsCount: len(input.Output.Annotations),
+		}
+	}
+	return run, res, ErrChecksDegraded
+}
+
+func (s *statusChecksService) UpdateCheckRun(ctx context.Context, repo string, id int64, input *CheckRunInput) (*CheckRun, *Response, error) {
+	// commit statuses are replaced by creating a status
+	// with the same label.
+	return s.CreateCheckRun(ctx, repo, input)
+}
+
+func (s *statusChecksService) FindCheckRun(ctx context.Context, repo string, id int64) (*CheckRun, *Response, error) {
+	return nil, nil, ErrNotSupported
+}
+
+func (s *statusChecksService) ListCheckRuns(ctx context.Context, repo, ref string, opts CheckRunListOptions) ([]*CheckRun, *Response, error) {
+	statuses, res, err := s.client.Repositories.ListStatus(ctx, repo, ref, ListOptions{Page: opts.Page, Size: opts.Size})
+	if err != nil {
+		return nil, res, err
+	}
+	var runs []*CheckRun
+	for _, status := range statuses {
+		run := &CheckRun{
+			Name:       status.Label,
+			HeadSha:    ref,
+			DetailsURL: status.Target,
+			Link:       status.Link,
+			Output:     CheckRunOutput{Title: status.Desc},
+		}
+		run.Status, run.Conclusion = convertStatusState(status.State)
+		if opts.Name != "" && opts.Name != run.Name {
+			continue
+		}
+		if opts.Status != "" && opts.Status != run.Status {
+			continue
+		}
+		runs = append(runs, run)
+	}
+	return runs, res, nil
+}
+
+// helper function comments the check run summary on the pull
+// request, editing the summary of an earlier update of the
+// check run if there is one. If the driver cannot edit
+// comments the summary is only commented once.
+func (s *statusChecksService) commentSummary(ctx context.Context, repo string, input *CheckRunInput) (*Response, error) {
+	body := checkRunSummary(input)
+	comment, res, err := s.findSummary(ctx, repo, input.PullRequest, checkRunMarker(input.Name))
+	if err != nil {
+		return res, err
+	}
+	if comment == nil {
+		_, res, err = s.client.PullRequests.CreateComment(ctx, repo, input.PullRequest, &CommentInput{Body: body})
+		return res, err
+	}
+	if comment.Body == body {
+		return res, nil
+	}
+	_, res, err = s.client.PullRequests.EditComment(ctx, repo, input.PullRequest, comment.ID, &CommentInput{Body: body})
+	if err == ErrNotSupported {
+		return res, nil
+	}
+	return res, err
+}
+
+// helper function returns the pull request comment with the
+// check run marker, or nil if the summary was not commented.
+func (s *statusChecksService) findSummary(ctx context.Context, repo string, number int, marker string) (*Comment, *Response, error) {
+	opts := ListOptions{Page: 1, Size: 100}
+	for {
+		comments, res, err := s.client.PullRequests.ListComments(ctx, repo, number, opts)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, comment := range comments {
+			if strings.Contains(comment.Body, marker) {
+				return comment, res, nil
+			}
+		}
+		if res == nil || res.Page.Next == 0 {
+			return nil, res, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+func (s *statusChecksService) ListCheckSuites(ctx context.Context, repo, ref string, opts ListOptions) ([]*CheckSuite, *Response, error) {
+	return nil, nil, ErrNotSupported
+}
+
+func (s *statusChecksService) ListAnnotations(ctx context.Context, repo string, id int64, opts ListOptions) ([]*CheckRunAnnotation, *Response, error) {
+	return nil, nil, ErrNotSupported
+}
+
+func (s *statusChecksService) RerequestCheckRun(ctx context.Context, repo string, id int64) (*Response, error) {
+	return nil, ErrNotSupported
+}
+
+func (s *statusChecksService) RerequestCheckSuite(ctx context.Context, repo string, id int64) (*Response, error) {
+	return nil, ErrNotSupported
+}
+
+// helper function returns the commit status state of a
+// check run status and conclusion.
+func convertCheckRunState(status, conclusion string) State {
+	switch status {
+	case "queued", "":
+		return StatePending
+	case "in_progress":
+		return StateRunning
+	}
+	switch conclusion {
+	case "success", "neutral", "skipped":
+		return StateSuccess
+	case "cancelled":
+		return StateCanceled
+	case "failure", "timed_out", "action_required":
+		return StateFailure
+	default:
+		return StateError
+	}
+}
+
+// helper function returns the check run status and
+// conclusion of a commit status state.
+func convertStatusState(state State) (string, string) {
+	switch state {
+	case StateRunning:
+		return "in_progress", ""
+	case StateSuccess:
+		return "completed", "success"
+	case StateFailure, StateError:
+		return "completed", "failure"
+	case StateCanceled:
+		return "completed", "cancelled"
+	default:
+		return "queued", ""
+	}
+}
+
+// maxStatusDescription is the length most providers accept
+// for a commit status description.
+const maxStatusDescription = 140
+
+// helper function returns the commit status description of
+// the check run output.
+func checkRunDescription(output *CheckRunOutputInput) string {
+	if output == nil {
+		return ""
+	}
+	desc := output.Title
+	if desc == "" {
+		desc = strings.SplitN(strings.TrimSpace(output.Summary), "\n", 2)[0]
+	}
+	if r := []rune(desc); len(r) > maxStatusDescription {
+		desc = string(r[:maxStatusDescription-3]) + "..."
+	}
+	return desc
+}
+
+// maxSummaryAnnotations is the number of annotations listed
+// in a summary comment.
+const maxSummaryAnnotations = 50
+
+// helper function renders the check run output as a markdown
+// comment.
+func checkRunSummary(input *CheckRunInput) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s**: %s\n", input.Name, input.Conclusion)
+	if input.Output.Title != "" {
+		fmt.Fprintf(&b, "\n### %s\n", input.Output.Title)
+	}
+	if input.Output.Summary != "" {
+		fmt.Fprintf(&b, "\n%s\n", input.Output.Summary)
+	}
+	if input.Output.Text != "" {
+		fmt.Fprintf(&b, "\n%s\n", input.Output.Text)
+	}
+	if len(input.Output.Annotations) != 0 {
+		b.WriteString("\n")
+	}
+	for i, a := range input.Output.Annotations {
+		if i == maxSummaryAnnotations {
+			fmt.Fprintf(&b, "- and %d more\n", len(input.Output.Annotations)-i)
+			break
+		}
+		fmt.Fprintf(&b, "- **%s** `%s:%d`: %s\n", a.Level, a.Path, a.StartLine, a.Message)
+	}
+	if input.DetailsURL != "" {
+		fmt.Fprintf(&b, "\n[Details](%s)\n", input.DetailsURL)
+	}
+	fmt.Fprintf(&b, "\n%s\n", checkRunMarker(input.Name))
+	return b.String()
+}
+
+// helper function returns the hidden marker that identifies
+// the summary comment of the named check run.
+func checkRunMarker(name string) string {
+	return fmt.Sprintf("<!-- check run: %s -->", name)
+}
@@ -105,22 +105,29 @@ type (
 		Username string
 
 		// Services used for communicating with the API.
-		Driver        Driver
-		Apps          AppService
-		Contents      ContentService
-		Deployments   DeploymentService
-		Git           GitService
-		GraphQL       GraphQLService
-		Organizations OrganizationService
-		Issues        IssueService
-		Milestones    MilestoneService
-		Releases      ReleaseService
-		PullRequests  PullRequestService
-		Repositories  RepositoryService
-		Reviews       ReviewService
-		Users         UserService
-		Webhooks      WebhookService
-		Commits       CommitService
+		Driver            Driver
+		Apps              AppService
+		BranchProtections BranchProtectionService
+		Checks            ChecksService
+		Contents          ContentService
+		Deployments       DeploymentService
+		DeployKeys        DeployKeyService
+		Git               GitService
+		GraphQL           GraphQLService
+		Organizations     OrganizationService
+		Issues            IssueService
+		Milestones        MilestoneService
+		Releases          ReleaseService
+		Pipelines         PipelineService
+		PullRequests      PullRequestService
+		Repositories      RepositoryService
+		Reviews           ReviewService
+		Secrets           SecretService
+		SystemHooks       SystemHookService
+		Users             UserService
+		Webhooks          WebhookService
+		Deliveries        WebhookDeliveryService
+		Commits           CommitService
 
 		// DumpResponse optionally specifies a function to
 		// dump the the response body for debugging purposes.
@@ -232,6 +239,13 @@ func (r *Response) PopulatePageValues() {
 
 		page := url.Query().Get("page")
 		if page == "" {
+			// cursor based pagination links have no page
+			// number so the next link is returned as is.
+			for _, segment := range segments[1:] {
+				if strings.TrimSpace(segment) == `rel="next"` {
+					r.Page.NextURL = url.String()
+				}
+			}
 			continue
 		}
 
@@ -0,0 +1,236 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cloudevents converts scm webhooks to and from
+// CloudEvents 1.0 events, in the structured and binary HTTP
+// content modes.
+package cloudevents
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// SpecVersion is the supported CloudEvents version.
+const SpecVersion = "1.0"
+
+// TypePrefix is the prefix of the type of webhook events.
+// The type is followed by the webhook kind and, if known,
+// the action, eg io.jenkins-x.scm.pull_request.opened
+const TypePrefix = "io.jenkins-x.scm."
+
+// Event is a CloudEvents event.
+type Event struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            *time.Time      `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+}
+
+// FromWebhook converts the webhook to an event. The id is the
+// delivery GUID, or a random id if the webhook has no GUID,
+// and the source is the repository link.
+func FromWebhook(hook scm.Webhook) (*Event, error) {
+	data, err := json.Marshal(hook)
+	if err != nil {
+		return nil, err
+	}
+	fields := new(webhookFields)
+	if err := json.Unmarshal(data, fields); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	e := &Event{
+		SpecVersion:     SpecVersion,
+		ID:              fields.GUID,
+		Source:          source(hook.Repository()),
+		Type:            TypePrefix + string(hook.Kind()),
+		Subject:         fields.subject(),
+		Time:            &now,
+		DataContentType: "application/json",
+		Data:            data,
+	}
+	if action := fields.Action.String(); action != "" {
+		e.Type += "." + action
+	}
+	if e.ID == "" {
+		e.ID = scm.NewDeliveryID()
+	}
+	return e, nil
+}
+
+// Kind returns the webhook kind of the event type, or an empty
+// string if the event is not a webhook event.
+func (e *Event) Kind() scm.WebhookKind {
+	if !strings.HasPrefix(e.Type, TypePrefix) {
+		return ""
+	}
+	kind := strings.TrimPrefix(e.Type, TypePrefix)
+	if i := strings.Index(kind, "."); i != -1 {
+		kind = kind[:i]
+	}
+	return scm.WebhookKind(kind)
+}
+
+// Webhook converts the event back to a webhook.
+func (e *Event) Webhook() (scm.Webhook, error) {
+	hook := newWebhook(e.Kind())
+	if hook == nil {
+		return nil, scm.UnknownWebhook{Event: e.Type}
+	}
+	if err := json.Unmarshal(e.Data, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+// Validate returns an error if a required attribute is
+// missing.
+func (e *Event) Validate() error {
+	switch {
+	case e.SpecVersion != SpecVersion:
+		return fmt.Errorf("Unsupported specversion %q", e.SpecVersion)
+	case e.ID == "":
+		return fmt.Errorf("Missing event id")
+	case e.Source == "":
+		return fmt.Errorf("Missing event source")
+	case e.Type == "":
+		return fmt.Errorf("Missing event type")
+	}
+	return nil
+}
+
+// helper function returns the repository link, or the name
+// if the link is unknown.
+func source(repo scm.Repository) string {
+	switch {
+	case repo.Link != "":
+		return repo.Link
+	case repo.FullName != "":
+		return repo.FullName
+	default:
+		return "/"
+	}
+}
+
+// webhookFields are the fields shared by most webhooks.
+type webhookFields struct {
+	Action      scm.Action
+	GUID        string
+	Ref         json.RawMessage
+	PullRequest struct {
+		Number int
+	}
+	Issue struct {
+		Number int
+	}
+}
+
+// helper function returns the pull request or issue number,
+// or the name of the reference.
+func (f *webhookFields) subject() string {
+	switch {
+	case f.PullRequest.Number != 0:
+		return strconv.Itoa(f.PullRequest.Number)
+	case f.Issue.Number != 0:
+		return strconv.Itoa(f.Issue.Number)
+	}
+	var ref string
+	if json.Unmarshal(f.Ref, &ref) == nil {
+		return ref
+	}
+	var reference scm.Reference
+	if json.Unmarshal(f.Ref, &reference) == nil {
+		return reference.Name
+	}
+	return ""
+}
+
+func newWebhook(kind scm.WebhookKind) scm.Webhook {
+	switch kind {
+	case scm.WebhookKindBranch:
+		return new(scm.BranchHook)
+	case scm.WebhookKindCheckRun:
+		return new(scm.CheckRunHook)
+	case scm.WebhookKindCheckSuite:
+		return new(scm.CheckSuiteHook)
+	case scm.WebhookKindCommitComment:
+		return new(scm.CommitCommentHook)
+	case scm.WebhookKindDeploy:
+		return new(scm.DeployHook)
+	case scm.WebhookKindDeploymentStatus:
+		return new(scm.DeploymentStatusHook)
+	case scm.WebhookKindFork:
+		return new(scm.ForkHook)
+	case scm.WebhookKindInstallation:
+		return new(scm.InstallationHook)
+	case scm.WebhookKindInstallationRepository:
+		return new(scm.InstallationRepositoryHook)
+	case scm.WebhookKindIssue:
+		return new(scm.IssueHook)
+	case scm.WebhookKindIssueComment:
+		return new(scm.IssueCommentHook)
+	case scm.WebhookKindJob:
+		return new(scm.JobHook)
+	case scm.WebhookKindLabel:
+		return new(scm.LabelHook)
+	case scm.WebhookKindMember:
+		return new(scm.MemberHook)
+	case scm.WebhookKindMembership:
+		return new(scm.MembershipHook)
+	case scm.WebhookKindMergeGroup:
+		return new(scm.MergeGroupHook)
+	case scm.WebhookKindMilestone:
+		return new(scm.MilestoneHook)
+	case scm.WebhookKindOrganization:
+		return new(scm.OrganizationHook)
+	case scm.WebhookKindPing:
+		return new(scm.PingHook)
+	case scm.WebhookKindPipeline:
+		return new(scm.PipelineHook)
+	case scm.WebhookKindPublic:
+		return new(scm.PublicHook)
+	case scm.WebhookKindPullRequest:
+		return new(scm.PullRequestHook)
+	case scm.WebhookKindPullRequestComment:
+		return new(scm.PullRequestCommentHook)
+	case scm.WebhookKindPush:
+		return new(scm.PushHook)
+	case scm.WebhookKindRelease:
+		return new(scm.ReleaseHook)
+	case scm.WebhookKindRepository:
+		return new(scm.RepositoryHook)
+	case scm.WebhookKindRepositoryDispatch:
+		return new(scm.RepositoryDispatchHook)
+	case scm.WebhookKindReview:
+		return new(scm.ReviewHook)
+	case scm.WebhookKindReviewCommentHook:
+		return new(scm.ReviewCommentHook)
+	case scm.WebhookKindStar:
+		return new(scm.StarHook)
+	case scm.WebhookKindStatus:
+		return new(scm.StatusHook)
+	case scm.WebhookKindTag:
+		return new(scm.TagHook)
+	case scm.WebhookKindTeam:
+		return new(scm.TeamHook)
+	case scm.WebhookKindWatch:
+		return new(scm.WatchHook)
+	case scm.WebhookKindWorkflowJob:
+		return new(scm.WorkflowJobHook)
+	case scm.WebhookKindWorkflowRun:
+		return new(scm.WorkflowRunHook)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cloudevents
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+func TestFromWebhook(t *testing.T) {
+	repo := scm.Repository{
+		Namespace: "octocat",
+		Name:      "hello-world",
+		FullName:  "octocat/hello-world",
+		Link:      "https://github.com/octocat/hello-world",
+	}
+	tests := []struct {
+		hook    scm.Webhook
+		typ     string
+		subject string
+		id      string
+	}{
+		{
+			hook:    &scm.PushHook{Ref: "refs/heads/master", GUID: "1234", Repo: repo},
+			typ:     "io.jenkins-x.scm.push",
+			subject: "refs/heads/master",
+			id:      "1234",
+		},
+		{
+			hook:    &scm.BranchHook{Action: scm.ActionCreate, Ref: scm.Reference{Name: "feature"}, Repo: repo},
+			typ:     "io.jenkins-x.scm.branch.created",
+			subject: "feature",
+		},
+		{
+			hook:    &scm.PullRequestHook{Action: scm.ActionOpen, PullRequest: scm.PullRequest{Number: 42}, GUID: "5678", Repo: repo},
+			typ:     "io.jenkins-x.scm.pull_request.opened",
+			subject: "42",
+			id:      "5678",
+		},
+		{
+			hook:    &scm.IssueCommentHook{Action: scm.ActionCreate, Issue: scm.Issue{Number: 7}, Repo: repo},
+			typ:     "io.jenkins-x.scm.issue_comment.created",
+			subject: "7",
+		},
+	}
+	for _, test := range tests {
+		e, err := FromWebhook(test.hook)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := e.Type, test.typ; got != want {
+			t.Errorf("Want type %q, got %q", want, got)
+		}
+		if got, want := e.Subject, test.subject; got != want {
+			t.Errorf("Want subject %q, got %q", want, got)
+		}
+		if got, want := e.Source, repo.Link; got != want {
+			t.Errorf("Want source %q, got %q", want, got)
+		}
+		if test.id != "" && e.ID != test.id {
+			t.Errorf("Want id %q, got %q", test.id, e.ID)
+		}
+		if err := e.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		for _, mode := range []Mode{Structured, Binary} {
+			req, err := NewRequest("http://localhost/events", e, mode)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := ReadRequest(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			hook, err := got.Webhook()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.hook, hook); diff != "" {
+				t.Errorf("Event in mode %d does not round trip", mode)
+				t.Log(diff)
+			}
+		}
+	}
+}
+
+// TestWebhookKinds checks every webhook kind converts to an
+// event and back.
+func TestWebhookKinds(t *testing.T) {
+	kinds := []scm.WebhookKind{
+		scm.WebhookKindBranch,
+		scm.WebhookKindCheckRun,
+		scm.WebhookKindCheckSuite,
+		scm.WebhookKindCommitComment,
+		scm.WebhookKindDeploy,
+		scm.WebhookKindDeploymentStatus,
+		scm.WebhookKindFork,
+		scm.WebhookKindInstallation,
+		scm.WebhookKindInstallationRepository,
+		scm.WebhookKindIssue,
+		scm.WebhookKindIssueComment,
+		scm.WebhookKindJob,
+		scm.WebhookKindLabel,
+		scm.WebhookKindMember,
+		scm.WebhookKindMembership,
+		scm.WebhookKindMergeGroup,
+		scm.WebhookKindMilestone,
+		scm.WebhookKindOrganization,
+		scm.WebhookKindPing,
+		scm.WebhookKindPipeline,
+		scm.WebhookKindPublic,
+		scm.WebhookKindPullRequest,
+		scm.WebhookKindPullRequestComment,
+		scm.WebhookKindPush,
+		scm.WebhookKindRelease,
+		scm.WebhookKindRepository,
+		scm.WebhookKindRepositoryDispatch,
+		scm.WebhookKindReview,
+		scm.WebhookKindReviewCommentHook,
+		scm.WebhookKindStar,
+		scm.WebhookKindStatus,
+		scm.WebhookKindTag,
+		scm.WebhookKindTeam,
+		scm.WebhookKindWatch,
+		scm.WebhookKindWorkflowJob,
+		scm.WebhookKindWorkflowRun,
+	}
+	for _, kind := range kinds {
+		hook := newWebhook(kind)
+		if hook == nil {
+			t.Errorf("Kind %s is not supported", kind)
+			continue
+		}
+		if hook.Kind() != kind {
+			t.Errorf("Want kind %s, got %s", kind, hook.Kind())
+		}
+		e, err := FromWebhook(hook)
+		if err != nil {
+			t.Errorf("Kind %s: %s", kind, err)
+			continue
+		}
+		if e.Kind() != kind {
+			t.Errorf("Want event kind %s, got %s", kind, e.Kind())
+		}
+		if _, err := e.Webhook(); err != nil {
+			t.Errorf("Kind %s: %s", kind, err)
+		}
+	}
+}
+
+func TestUnknownEvent(t *testing.T) {
+	e := &Event{SpecVersion: SpecVersion, ID: "1", Source: "/", Type: "com.example.unknown"}
+	if _, err := e.Webhook(); err == nil {
+		t.Errorf("Expect unknown webhook error")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cloudevents
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"time"
+)
+
+// ContentType is the content type of structured mode events.
+const ContentType = "application/cloudevents+json"
+
+// Mode is the HTTP content mode of an event.
+type Mode int
+
+// Mode values.
+const (
+	// Structured mode sends the event as the json body.
+	Structured Mode = iota
+	// Binary mode sends the event attributes as ce- headers
+	// and the data as the body.
+	Binary
+)
+
+// NewRequest returns a POST request to the target sending the
+// event in the given content mode.
+func NewRequest(target string, e *Event, mode Mode) (*http.Request, error) {
+	if mode == Binary {
+		req, err := http.NewRequest("POST", target, bytes.NewReader(e.Data))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("ce-specversion", e.SpecVersion)
+		req.Header.Set("ce-id", e.ID)
+		req.Header.Set("ce-source", e.Source)
+		req.Header.Set("ce-type", e.Type)
+		if e.Subject != "" {
+			req.Header.Set("ce-subject", e.Subject)
+		}
+		if e.Time != nil {
+			req.Header.Set("ce-time", e.Time.Format(time.RFC3339Nano))
+		}
+		if e.DataContentType != "" {
+			req.Header.Set("Content-Type", e.DataContentType)
+		}
+		return req, nil
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", target, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", ContentType)
+	return req, nil
+}
+
+// ReadRequest reads the event from a request in either
+// content mode.
+func ReadRequest(req *http.Request) (*Event, error) {
+	data, err := ioutil.ReadAll(
+		io.LimitReader(req.Body, 10000000),
+	)
+	if err != nil {
+		return nil, err
+	}
+	contentType := req.Header.Get("Content-Type")
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == ContentType {
+		e := new(Event)
+		if err := json.Unmarshal(data, e); err != nil {
+			return nil, err
+		}
+		return e, e.Validate()
+	}
+	e := &Event{
+		SpecVersion:     req.Header.Get("ce-specversion"),
+		ID:              req.Header.Get("ce-id"),
+		Source:          req.Header.Get("ce-source"),
+		Type:            req.Header.Get("ce-type"),
+		Subject:         req.Header.Get("ce-subject"),
+		DataContentType: contentType,
+		Data:            data,
+	}
+	if v := req.Header.Get("ce-time"); v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return nil, err
+		}
+		e.Time = &t
+	}
+	return e, e.Validate()
+}
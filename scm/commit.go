@@ -43,7 +43,7 @@ type (
 		TargetURL   string
 		Description string
 		Coverage    float64
-		PipelineID  *int
+		PipelineID  *int // GitLab only, see PipelineService
 	}
 )
 
@@ -113,6 +113,27 @@ const (
 
 	// check run / check suite
 	ActionCompleted
+
+	// repositories
+	ActionRenamed
+
+	// workflow runs / jobs
+	ActionRequested
+	ActionQueued
+	ActionInProgress
+	ActionWaiting
+
+	// merge groups
+	ActionChecksRequested
+	ActionDestroyed
+
+	// members / teams
+	ActionAdded
+	ActionRemoved
+	ActionInvited
+
+	// pull request reviewers
+	ActionReviewersUpdated
 )
 
 // String returns the string representation of Action.
@@ -143,7 +164,7 @@ func (a Action) String() (s string) {
 	case ActionSubmitted:
 		return "submitted"
 	case ActionDismissed:
-		return "dismisssed"
+		return "dismissed"
 	case ActionAssigned:
 		return "assigned"
 	case ActionUnassigned:
@@ -158,6 +179,28 @@ func (a Action) String() (s string) {
 		return "converted_to_draft"
 	case ActionCompleted:
 		return "completed"
+	case ActionRenamed:
+		return "renamed"
+	case ActionRequested:
+		return "requested"
+	case ActionQueued:
+		return "queued"
+	case ActionInProgress:
+		return "in_progress"
+	case ActionWaiting:
+		return "waiting"
+	case ActionChecksRequested:
+		return "checks_requested"
+	case ActionDestroyed:
+		return "destroyed"
+	case ActionAdded:
+		return "added"
+	case ActionRemoved:
+		return "removed"
+	case ActionInvited:
+		return "invited"
+	case ActionReviewersUpdated:
+		return "reviewers_updated"
 	default:
 		return
 	}
@@ -195,8 +238,38 @@ func (a *Action) UnmarshalJSON(data []byte) error {
 		*a = ActionSync
 	case "merged":
 		*a = ActionMerge
+	case "assigned":
+		*a = ActionAssigned
+	case "unassigned":
+		*a = ActionUnassigned
+	case "review_requested":
+		*a = ActionReviewRequested
+	case "review_request_removed":
+		*a = ActionReviewRequestRemoved
 	case "completed":
 		*a = ActionCompleted
+	case "renamed":
+		*a = ActionRenamed
+	case "requested":
+		*a = ActionRequested
+	case "queued":
+		*a = ActionQueued
+	case "in_progress":
+		*a = ActionInProgress
+	case "waiting":
+		*a = ActionWaiting
+	case "checks_requested":
+		*a = ActionChecksRequested
+	case "destroyed":
+		*a = ActionDestroyed
+	case "added":
+		*a = ActionAdded
+	case "removed":
+		*a = ActionRemoved
+	case "invited":
+		*a = ActionInvited
+	case "reviewers_updated":
+		*a = ActionReviewersUpdated
 	case "ready_for_review":
 		*a = ActionReadyForReview
 	case "converted_to_draft":
@@ -251,6 +324,85 @@ func (d Driver) String() (s string) {
 	}
 }
 
+// ReactionContent identifies the emoji of a reaction.
+type ReactionContent int
+
+// ReactionContent values.
+const (
+	ReactionUnknown ReactionContent = iota
+	ReactionPlusOne
+	ReactionMinusOne
+	ReactionLaugh
+	ReactionConfused
+	ReactionHeart
+	ReactionHooray
+	ReactionRocket
+	ReactionEyes
+)
+
+// String returns the string representation of ReactionContent.
+func (r ReactionContent) String() string {
+	switch r {
+	case ReactionPlusOne:
+		return "+1"
+	case ReactionMinusOne:
+		return "-1"
+	case ReactionLaugh:
+		return "laugh"
+	case ReactionConfused:
+		return "confused"
+	case ReactionHeart:
+		return "heart"
+	case ReactionHooray:
+		return "hooray"
+	case ReactionRocket:
+		return "rocket"
+	case ReactionEyes:
+		return "eyes"
+	default:
+		return "unknown"
+	}
+}
+
+// ToReactionContent converts the given text to a reaction content.
+func ToReactionContent(s string) ReactionContent {
+	switch strings.ToLower(s) {
+	case "+1":
+		return ReactionPlusOne
+	case "-1":
+		return ReactionMinusOne
+	case "laugh":
+		return ReactionLaugh
+	case "confused":
+		return ReactionConfused
+	case "heart":
+		return ReactionHeart
+	case "hooray":
+		return ReactionHooray
+	case "rocket":
+		return ReactionRocket
+	case "eyes":
+		return ReactionEyes
+	default:
+		return ReactionUnknown
+	}
+}
+
+// MarshalJSON marshals ReactionContent to JSON
+func (r ReactionContent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON unmarshals JSON to ReactionContent
+func (r *ReactionContent) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*r = ToReactionContent(s)
+	return nil
+}
+
 // SearchTimeFormat is a time.Time format string for ISO8601 which is the
 // format that GitHub requires for times specified as part of a search query.
 const SearchTimeFormat = "2006-01-02T15:04:05Z"
@@ -6,6 +6,21 @@ package scm
 
 import "context"
 
+// File change actions.
+const (
+	// FileCreate adds a new file.
+	FileCreate = "create"
+	// FileUpdate changes the content of a file.
+	FileUpdate = "update"
+	// FileDelete removes a file.
+	FileDelete = "delete"
+	// FileMove renames a file, and changes its content when
+	// data is given.
+	FileMove = "move"
+	// FileChmod changes the executable bit of a file.
+	FileChmod = "chmod"
+)
+
 type (
 	// Content stores the contents of a repository file.
 	Content struct {
@@ -24,6 +39,41 @@ type (
 		Sha     string
 	}
 
+	// FileChange describes the change of a single file in a
+	// commit.
+	FileChange struct {
+		Action string
+		Path   string
+
+		// PreviousPath is the path a moved file is renamed
+		// from.
+		PreviousPath string
+
+		Data []byte
+
+		// Executable sets the executable bit of the file. A
+		// nil value keeps the mode of an existing file, and
+		// creates regular files.
+		Executable *bool
+	}
+
+	// CommitFilesParams provides parameters for committing
+	// several file changes at once.
+	CommitFilesParams struct {
+		// Base is the branch a missing branch is created
+		// from.
+		Base string
+
+		// Branch is fast-forwarded to the commit, or created
+		// from Base when missing. Base is fast-forwarded
+		// when empty.
+		Branch string
+
+		Message string
+		Author  Signature
+		Changes []FileChange
+	}
+
 	// FileEntry returns the details of a file
 	FileEntry struct {
 		Name string
@@ -50,5 +100,9 @@ type (
 
 		// Delete deletes a reository file.
 		Delete(ctx context.Context, repo, path, ref string) (*Response, error)
+
+		// CommitFiles applies the file changes in a single
+		// commit, and returns the commit.
+		CommitFiles(ctx context.Context, repo string, params *CommitFilesParams) (*Commit, *Response, error)
 	}
 )
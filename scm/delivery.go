@@ -0,0 +1,93 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type (
+	// WebhookDelivery represents an attempt by the provider
+	// to deliver a webhook payload.
+	WebhookDelivery struct {
+		ID         string
+		GUID       string
+		Event      string
+		Action     string
+		Status     string
+		StatusCode int
+		Duration   time.Duration
+		Redelivery bool
+		Delivered  time.Time
+
+		// Request and Response are only populated when the
+		// provider returns the delivery details, eg when
+		// calling WebhookDeliveryService.Find on GitHub.
+		Request  *WebhookDeliveryRequest
+		Response *WebhookDeliveryResponse
+	}
+
+	// WebhookDeliveryRequest is the request sent by the
+	// provider for a webhook delivery.
+	WebhookDeliveryRequest struct {
+		URL    string
+		Header http.Header
+		Body   string
+	}
+
+	// WebhookDeliveryResponse is the response received by
+	// the provider for a webhook delivery.
+	WebhookDeliveryResponse struct {
+		Header http.Header
+		Body   string
+	}
+
+	// WebhookDeliveryService provides access to the delivery
+	// history of repository webhooks.
+	WebhookDeliveryService interface {
+		// List returns the recent deliveries for a repository
+		// webhook.
+		List(ctx context.Context, repo, hook string, opts ListOptions) ([]*WebhookDelivery, *Response, error)
+
+		// Find returns a webhook delivery including the
+		// request and response.
+		Find(ctx context.Context, repo, hook, id string) (*WebhookDelivery, *Response, error)
+
+		// Redeliver sends a previous webhook delivery again.
+		Redeliver(ctx context.Context, repo, hook, id string) (*Response, error)
+
+		// Ping triggers a test delivery for a repository
+		// webhook.
+		Ping(ctx context.Context, repo, hook string) (*Response, error)
+	}
+)
+
+// HTTPRequest returns the recorded delivery request as an
+// http.Request that can be passed to WebhookService.Parse.
+// Providers may re-encode the payload when reporting it so
+// the original signature may not validate.
+func (r *WebhookDeliveryRequest) HTTPRequest() (*http.Request, error) {
+	target := r.URL
+	if target == "" {
+		target = "/"
+	}
+	req, err := http.NewRequest("POST", target, bytes.NewBufferString(r.Body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range r.Header {
+		for _, vv := range v {
+			req.Header.Add(k, vv)
+		}
+	}
+	if req.Header.Get("Content-Type") == "" && strings.HasPrefix(strings.TrimSpace(r.Body), "{") {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
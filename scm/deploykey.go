@@ -0,0 +1,50 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// DeployKey represents a repository deploy key.
+	DeployKey struct {
+		ID       string
+		Title    string
+		Key      string
+		ReadOnly bool
+		Link     string
+		Created  time.Time
+	}
+
+	// DeployKeyInput provides the input fields required for
+	// creating a repository deploy key.
+	DeployKeyInput struct {
+		Title    string
+		Key      string
+		ReadOnly bool
+	}
+
+	// DeployKeyService provides access to repository deploy
+	// keys.
+	DeployKeyService interface {
+		// Find returns a repository deploy key by id.
+		Find(ctx context.Context, repo, id string) (*DeployKey, *Response, error)
+
+		// List returns the repository deploy keys.
+		List(ctx context.Context, repo string, opts ListOptions) ([]*DeployKey, *Response, error)
+
+		// Create creates a repository deploy key.
+		Create(ctx context.Context, repo string, input *DeployKeyInput) (*DeployKey, *Response, error)
+
+		// Delete deletes a repository deploy key.
+		Delete(ctx context.Context, repo, id string) (*Response, error)
+
+		// Enable enables an existing deploy key, created on
+		// another repository, on the repository.
+		Enable(ctx context.Context, repo, id string) (*DeployKey, *Response, error)
+	}
+)
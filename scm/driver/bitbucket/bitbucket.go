@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strings"
@@ -36,14 +37,18 @@ func New(uri string) (*scm.Client, error) {
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverBitbucket
+	client.Checks = scm.NewStatusChecksService(client.Client)
 	client.Contents = &contentService{client}
+	client.DeployKeys = &deployKeyService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -71,7 +76,13 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	}
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
-	if in != nil {
+	if f, ok := in.(*form); ok {
+		f.writer.Close()
+		req.Header = map[string][]string{
+			"Content-Type": {f.writer.FormDataContentType()},
+		}
+		req.Body = &f.body
+	} else if in != nil {
 		buf := new(bytes.Buffer)
 		json.NewEncoder(buf).Encode(in) // #nosec
 		req.Header = map[string][]string{
@@ -113,6 +124,23 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// form is a multipart form request body.
+type form struct {
+	body   bytes.Buffer
+	writer *multipart.Writer
+}
+
+func newForm() *form {
+	f := new(form)
+	f.writer = multipart.NewWriter(&f.body)
+	return f
+}
+
+func (f *form) add(name string, value []byte) {
+	w, _ := f.writer.CreateFormField(name)
+	w.Write(value) // #nosec
+}
+
 // pagination represents Bitbucket pagination properties
 // embedded in list responses.
 type pagination struct {
@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"path"
+	"strings"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -41,3 +43,71 @@ func (s *contentService) Update(ctx context.Context, repo, path string, params *
 func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+// CommitFiles posts the file changes to the src endpoint, which
+// commits them together. Bitbucket cannot change the mode of files.
+func (s *contentService) CommitFiles(ctx context.Context, repo string, params *scm.CommitFilesParams) (*scm.Commit, *scm.Response, error) {
+	branch := params.Branch
+	if branch == "" {
+		branch = params.Base
+	}
+	f := newForm()
+	f.add("message", []byte(params.Message))
+	f.add("branch", []byte(branch))
+	if params.Author.Email != "" {
+		f.add("author", []byte(fmt.Sprintf("%s <%s>", params.Author.Name, params.Author.Email)))
+	}
+	// files are read from the parent commit of a new branch.
+	ref := branch
+	if branch != params.Base {
+		_, res, err := s.client.Git.FindBranch(ctx, repo, branch)
+		switch {
+		case res != nil && res.Status == 404:
+			base, res, err := s.client.Git.FindBranch(ctx, repo, params.Base)
+			if err != nil {
+				return nil, res, err
+			}
+			ref = base.Sha
+			f.add("parents", []byte(ref))
+		case err != nil:
+			return nil, res, err
+		}
+	}
+
+	for _, c := range params.Changes {
+		if c.Action == scm.FileChmod || c.Executable != nil {
+			return nil, nil, scm.ErrNotSupported
+		}
+		switch c.Action {
+		case scm.FileDelete:
+			f.add("files", []byte(srcPath(c.Path)))
+		case scm.FileMove:
+			data := c.Data
+			if data == nil {
+				old, res, err := s.Find(ctx, repo, c.PreviousPath, ref)
+				if err != nil {
+					return nil, res, err
+				}
+				data = old.Data
+			}
+			f.add("files", []byte(srcPath(c.PreviousPath)))
+			f.add(srcPath(c.Path), data)
+		default:
+			f.add(srcPath(c.Path), c.Data)
+		}
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%s/src", repo)
+	res, err := s.client.do(ctx, "POST", endpoint, f, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	// the new commit is only returned in the location header.
+	sha := path.Base(res.Header.Get("Location"))
+	return s.client.Git.FindCommit(ctx, repo, sha)
+}
+
+// srcPath returns the form field name of a file path.
+func srcPath(name string) string {
+	return "/" + strings.TrimPrefix(name, "/")
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -64,3 +65,79 @@ func TestContentDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestContentCommitFiles(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/refs/branches/feature").
+		Reply(404).
+		Type("application/json").
+		BodyString(`{"type": "error", "error": {"message": "feature not found"}}`)
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/refs/branches/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/src/a6e5e7d797edf751cbd839d6bd4aef86c941eec9/README").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/content.txt")
+
+	readme, _ := ioutil.ReadFile("testdata/content.txt")
+	want := map[string][]string{
+		"message":      {"add build script"},
+		"branch":       {"feature"},
+		"author":       {"Jane Doe <jane@example.com>"},
+		"parents":      {"a6e5e7d797edf751cbd839d6bd4aef86c941eec9"},
+		"files":        {"/README", "/Makefile"},
+		"/docs/README": {string(readme)},
+		"/build.sh":    {"make"},
+	}
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/src").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				return false, err
+			}
+			return cmp.Equal(req.MultipartForm.Value, want), nil
+		}).
+		Reply(201).
+		SetHeader("Location", "https://api.bitbucket.org/2.0/repositories/atlassian/stash-example-plugin/commit/a6e5e7d797edf751cbd839d6bd4aef86c941eec9")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/commit/a6e5e7d797edf751cbd839d6bd4aef86c941eec9").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	params := &scm.CommitFilesParams{
+		Base:    "master",
+		Branch:  "feature",
+		Message: "add build script",
+		Author:  scm.Signature{Name: "Jane Doe", Email: "jane@example.com"},
+		Changes: []scm.FileChange{
+			{Action: scm.FileMove, Path: "docs/README", PreviousPath: "README"},
+			{Action: scm.FileCreate, Path: "build.sh", Data: []byte("make")},
+			{Action: scm.FileDelete, Path: "Makefile"},
+		},
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Contents.CommitFiles(context.Background(), "atlassian/stash-example-plugin", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commit := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit.json.golden")
+	json.Unmarshal(raw, commit)
+
+	if diff := cmp.Diff(got, commit); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
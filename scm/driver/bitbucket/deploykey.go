@@ -0,0 +1,97 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type deployKeyService struct {
+	client *wrapper
+}
+
+type deployKey struct {
+	ID        int       `json:"id"`
+	Key       string    `json:"key"`
+	Label     string    `json:"label"`
+	CreatedOn time.Time `json:"created_on"`
+	Links     struct {
+		Self link `json:"self"`
+	} `json:"links"`
+}
+
+type deployKeys struct {
+	pagination
+	Values []*deployKey `json:"values"`
+}
+
+type deployKeyInput struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+func (s *deployKeyService) Find(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys/%s", repo, id)
+	out := new(deployKey)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDeployKey(out), res, wrapError(res, err)
+}
+
+func (s *deployKeyService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys?%s", repo, encodeListOptions(opts))
+	out := new(deployKeys)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	err = copyPagination(out.pagination, res)
+	return convertDeployKeyList(out), res, wrapError(res, err)
+}
+
+// Create creates a repository deploy key. Bitbucket deploy
+// keys are always read-only, so ReadOnly is ignored.
+func (s *deployKeyService) Create(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys", repo)
+	in := &deployKeyInput{
+		Key:   input.Key,
+		Label: input.Title,
+	}
+	out := new(deployKey)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployKey(out), res, wrapError(res, err)
+}
+
+func (s *deployKeyService) Delete(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *deployKeyService) Enable(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func convertDeployKeyList(from *deployKeys) []*scm.DeployKey {
+	to := []*scm.DeployKey{}
+	for _, v := range from.Values {
+		to = append(to, convertDeployKey(v))
+	}
+	return to
+}
+
+func convertDeployKey(from *deployKey) *scm.DeployKey {
+	return &scm.DeployKey{
+		ID:       strconv.Itoa(from.ID),
+		Title:    from.Label,
+		Key:      from.Key,
+		ReadOnly: true,
+		Link:     from.Links.Self.Href,
+		Created:  from.CreatedOn,
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestDeployKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/deploy-keys").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/deploy_keys.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.DeployKeys.List(context.Background(), "atlassian/stash-example-plugin", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.DeployKey{}
+	raw, _ := ioutil.ReadFile("testdata/deploy_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/deploy-keys").
+		JSON(map[string]string{"key": "ssh-rsa AAA...", "label": "gitops-agent"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/deploy_key.json")
+
+	input := &scm.DeployKeyInput{
+		Title:    "gitops-agent",
+		Key:      "ssh-rsa AAA...",
+		ReadOnly: true,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.DeployKeys.Create(context.Background(), "atlassian/stash-example-plugin", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.DeployKey{}
+	raw, _ := ioutil.ReadFile("testdata/deploy_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want[0]); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/deploy-keys/123").
+		Reply(204).Done()
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.DeployKeys.Delete(context.Background(), "atlassian/stash-example-plugin", "123")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// Generate renders the webhook as the request bitbucket would
+// send. Bitbucket does not sign payloads, so the secret is
+// passed as a query parameter of the target url.
+func (s *webhookService) Generate(hook scm.Webhook, secret string) (*http.Request, error) {
+	var event, guid string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event, guid, payload = "repo:push", v.GUID, convertFromPushHook(v)
+	case *scm.BranchHook:
+		event, payload = "repo:push", convertFromRefHook("branch", v.Ref, v.Action, &v.Repo, &v.Sender)
+	case *scm.TagHook:
+		event, payload = "repo:push", convertFromRefHook("tag", v.Ref, v.Action, &v.Repo, &v.Sender)
+	case *scm.PullRequestHook:
+		event, payload = convertFromPullRequestAction(v.Action), convertFromPullRequestHook(v)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if guid == "" {
+		guid = scm.NewDeliveryID()
+	}
+	header := http.Header{}
+	header.Set("X-Event-Key", event)
+	header.Set("X-Hook-UUID", guid)
+	target := "/"
+	if secret != "" {
+		target += "?secret=" + url.QueryEscape(secret)
+	}
+	return scm.NewWebhookRequest(target, data, header)
+}
+
+func convertFromPushHook(from *scm.PushHook) *pushHook {
+	to := &pushHook{
+		Repository: convertFromRepository(&from.Repo),
+		Actor:      convertFromActor(&from.Sender),
+	}
+	change := pushHookChange{}
+	change.New.Type = "branch"
+	change.New.Name = scm.TrimRef(from.Ref)
+	if scm.IsTag(from.Ref) {
+		change.New.Type = "tag"
+	}
+	target := &change.New.Target
+	target.Hash = from.Commit.Sha
+	target.Message = from.Commit.Message
+	target.Date = from.Commit.Author.Date
+	target.Links.HTML.Href = from.Commit.Link
+	target.Author.Raw = convertFromSignature(&from.Commit.Author)
+	target.Author.User.Username = from.Commit.Author.Login
+	target.Author.User.DisplayName = from.Commit.Author.Name
+	target.Author.User.Links.Avatar.Href = from.Commit.Author.Avatar
+	for _, c := range from.Commits {
+		commit := pushHookCommit{
+			Hash:    c.ID,
+			Message: c.Message,
+			Date:    c.Timestamp,
+		}
+		commit.Links.HTML.Href = c.Link
+		commit.Author.Raw = convertFromSignature(&c.Author)
+		commit.Author.User.Username = c.Author.Login
+		commit.Author.User.DisplayName = c.Author.Name
+		commit.Author.User.Links.Avatar.Href = c.Author.Avatar
+		change.Commits = append(change.Commits, commit)
+	}
+	to.Push.Changes = append(to.Push.Changes, change)
+	return to
+}
+
+// helper function renders a branch or tag hook as a push
+// with a single change. Created references are parsed back as
+// push hooks, since they carry more metadata.
+func convertFromRefHook(refType string, ref scm.Reference, action scm.Action, repo *scm.Repository, sender *scm.User) *pushHook {
+	to := &pushHook{
+		Repository: convertFromRepository(repo),
+		Actor:      convertFromActor(sender),
+	}
+	change := pushHookChange{}
+	if action == scm.ActionDelete {
+		change.Closed = true
+		change.Old.Type = refType
+		change.Old.Name = ref.Name
+		change.Old.Target.Hash = ref.Sha
+	} else {
+		change.Created = true
+		change.New.Type = refType
+		change.New.Name = ref.Name
+		change.New.Target.Hash = ref.Sha
+	}
+	to.Push.Changes = append(to.Push.Changes, change)
+	return to
+}
+
+func convertFromPullRequestAction(from scm.Action) string {
+	switch from {
+	case scm.ActionOpen:
+		return "pullrequest:created"
+	case scm.ActionMerge:
+		return "pullrequest:fulfilled"
+	case scm.ActionClose:
+		return "pullrequest:rejected"
+	default:
+		return "pullrequest:updated"
+	}
+}
+
+func convertFromPullRequestHook(from *scm.PullRequestHook) *webhook {
+	to := &webhook{
+		Repository: convertFromRepository(&from.Repo),
+		Actor:      convertFromActor(&from.Sender),
+	}
+	pr := &to.PullRequest
+	pr.ID = from.PullRequest.Number
+	pr.Title = from.PullRequest.Title
+	pr.Description = from.PullRequest.Body
+	pr.Links.HTML.Href = from.PullRequest.Link
+	pr.Source.Commit.Hash = from.PullRequest.Sha
+	pr.Source.Branch.Name = from.PullRequest.Source
+	pr.Source.Repository.FullName = from.PullRequest.Fork
+	pr.Destination.Branch.Name = from.PullRequest.Target
+	pr.Destination.Repository.FullName = from.Repo.FullName
+	pr.Author.Username = from.PullRequest.Author.Login
+	pr.Author.DisplayName = from.PullRequest.Author.Name
+	pr.Author.Links.Avatar.Href = from.PullRequest.Author.Avatar
+	pr.CreatedOn = from.PullRequest.Created
+	pr.UpdatedOn = from.PullRequest.Updated
+	switch {
+	case from.PullRequest.Merged:
+		pr.State = "MERGED"
+	case from.PullRequest.Closed:
+		pr.State = "DECLINED"
+	default:
+		pr.State = "OPEN"
+	}
+	return to
+}
+
+func convertFromRepository(from *scm.Repository) webhookRepository {
+	to := webhookRepository{
+		Scm:       "git",
+		Name:      from.Name,
+		FullName:  from.FullName,
+		IsPrivate: from.Private,
+		UUID:      from.ID,
+	}
+	to.Links.HTML.Href = from.Link
+	to.Owner.Username = from.Namespace
+	return to
+}
+
+func convertFromActor(from *scm.User) webhookActor {
+	to := webhookActor{
+		Username:    from.Login,
+		DisplayName: from.Name,
+	}
+	to.Links.Avatar.Href = from.Avatar
+	return to
+}
+
+// helper function returns the git author string, in the
+// format parsed by extractEmail.
+func convertFromSignature(from *scm.Signature) string {
+	if from.Email == "" {
+		return from.Name
+	}
+	return fmt.Sprintf("%s <%s>", from.Name, from.Email)
+}
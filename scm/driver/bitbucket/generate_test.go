@@ -0,0 +1,40 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm/driver/internal/webhooktest"
+)
+
+func TestWebhookGenerate(t *testing.T) {
+	tests := []webhooktest.Test{
+		{Event: "repo:push", File: "push.json"},
+		{Event: "repo:push", File: "push_branch_create.json"},
+		{Event: "repo:push", File: "push_branch_delete.json"},
+		{Event: "repo:push", File: "push_tag_create.json"},
+		{Event: "repo:push", File: "push_tag_delete.json"},
+		{Event: "pullrequest:created", File: "pr_created.json"},
+		{Event: "pullrequest:created", File: "pr_created_slashbranch.json"},
+		{Event: "pullrequest:updated", File: "pr_updated.json"},
+		{Event: "pullrequest:fulfilled", File: "pr_fulfilled.json"},
+		{Event: "pullrequest:rejected", File: "pr_declined.json"},
+	}
+	webhooktest.RoundTrip(t, new(webhookService), generateHeader, tests)
+}
+
+func TestWebhookGenerateNotSupported(t *testing.T) {
+	webhooktest.NotSupported(t, new(webhookService))
+}
+
+// generateHeader returns the headers Bitbucket sends with the event.
+func generateHeader(event string) http.Header {
+	header := http.Header{}
+	header.Set("X-Event-Key", event)
+	header.Set("X-Hook-UUID", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+	return header
+}
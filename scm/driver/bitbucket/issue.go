@@ -172,3 +172,27 @@ func (s *issueService) SetMilestone(ctx context.Context, repo string, issueID in
 func (s *issueService) ClearMilestone(ctx context.Context, repo string, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *issueService) ListReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *issueService) CreateReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *issueService) DeleteReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *issueService) ListCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *issueService) CreateCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *issueService) DeleteCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
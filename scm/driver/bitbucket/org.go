@@ -91,6 +91,95 @@ func (s *organizationService) ListMemberships(ctx context.Context, opts scm.List
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *organizationService) ListHooks(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks?%s", org, encodeListOptions(opts))
+	out := new(hooks)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	err = copyPagination(out.pagination, res)
+	return convertHookList(out), res, wrapError(res, err)
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	in, err := convertFromHookInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks", org)
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	// repository change events are not parsed.
+	return convertHook(out), res, scm.CheckUnsupportedFields(&input.Events, "Repository")
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	in, err := convertFromHookInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks/%s", org, input.ID)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	return convertHook(out), res, scm.CheckUnsupportedFields(&input.Events, "Repository")
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, org string, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks/%s", org, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, org string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, org, team string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, org, team string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, org, team, user, role string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, org, team, user string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) AddTeamRepository(ctx context.Context, org, team, repo, permission string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveTeamRepository(ctx context.Context, org, team, repo string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) InviteMember(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveMember(ctx context.Context, org, user string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) SetMemberRole(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListOutsideCollaborators(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.TeamMember, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func convertOrganizationList(from *organizationList) []*scm.Organization {
 	to := []*scm.Organization{}
 	for _, v := range from.Values {
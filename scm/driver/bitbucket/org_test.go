@@ -67,3 +67,75 @@ func TestOrganizationList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestOrganizationHookList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/workspaces/atlassian/hooks").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hooks.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Organizations.ListHooks(context.Background(), "atlassian", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Hook{}
+	raw, _ := ioutil.ReadFile("testdata/hooks.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/workspaces/atlassian/hooks").
+		Reply(201).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Organizations.CreateHook(context.Background(), "atlassian", &scm.HookInput{
+		Target: "https://example.com",
+		Events: scm.HookEvents{Push: true},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/workspaces/atlassian/hooks/{d53603cc-3f67-45ea-b310-aaa5ef6ec061}").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Organizations.DeleteHook(context.Background(), "atlassian", "{d53603cc-3f67-45ea-b310-aaa5ef6ec061}")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type pipelineService struct {
+	client *wrapper
+}
+
+type pipelines struct {
+	pagination
+	Values []*pipeline `json:"values"`
+}
+
+type pipeline struct {
+	UUID        string          `json:"uuid"`
+	BuildNumber int             `json:"build_number"`
+	State       pipelineState   `json:"state"`
+	Target      *pipelineTarget `json:"target"`
+	Trigger     struct {
+		Name string `json:"name"`
+	} `json:"trigger"`
+	Variables   []*pipelineVariable `json:"variables"`
+	CreatedOn   time.Time           `json:"created_on"`
+	CompletedOn time.Time           `json:"completed_on"`
+	Duration    int                 `json:"duration_in_seconds"`
+}
+
+type pipelineState struct {
+	Name   string `json:"name"`
+	Result *struct {
+		Name string `json:"name"`
+	} `json:"result,omitempty"`
+	Stage *struct {
+		Name string `json:"name"`
+	} `json:"stage,omitempty"`
+}
+
+type pipelineTarget struct {
+	Type     string            `json:"type"`
+	RefType  string            `json:"ref_type,omitempty"`
+	RefName  string            `json:"ref_name,omitempty"`
+	Commit   *pipelineCommit   `json:"commit,omitempty"`
+	Selector *pipelineSelector `json:"selector,omitempty"`
+}
+
+type pipelineCommit struct {
+	Type string `json:"type"`
+	Hash string `json:"hash"`
+}
+
+type pipelineSelector struct {
+	Type    string `json:"type"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
+type pipelineVariable struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Secured bool   `json:"secured"`
+}
+
+type pipelineInput struct {
+	Target    *pipelineTarget     `json:"target"`
+	Variables []*pipelineVariable `json:"variables,omitempty"`
+}
+
+type pipelineSteps struct {
+	pagination
+	Values []*pipelineStep `json:"values"`
+}
+
+type pipelineStep struct {
+	UUID        string        `json:"uuid"`
+	Name        string        `json:"name"`
+	State       pipelineState `json:"state"`
+	StartedOn   time.Time     `json:"started_on"`
+	CompletedOn time.Time     `json:"completed_on"`
+	Duration    int           `json:"duration_in_seconds"`
+}
+
+func (s *pipelineService) List(ctx context.Context, repo string, opts scm.PipelineListOptions) ([]*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/?%s", repo, encodePipelineListOptions(opts))
+	out := new(pipelines)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	copyPipelinePagination(out.pagination, res)
+	return convertPipelineList(repo, out.Values), res, nil
+}
+
+func (s *pipelineService) Find(ctx context.Context, repo string, id int) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/%d", repo, id)
+	out := new(pipeline)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertPipeline(repo, out), res, wrapError(res, err)
+}
+
+// Trigger runs the default pipeline of the ref, or the custom
+// pipeline named by the input workflow.
+func (s *pipelineService) Trigger(ctx context.Context, repo string, input *scm.PipelineInput) (*scm.Pipeline, *scm.Response, error) {
+	in := &pipelineInput{
+		Target: &pipelineTarget{
+			Type:    "pipeline_ref_target",
+			RefType: "branch",
+			RefName: input.Ref,
+		},
+	}
+	if input.Workflow != "" {
+		in.Target.Selector = &pipelineSelector{
+			Type:    "custom",
+			Pattern: input.Workflow,
+		}
+	}
+	var keys []string
+	for k := range input.Variables {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		in.Variables = append(in.Variables, &pipelineVariable{
+			Key:   k,
+			Value: input.Variables[k],
+		})
+	}
+	return s.create(ctx, repo, in)
+}
+
+func (s *pipelineService) Cancel(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/%d/stopPipeline", repo, id)
+	res, err := s.client.do(ctx, "POST", path, nil, nil)
+	return res, wrapError(res, err)
+}
+
+// Retry runs a new pipeline against the target of the given
+// pipeline, since bitbucket cannot re-run a pipeline in place.
+// Secured variables are not copied to the new pipeline.
+func (s *pipelineService) Retry(ctx context.Context, repo string, id int) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/%d", repo, id)
+	out := new(pipeline)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	in := &pipelineInput{Target: out.Target}
+	for _, v := range out.Variables {
+		if !v.Secured {
+			in.Variables = append(in.Variables, v)
+		}
+	}
+	return s.create(ctx, repo, in)
+}
+
+// ListJobs returns the steps of the pipeline. Steps are
+// identified by their position in the pipeline, starting at
+// one, since bitbucket identifies them by uuid.
+func (s *pipelineService) ListJobs(ctx context.Context, repo string, id int, opts scm.ListOptions) ([]*scm.PipelineJob, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/%d/steps/?%s", repo, id, encodeListOptions(opts))
+	out := new(pipelineSteps)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	copyPipelinePagination(out.pagination, res)
+	offset := 0
+	if out.Page > 1 {
+		offset = (out.Page - 1) * out.PageLen
+	}
+	var jobs []*scm.PipelineJob
+	for i, step := range out.Values {
+		jobs = append(jobs, convertPipelineStep(repo, id, offset+i+1, step))
+	}
+	return jobs, res, nil
+}
+
+func (s *pipelineService) JobLog(ctx context.Context, repo string, pipeline, job int) ([]byte, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/%d/steps/?pagelen=100", repo, pipeline)
+	out := new(pipelineSteps)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	if job < 1 || job > len(out.Values) {
+		return nil, res, scm.ErrNotFound
+	}
+	path = fmt.Sprintf("2.0/repositories/%s/pipelines/%d/steps/%s/log", repo, pipeline, url.PathEscape(out.Values[job-1].UUID))
+	buf := new(bytes.Buffer)
+	res, err = s.client.do(ctx, "GET", path, nil, buf)
+	return buf.Bytes(), res, wrapError(res, err)
+}
+
+func (s *pipelineService) create(ctx context.Context, repo string, in *pipelineInput) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/", repo)
+	out := new(pipeline)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	return convertPipeline(repo, out), res, nil
+}
+
+func encodePipelineListOptions(opts scm.PipelineListOptions) string {
+	params := url.Values{}
+	params.Set("sort", "-created_on")
+	if opts.Ref != "" {
+		params.Set("target.ref_name", opts.Ref)
+	}
+	if opts.Sha != "" {
+		params.Set("target.commit.hash", opts.Sha)
+	}
+	if opts.Status != "" {
+		params.Set("status", opts.Status)
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("pagelen", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
+// helper function copies the pagination of the pipelines api,
+// which reports the total size instead of the next page link.
+func copyPipelinePagination(from pagination, to *scm.Response) {
+	copyPagination(from, to)
+	if to.Page.Next == 0 && from.Page*from.PageLen < from.Size {
+		to.Page.Next = from.Page + 1
+	}
+}
+
+func convertPipelineList(repo string, from []*pipeline) []*scm.Pipeline {
+	to := []*scm.Pipeline{}
+	for _, v := range from {
+		to = append(to, convertPipeline(repo, v))
+	}
+	return to
+}
+
+func convertPipeline(repo string, from *pipeline) *scm.Pipeline {
+	to := &scm.Pipeline{
+		ID:             from.BuildNumber,
+		Number:         from.BuildNumber,
+		Status:         convertPipelineState(from.State),
+		DetailedStatus: convertPipelineDetailedStatus(from.State),
+		Source:         strings.ToLower(from.Trigger.Name),
+		Link:           fmt.Sprintf("https://bitbucket.org/%s/pipelines/results/%d", repo, from.BuildNumber),
+		Created:        from.CreatedOn,
+		Finished:       from.CompletedOn,
+		Duration:       time.Duration(from.Duration) * time.Second,
+	}
+	if from.Target != nil {
+		to.Ref = from.Target.RefName
+		to.Tag = from.Target.RefType == "tag"
+		if from.Target.Commit != nil {
+			to.Sha = from.Target.Commit.Hash
+		}
+	}
+	if len(from.Variables) > 0 {
+		to.Variables = map[string]string{}
+		for _, v := range from.Variables {
+			to.Variables[v.Key] = v.Value
+		}
+	}
+	return to
+}
+
+func convertPipelineStep(repo string, pipeline, id int, from *pipelineStep) *scm.PipelineJob {
+	return &scm.PipelineJob{
+		ID:             id,
+		PipelineID:     pipeline,
+		Name:           from.Name,
+		Status:         convertPipelineState(from.State),
+		DetailedStatus: convertPipelineDetailedStatus(from.State),
+		Link:           fmt.Sprintf("https://bitbucket.org/%s/pipelines/results/%d/steps/%s", repo, pipeline, from.UUID),
+		Started:        from.StartedOn,
+		Finished:       from.CompletedOn,
+		Duration:       time.Duration(from.Duration) * time.Second,
+	}
+}
+
+func convertPipelineState(from pipelineState) scm.State {
+	switch from.Name {
+	case "PENDING":
+		return scm.StatePending
+	case "IN_PROGRESS":
+		if from.Stage != nil && from.Stage.Name == "PAUSED" {
+			return scm.StatePending
+		}
+		return scm.StateRunning
+	}
+	if from.Result == nil {
+		return scm.StateUnknown
+	}
+	switch from.Result.Name {
+	case "SUCCESSFUL":
+		return scm.StateSuccess
+	case "FAILED":
+		return scm.StateFailure
+	case "ERROR":
+		return scm.StateError
+	case "STOPPED", "EXPIRED":
+		return scm.StateCanceled
+	default:
+		return scm.StateUnknown
+	}
+}
+
+func convertPipelineDetailedStatus(from pipelineState) string {
+	switch {
+	case from.Result != nil:
+		return strings.ToLower(from.Result.Name)
+	case from.Stage != nil:
+		return strings.ToLower(from.Stage.Name)
+	default:
+		return strings.ToLower(from.Name)
+	}
+}
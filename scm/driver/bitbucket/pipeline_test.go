@@ -0,0 +1,240 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestPipelineFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines/12").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pipeline.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Pipelines.Find(context.Background(), "atlassian/stash-example-plugin", 12)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Pipeline)
+	raw, _ := ioutil.ReadFile("testdata/pipeline.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines/").
+		MatchParam("sort", "-created_on").
+		MatchParam("target.ref_name", "master").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pipelines.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	opts := scm.PipelineListOptions{Ref: "master", Page: 1, Size: 2}
+	got, res, err := client.Pipelines.List(context.Background(), "atlassian/stash-example-plugin", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Pipeline{}
+	raw, _ := ioutil.ReadFile("testdata/pipelines.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if got, want := res.Page.Next, 2; got != want {
+		t.Errorf("Want next page %d, got %d", want, got)
+	}
+}
+
+func TestPipelineTrigger(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/pipelines/").
+		JSON(map[string]interface{}{
+			"target": map[string]interface{}{
+				"type":     "pipeline_ref_target",
+				"ref_type": "branch",
+				"ref_name": "master",
+				"selector": map[string]string{
+					"type":    "custom",
+					"pattern": "deploy",
+				},
+			},
+			"variables": []map[string]interface{}{
+				{"key": "DEPLOY", "value": "true", "secured": false},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pipeline.json")
+
+	input := &scm.PipelineInput{
+		Ref:       "master",
+		Workflow:  "deploy",
+		Variables: map[string]string{"DEPLOY": "true"},
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Pipelines.Trigger(context.Background(), "atlassian/stash-example-plugin", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Pipeline)
+	raw, _ := ioutil.ReadFile("testdata/pipeline.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineCancel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/pipelines/12/stopPipeline").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	if _, err := client.Pipelines.Cancel(context.Background(), "atlassian/stash-example-plugin", 12); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPipelineRetry(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines/12").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pipeline.json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/pipelines/").
+		JSON(map[string]interface{}{
+			"target": map[string]interface{}{
+				"type":     "pipeline_ref_target",
+				"ref_type": "branch",
+				"ref_name": "master",
+				"commit": map[string]string{
+					"type": "commit",
+					"hash": "a6e5e7d797edf751cbd839d6bd4aef86c941eec9",
+				},
+				"selector": map[string]string{
+					"type":    "branches",
+					"pattern": "master",
+				},
+			},
+			"variables": []map[string]interface{}{
+				{"key": "DEPLOY", "value": "true", "secured": false},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pipeline.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Pipelines.Retry(context.Background(), "atlassian/stash-example-plugin", 12)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Pipeline)
+	raw, _ := ioutil.ReadFile("testdata/pipeline.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineListJobs(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines/12/steps/").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pipeline_steps.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Pipelines.ListJobs(context.Background(), "atlassian/stash-example-plugin", 12, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PipelineJob{}
+	raw, _ := ioutil.ReadFile("testdata/pipeline_steps.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineJobLog(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines/12/steps/").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pipeline_steps.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines/12/steps/{c2d3e4f5-a6b7-4c8d-9e0f-1a2b3c4d5e6f}/log").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("+ ./deploy.sh\n")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Pipelines.JobLog(context.Background(), "atlassian/stash-example-plugin", 12, 2)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if want := "+ ./deploy.sh\n"; string(got) != want {
+		t.Errorf("Want log %q, got %q", want, got)
+	}
+}
@@ -39,6 +39,20 @@ type repository struct {
 	} `json:"mainbranch"`
 }
 
+type repositorySettings struct {
+	Name        string      `json:"name,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Website     string      `json:"website,omitempty"`
+	IsPrivate   *bool       `json:"is_private,omitempty"`
+	HasIssues   *bool       `json:"has_issues,omitempty"`
+	HasWiki     *bool       `json:"has_wiki,omitempty"`
+	Mainbranch  *branchName `json:"mainbranch,omitempty"`
+}
+
+type branchName struct {
+	Name string `json:"name"`
+}
+
 type perms struct {
 	Values []*perm `json:"values"`
 }
@@ -62,10 +76,11 @@ type hook struct {
 }
 
 type hookInput struct {
-	Description string   `json:"description"`
-	URL         string   `json:"url"`
-	Active      bool     `json:"active"`
-	Events      []string `json:"events"`
+	Description          string   `json:"description"`
+	URL                  string   `json:"url"`
+	Active               bool     `json:"active"`
+	SkipCertVerification bool     `json:"skip_cert_verification"`
+	Events               []string `json:"events"`
 }
 
 type repositoryService struct {
@@ -224,10 +239,71 @@ func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions)
 	return nil, nil, nil
 }
 
+// CreateLabel is not supported. Bitbucket has no repository
+// labels, and issue components are read-only in the API.
+func (s *repositoryService) CreateLabel(context.Context, string, *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) UpdateLabel(context.Context, string, string, *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteLabel(context.Context, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *repositoryService) Delete(context.Context, string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+// Update updates the repository settings.
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositorySettings) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s", repo)
+	in := &repositorySettings{
+		Description: input.Description,
+		Website:     input.Homepage,
+		HasIssues:   input.HasIssues,
+		HasWiki:     input.HasWiki,
+	}
+	if input.DefaultBranch != "" {
+		in.Mainbranch = &branchName{Name: input.DefaultBranch}
+	}
+	unsupported := []string{
+		"Archived",
+		"AllowMergeCommit",
+		"AllowSquashMerge",
+		"AllowRebaseMerge",
+		"DeleteBranchOnMerge",
+		"Topics",
+	}
+	switch input.Visibility {
+	case scm.VisibilityPublic, scm.VisibilityPrivate:
+		private := input.Visibility == scm.VisibilityPrivate
+		in.IsPrivate = &private
+	case scm.VisibilityInternal:
+		unsupported = append(unsupported, "Visibility")
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	return convertRepository(out), res, scm.CheckUnsupportedFields(input, unsupported...)
+}
+
+// Rename renames the repository, which also changes its slug.
+func (s *repositoryService) Rename(ctx context.Context, repo, name string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s", repo)
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, &repositorySettings{Name: name}, out)
+	return convertRepository(out), res, wrapError(res, err)
+}
+
+func (s *repositoryService) Transfer(context.Context, string, string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 // Find returns the repository by name.
 func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Repository, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s", repo)
@@ -301,37 +377,40 @@ func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, op
 
 // CreateHook creates a new repository webhook.
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	targetText := input.Target
-	if input.Secret != "" {
-		target, err := url.Parse(input.Target)
-		if err != nil {
-			return nil, nil, err
-		}
-		params := target.Query()
-		params.Set("secret", input.Secret)
-		target.RawQuery = params.Encode()
-		targetText = target.String()
+	in, err := convertFromHookInput(input)
+	if err != nil {
+		return nil, nil, err
 	}
-
 	path := fmt.Sprintf("2.0/repositories/%s/hooks", repo)
-	in := new(hookInput)
-	in.URL = targetText
-	in.Active = true
-	in.Description = input.Name
-	if in.Description == "" {
-		in.Description = "my webhook"
-	}
-	in.Events = append(
-		input.NativeEvents,
-		convertHookEvents(input.Events)...,
-	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
-	return convertHook(out), res, wrapError(res, err)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	// repository change events are not parsed.
+	return convertHook(out), res, scm.CheckUnsupportedFields(&input.Events, "Repository")
 }
 
+// UpdateHook updates a repository webhook.
 func (s *repositoryService) UpdateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	in, err := convertFromHookInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/hooks/%s", repo, input.ID)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	return convertHook(out), res, scm.CheckUnsupportedFields(&input.Events, "Repository")
+}
+
+// EnsureHook makes sure exactly one repository webhook
+// targets the input url with the input events.
+func (s *repositoryService) EnsureHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.EnsureHookResult, *scm.Response, error) {
+	events := append(input.NativeEvents, convertHookEvents(input.Events)...)
+	return scm.EnsureHook(ctx, s, repo, input, events)
 }
 
 // CreateStatus creates a new commit status.
@@ -422,6 +501,37 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+// helper function to convert the common hook input to the
+// bitbucket hook input. The secret is passed as a query
+// parameter since bitbucket does not sign webhooks.
+func convertFromHookInput(from *scm.HookInput) (*hookInput, error) {
+	targetText := from.Target
+	if from.Secret != "" {
+		target, err := url.Parse(from.Target)
+		if err != nil {
+			return nil, err
+		}
+		params := target.Query()
+		params.Set("secret", from.Secret)
+		target.RawQuery = params.Encode()
+		targetText = target.String()
+	}
+
+	in := new(hookInput)
+	in.URL = targetText
+	in.Active = true
+	in.SkipCertVerification = from.SkipVerify
+	in.Description = from.Name
+	if in.Description == "" {
+		in.Description = "my webhook"
+	}
+	in.Events = append(
+		from.NativeEvents,
+		convertHookEvents(from.Events)...,
+	)
+	return in, nil
+}
+
 func convertHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push {
@@ -449,6 +559,13 @@ func convertHookEvents(from scm.HookEvents) []string {
 	if from.IssueComment {
 		events = append(events, "issue:comment_created")
 	}
+	if from.Fork {
+		events = append(events, "repo:fork")
+	}
+	if from.Status {
+		events = append(events, "repo:commit_status_created")
+		events = append(events, "repo:commit_status_updated")
+	}
 	return events
 }
 
@@ -297,6 +297,74 @@ func TestRepositoryHookCreate(t *testing.T) {
 	}
 }
 
+func TestRepositoryHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/hooks/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.UpdateHook(context.Background(), "atlassian/stash-example-plugin", &scm.HookInput{ID: "1"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryHookEnsure(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/hooks").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/hooks.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/hooks/.+").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	in := &scm.HookInput{
+		Target:       "http://example.com/webhook",
+		NativeEvents: []string{"pullrequest:created", "pullrequest:updated", "repo:push"},
+	}
+	got, _, err := client.Repositories.EnsureHook(context.Background(), "atlassian/stash-example-plugin", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Changed() {
+		t.Errorf("Want unchanged hook, got %+v", got)
+	}
+
+	in.NativeEvents = nil
+	in.Events = scm.HookEvents{Push: true}
+	got, _, err = client.Repositories.EnsureHook(context.Background(), "atlassian/stash-example-plugin", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got.Updated {
+		t.Errorf("Want updated hook, got %+v", got)
+	}
+}
+
 func TestConvertFromState(t *testing.T) {
 	tests := []struct {
 		src scm.State
@@ -401,3 +469,57 @@ func TestConvertPerms(t *testing.T) {
 		}
 	}
 }
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin").
+		JSON(map[string]interface{}{"is_private": true, "mainbranch": map[string]string{"name": "master"}}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	in := &scm.RepositorySettings{
+		Visibility:    scm.VisibilityPrivate,
+		DefaultBranch: "master",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.Update(context.Background(), "atlassian/stash-example-plugin", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryRename(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/example-plugin").
+		JSON(map[string]string{"name": "stash-example-plugin"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.Rename(context.Background(), "atlassian/example-plugin", "stash-example-plugin")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.FullName, "atlassian/stash-example-plugin"; got != want {
+		t.Errorf("Want repository %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// secretService manages bitbucket pipelines variables.
+// Environment variables are the variables of a deployment
+// environment, and organization variables are the variables
+// of a workspace. Variables are identified by uuid, so they
+// are listed to find a variable by name.
+type secretService struct {
+	client *wrapper
+}
+
+type variable struct {
+	UUID    string `json:"uuid,omitempty"`
+	Key     string `json:"key"`
+	Value   string `json:"value,omitempty"`
+	Secured bool   `json:"secured"`
+}
+
+type variables struct {
+	pagination
+	Values []*variable `json:"values"`
+}
+
+type environment struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type environments struct {
+	pagination
+	Values []*environment `json:"values"`
+}
+
+func (s *secretService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines_config/variables/", repo)
+	return s.list(ctx, path, "", opts)
+}
+
+func (s *secretService) Set(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines_config/variables/", repo)
+	return s.set(ctx, path, input)
+}
+
+func (s *secretService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines_config/variables/", repo)
+	return s.delete(ctx, path, name)
+}
+
+func (s *secretService) ListEnvironment(ctx context.Context, repo, env string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path, res, err := s.environmentPath(ctx, repo, env)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.list(ctx, path, env, opts)
+}
+
+func (s *secretService) SetEnvironment(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	path, res, err := s.environmentPath(ctx, repo, env)
+	if err != nil {
+		return res, err
+	}
+	return s.set(ctx, path, input)
+}
+
+func (s *secretService) DeleteEnvironment(ctx context.Context, repo, env, name string) (*scm.Response, error) {
+	path, res, err := s.environmentPath(ctx, repo, env)
+	if err != nil {
+		return res, err
+	}
+	return s.delete(ctx, path, name)
+}
+
+func (s *secretService) ListOrganization(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables", org)
+	return s.list(ctx, path, "", opts)
+}
+
+func (s *secretService) SetOrganization(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables", org)
+	return s.set(ctx, path, input)
+}
+
+func (s *secretService) DeleteOrganization(ctx context.Context, org, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables", org)
+	return s.delete(ctx, path, name)
+}
+
+func (s *secretService) list(ctx context.Context, path, env string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	out := new(variables)
+	res, err := s.client.do(ctx, "GET", fmt.Sprintf("%s?%s", path, encodeListOptions(opts)), nil, out)
+	if err != nil {
+		return nil, res, wrapError(res, err)
+	}
+	err = copyPagination(out.pagination, res)
+	return convertVariableList(out, env), res, wrapError(res, err)
+}
+
+// helper function updates the variable, or creates it if
+// it does not exist. Secured variables are masked.
+func (s *secretService) set(ctx context.Context, path string, input *scm.SecretInput) (*scm.Response, error) {
+	existing, res, err := s.find(ctx, path, input.Name)
+	if err != nil && err != scm.ErrNotFound {
+		return res, err
+	}
+	in := &variable{
+		Key:     input.Name,
+		Value:   input.Value,
+		Secured: input.Masked,
+	}
+	if existing != nil {
+		res, err = s.client.do(ctx, "PUT", variablePath(path, existing.UUID), in, nil)
+	} else {
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+	}
+	if err != nil {
+		return res, wrapError(res, err)
+	}
+	return res, scm.CheckUnsupportedFields(input, "Protected", "Visibility", "SelectedRepositoryIDs")
+}
+
+func (s *secretService) delete(ctx context.Context, path, name string) (*scm.Response, error) {
+	existing, res, err := s.find(ctx, path, name)
+	if err != nil {
+		return res, err
+	}
+	return s.client.do(ctx, "DELETE", variablePath(path, existing.UUID), nil, nil)
+}
+
+// helper function returns the named variable, or ErrNotFound
+// if there is no such variable.
+func (s *secretService) find(ctx context.Context, path, name string) (*variable, *scm.Response, error) {
+	opts := scm.ListOptions{Page: 1, Size: 50}
+	for {
+		out := new(variables)
+		res, err := s.client.do(ctx, "GET", fmt.Sprintf("%s?%s", path, encodeListOptions(opts)), nil, out)
+		if err != nil {
+			return nil, res, wrapError(res, err)
+		}
+		for _, v := range out.Values {
+			if v.Key == name {
+				return v, res, nil
+			}
+		}
+		if err := copyPagination(out.pagination, res); err != nil || res.Page.Next == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// helper function returns the variables path of the named
+// deployment environment, which is matched by name or slug.
+func (s *secretService) environmentPath(ctx context.Context, repo, env string) (string, *scm.Response, error) {
+	opts := scm.ListOptions{Page: 1, Size: 50}
+	for {
+		out := new(environments)
+		path := fmt.Sprintf("2.0/repositories/%s/environments/?%s", repo, encodeListOptions(opts))
+		res, err := s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return "", res, wrapError(res, err)
+		}
+		for _, v := range out.Values {
+			if v.Name == env || v.Slug == env {
+				return fmt.Sprintf("2.0/repositories/%s/deployments_config/environments/%s/variables", repo, v.UUID), res, nil
+			}
+		}
+		if err := copyPagination(out.pagination, res); err != nil || res.Page.Next == 0 {
+			return "", res, scm.ErrNotFound
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+func variablePath(path, uuid string) string {
+	return strings.TrimSuffix(path, "/") + "/" + uuid
+}
+
+func convertVariableList(from *variables, env string) []*scm.Secret {
+	to := []*scm.Secret{}
+	for _, v := range from.Values {
+		to = append(to, &scm.Secret{
+			Name:        v.Key,
+			Environment: env,
+			Masked:      v.Secured,
+		})
+	}
+	return to
+}
@@ -0,0 +1,121 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines_config/variables/").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/variables.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Secrets.List(context.Background(), "atlassian/stash-example-plugin", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/variables.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSecretSetEnvironment_Update(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/environments/").
+		Reply(200).
+		Type("application/json").
+		File("testdata/environments.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/deployments_config/environments/{e1e1e1e1-1111-4111-8111-111111111111}/variables").
+		Reply(200).
+		Type("application/json").
+		File("testdata/variables.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/deployments_config/environments/{e1e1e1e1-1111-4111-8111-111111111111}/variables/{b4b4b1a5-2a4d-4c3b-9d3e-8f6f1c2d3e4f}").
+		JSON(map[string]interface{}{"key": "DEPLOY_TOKEN", "value": "s3cr3t", "secured": true}).
+		Reply(200).
+		Type("application/json")
+
+	input := &scm.SecretInput{
+		Name:   "DEPLOY_TOKEN",
+		Value:  "s3cr3t",
+		Masked: true,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Secrets.SetEnvironment(context.Background(), "atlassian/stash-example-plugin", "production", input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSecretSetOrganization_Create(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/workspaces/atlassian/pipelines-config/variables").
+		Reply(200).
+		Type("application/json").
+		File("testdata/variables.json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/workspaces/atlassian/pipelines-config/variables").
+		JSON(map[string]interface{}{"key": "NPM_TOKEN", "value": "s3cr3t", "secured": false}).
+		Reply(201).
+		Type("application/json")
+
+	input := &scm.SecretInput{
+		Name:  "NPM_TOKEN",
+		Value: "s3cr3t",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Secrets.SetOrganization(context.Background(), "atlassian", input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSecretDelete_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines_config/variables/").
+		Reply(200).
+		Type("application/json").
+		File("testdata/variables.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Secrets.Delete(context.Background(), "atlassian/stash-example-plugin", "NPM_TOKEN")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want error %v, got %v", scm.ErrNotFound, err)
+	}
+}
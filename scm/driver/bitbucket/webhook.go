@@ -39,7 +39,8 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	guid := req.Header.Get("X-Hook-UUID")
 
 	var hook scm.Webhook
-	switch req.Header.Get("x-event-key") {
+	event := req.Header.Get("x-event-key")
+	switch event {
 	case "repo:push":
 		hook, err = s.parsePushHook(data, guid)
 	case "pullrequest:created":
@@ -64,6 +65,18 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		if hook != nil {
 			hook.(*scm.PullRequestCommentHook).Action = scm.ActionCreate
 		}
+	case "pullrequest:approved", "pullrequest:unapproved", "pullrequest:changes_request_created", "pullrequest:changes_request_removed":
+		hook, err = s.parsePullRequestReviewHook(data, event)
+	case "issue:created", "issue:updated":
+		hook, err = s.parseIssueHook(data, event)
+	case "issue:comment_created":
+		hook, err = s.parseIssueCommentHook(data, guid)
+	case "repo:fork":
+		hook, err = s.parseForkHook(data)
+	case "repo:commit_status_created", "repo:commit_status_updated":
+		hook, err = s.parseStatusHook(data, event)
+	default:
+		return nil, scm.UnknownWebhook{Event: event}
 	}
 	if err != nil {
 		return nil, err
@@ -140,6 +153,53 @@ func (s *webhookService) parsePullRequestCommentHook(data []byte) (*scm.PullRequ
 	return s.convertPullRequestCommentHook(dst)
 }
 
+func (s *webhookService) parsePullRequestReviewHook(data []byte, event string) (*scm.ReviewHook, error) {
+	dst := new(webhookPRReview)
+	err := json.Unmarshal(data, dst)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertPullRequestReviewHook(dst, event)
+}
+
+func (s *webhookService) parseIssueHook(data []byte, event string) (*scm.IssueHook, error) {
+	dst := new(webhookIssue)
+	err := json.Unmarshal(data, dst)
+	if err != nil {
+		return nil, err
+	}
+	return convertIssueHook(dst, event), nil
+}
+
+func (s *webhookService) parseIssueCommentHook(data []byte, guid string) (*scm.IssueCommentHook, error) {
+	dst := new(webhookIssue)
+	err := json.Unmarshal(data, dst)
+	if err != nil {
+		return nil, err
+	}
+	hook := convertIssueCommentHook(dst)
+	hook.GUID = guid
+	return hook, nil
+}
+
+func (s *webhookService) parseForkHook(data []byte) (*scm.ForkHook, error) {
+	dst := new(webhookFork)
+	err := json.Unmarshal(data, dst)
+	if err != nil {
+		return nil, err
+	}
+	return convertForkHook(dst), nil
+}
+
+func (s *webhookService) parseStatusHook(data []byte, event string) (*scm.StatusHook, error) {
+	dst := new(webhookCommitStatus)
+	err := json.Unmarshal(data, dst)
+	if err != nil {
+		return nil, err
+	}
+	return convertStatusHook(dst, event), nil
+}
+
 //
 // native data structures
 //
@@ -147,156 +207,213 @@ func (s *webhookService) parsePullRequestCommentHook(data []byte) (*scm.PullRequ
 type (
 	pushHook struct {
 		Push struct {
-			Changes []struct {
-				Forced bool `json:"forced"`
-				Old    struct {
-					Type  string `json:"type"`
-					Name  string `json:"name"`
-					Links struct {
-						Commits struct {
-							Href string `json:"href"`
-						} `json:"commits"`
-						Self struct {
-							Href string `json:"href"`
-						} `json:"self"`
-						HTML struct {
-							Href string `json:"href"`
-						} `json:"html"`
-					} `json:"links"`
-					Target struct {
-						Hash  string `json:"hash"`
-						Links struct {
+			Changes []pushHookChange `json:"changes"`
+		} `json:"push"`
+		Repository webhookRepository `json:"repository"`
+		Actor      webhookActor      `json:"actor"`
+	}
+
+	pushHookCommit struct {
+		Hash  string `json:"hash"`
+		Links struct {
+			Self struct {
+				Href string `json:"href"`
+			} `json:"self"`
+			Comments struct {
+				Href string `json:"href"`
+			} `json:"comments"`
+			Patch struct {
+				Href string `json:"href"`
+			} `json:"patch"`
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+			Diff struct {
+				Href string `json:"href"`
+			} `json:"diff"`
+			Approve struct {
+				Href string `json:"href"`
+			} `json:"approve"`
+			Statuses struct {
+				Href string `json:"href"`
+			} `json:"statuses"`
+		} `json:"links"`
+		Author struct {
+			Raw  string `json:"raw"`
+			Type string `json:"type"`
+			User struct {
+				Username    string `json:"username"`
+				DisplayName string `json:"display_name"`
+				AccountID   string `json:"account_id"`
+				Links       struct {
+					Self struct {
+						Href string `json:"href"`
+					} `json:"self"`
+					HTML struct {
+						Href string `json:"href"`
+					} `json:"html"`
+					Avatar struct {
+						Href string `json:"href"`
+					} `json:"avatar"`
+				} `json:"links"`
+				Type string `json:"type"`
+				UUID string `json:"uuid"`
+			} `json:"user"`
+		} `json:"author"`
+		Summary struct {
+			Raw    string `json:"raw"`
+			Markup string `json:"markup"`
+			HTML   string `json:"html"`
+			Type   string `json:"type"`
+		} `json:"summary"`
+		Parents []struct {
+			Type  string `json:"type"`
+			Hash  string `json:"hash"`
+			Links struct {
+				Self struct {
+					Href string `json:"href"`
+				} `json:"self"`
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+			} `json:"links"`
+		} `json:"parents"`
+		Date    time.Time `json:"date"`
+		Message string    `json:"message"`
+		Type    string    `json:"type"`
+	}
+
+	pushHookChange struct {
+		Forced bool `json:"forced"`
+		Old    struct {
+			Type  string `json:"type"`
+			Name  string `json:"name"`
+			Links struct {
+				Commits struct {
+					Href string `json:"href"`
+				} `json:"commits"`
+				Self struct {
+					Href string `json:"href"`
+				} `json:"self"`
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+			} `json:"links"`
+			Target struct {
+				Hash  string `json:"hash"`
+				Links struct {
+					Self struct {
+						Href string `json:"href"`
+					} `json:"self"`
+					HTML struct {
+						Href string `json:"href"`
+					} `json:"html"`
+				} `json:"links"`
+				Author struct {
+					Raw  string `json:"raw"`
+					Type string `json:"type"`
+					User struct {
+						Username    string `json:"username"`
+						DisplayName string `json:"display_name"`
+						AccountID   string `json:"account_id"`
+						Links       struct {
 							Self struct {
 								Href string `json:"href"`
 							} `json:"self"`
 							HTML struct {
 								Href string `json:"href"`
 							} `json:"html"`
+							Avatar struct {
+								Href string `json:"href"`
+							} `json:"avatar"`
 						} `json:"links"`
-						Author struct {
-							Raw  string `json:"raw"`
-							Type string `json:"type"`
-							User struct {
-								Username    string `json:"username"`
-								DisplayName string `json:"display_name"`
-								AccountID   string `json:"account_id"`
-								Links       struct {
-									Self struct {
-										Href string `json:"href"`
-									} `json:"self"`
-									HTML struct {
-										Href string `json:"href"`
-									} `json:"html"`
-									Avatar struct {
-										Href string `json:"href"`
-									} `json:"avatar"`
-								} `json:"links"`
-								Type string `json:"type"`
-								UUID string `json:"uuid"`
-							} `json:"user"`
-						} `json:"author"`
-						Summary struct {
-							Raw    string `json:"raw"`
-							Markup string `json:"markup"`
-							HTML   string `json:"html"`
-							Type   string `json:"type"`
-						} `json:"summary"`
-						Parents []interface{} `json:"parents"`
-						Date    time.Time     `json:"date"`
-						Message string        `json:"message"`
-						Type    string        `json:"type"`
-					} `json:"target"`
-				} `json:"old"`
+						Type string `json:"type"`
+						UUID string `json:"uuid"`
+					} `json:"user"`
+				} `json:"author"`
+				Summary struct {
+					Raw    string `json:"raw"`
+					Markup string `json:"markup"`
+					HTML   string `json:"html"`
+					Type   string `json:"type"`
+				} `json:"summary"`
+				Parents []interface{} `json:"parents"`
+				Date    time.Time     `json:"date"`
+				Message string        `json:"message"`
+				Type    string        `json:"type"`
+			} `json:"target"`
+		} `json:"old"`
+		Links struct {
+			Commits struct {
+				Href string `json:"href"`
+			} `json:"commits"`
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+			Diff struct {
+				Href string `json:"href"`
+			} `json:"diff"`
+		} `json:"links"`
+		Truncated bool             `json:"truncated"`
+		Commits   []pushHookCommit `json:"commits"`
+		Created   bool             `json:"created"`
+		Closed    bool             `json:"closed"`
+		New       struct {
+			Type  string `json:"type"`
+			Name  string `json:"name"`
+			Links struct {
+				Commits struct {
+					Href string `json:"href"`
+				} `json:"commits"`
+				Self struct {
+					Href string `json:"href"`
+				} `json:"self"`
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+			} `json:"links"`
+			Target struct {
+				Hash  string `json:"hash"`
 				Links struct {
-					Commits struct {
+					Self struct {
 						Href string `json:"href"`
-					} `json:"commits"`
+					} `json:"self"`
 					HTML struct {
 						Href string `json:"href"`
 					} `json:"html"`
-					Diff struct {
-						Href string `json:"href"`
-					} `json:"diff"`
 				} `json:"links"`
-				Truncated bool `json:"truncated"`
-				Commits   []struct {
-					Hash  string `json:"hash"`
-					Links struct {
-						Self struct {
-							Href string `json:"href"`
-						} `json:"self"`
-						Comments struct {
-							Href string `json:"href"`
-						} `json:"comments"`
-						Patch struct {
-							Href string `json:"href"`
-						} `json:"patch"`
-						HTML struct {
-							Href string `json:"href"`
-						} `json:"html"`
-						Diff struct {
-							Href string `json:"href"`
-						} `json:"diff"`
-						Approve struct {
-							Href string `json:"href"`
-						} `json:"approve"`
-						Statuses struct {
-							Href string `json:"href"`
-						} `json:"statuses"`
-					} `json:"links"`
-					Author struct {
-						Raw  string `json:"raw"`
-						Type string `json:"type"`
-						User struct {
-							Username    string `json:"username"`
-							DisplayName string `json:"display_name"`
-							AccountID   string `json:"account_id"`
-							Links       struct {
-								Self struct {
-									Href string `json:"href"`
-								} `json:"self"`
-								HTML struct {
-									Href string `json:"href"`
-								} `json:"html"`
-								Avatar struct {
-									Href string `json:"href"`
-								} `json:"avatar"`
-							} `json:"links"`
-							Type string `json:"type"`
-							UUID string `json:"uuid"`
-						} `json:"user"`
-					} `json:"author"`
-					Summary struct {
-						Raw    string `json:"raw"`
-						Markup string `json:"markup"`
-						HTML   string `json:"html"`
-						Type   string `json:"type"`
-					} `json:"summary"`
-					Parents []struct {
-						Type  string `json:"type"`
-						Hash  string `json:"hash"`
-						Links struct {
+				Author struct {
+					Raw  string `json:"raw"`
+					Type string `json:"type"`
+					User struct {
+						Username    string `json:"username"`
+						DisplayName string `json:"display_name"`
+						AccountID   string `json:"account_id"`
+						Links       struct {
 							Self struct {
 								Href string `json:"href"`
 							} `json:"self"`
 							HTML struct {
 								Href string `json:"href"`
 							} `json:"html"`
+							Avatar struct {
+								Href string `json:"href"`
+							} `json:"avatar"`
 						} `json:"links"`
-					} `json:"parents"`
-					Date    time.Time `json:"date"`
-					Message string    `json:"message"`
-					Type    string    `json:"type"`
-				} `json:"commits"`
-				Created bool `json:"created"`
-				Closed  bool `json:"closed"`
-				New     struct {
+						Type string `json:"type"`
+						UUID string `json:"uuid"`
+					} `json:"user"`
+				} `json:"author"`
+				Summary struct {
+					Raw    string `json:"raw"`
+					Markup string `json:"markup"`
+					HTML   string `json:"html"`
+					Type   string `json:"type"`
+				} `json:"summary"`
+				Parents []struct {
 					Type  string `json:"type"`
-					Name  string `json:"name"`
+					Hash  string `json:"hash"`
 					Links struct {
-						Commits struct {
-							Href string `json:"href"`
-						} `json:"commits"`
 						Self struct {
 							Href string `json:"href"`
 						} `json:"self"`
@@ -304,65 +421,12 @@ type (
 							Href string `json:"href"`
 						} `json:"html"`
 					} `json:"links"`
-					Target struct {
-						Hash  string `json:"hash"`
-						Links struct {
-							Self struct {
-								Href string `json:"href"`
-							} `json:"self"`
-							HTML struct {
-								Href string `json:"href"`
-							} `json:"html"`
-						} `json:"links"`
-						Author struct {
-							Raw  string `json:"raw"`
-							Type string `json:"type"`
-							User struct {
-								Username    string `json:"username"`
-								DisplayName string `json:"display_name"`
-								AccountID   string `json:"account_id"`
-								Links       struct {
-									Self struct {
-										Href string `json:"href"`
-									} `json:"self"`
-									HTML struct {
-										Href string `json:"href"`
-									} `json:"html"`
-									Avatar struct {
-										Href string `json:"href"`
-									} `json:"avatar"`
-								} `json:"links"`
-								Type string `json:"type"`
-								UUID string `json:"uuid"`
-							} `json:"user"`
-						} `json:"author"`
-						Summary struct {
-							Raw    string `json:"raw"`
-							Markup string `json:"markup"`
-							HTML   string `json:"html"`
-							Type   string `json:"type"`
-						} `json:"summary"`
-						Parents []struct {
-							Type  string `json:"type"`
-							Hash  string `json:"hash"`
-							Links struct {
-								Self struct {
-									Href string `json:"href"`
-								} `json:"self"`
-								HTML struct {
-									Href string `json:"href"`
-								} `json:"html"`
-							} `json:"links"`
-						} `json:"parents"`
-						Date    time.Time `json:"date"`
-						Message string    `json:"message"`
-						Type    string    `json:"type"`
-					} `json:"target"`
-				} `json:"new"`
-			} `json:"changes"`
-		} `json:"push"`
-		Repository webhookRepository `json:"repository"`
-		Actor      webhookActor      `json:"actor"`
+				} `json:"parents"`
+				Date    time.Time `json:"date"`
+				Message string    `json:"message"`
+				Type    string    `json:"type"`
+			} `json:"target"`
+		} `json:"new"`
 	}
 
 	webhook struct {
@@ -510,6 +574,83 @@ type (
 	}
 )
 
+type webhookPRReview struct {
+	PullRequest    webhookPullRequest `json:"pullrequest"`
+	Repository     webhookRepository  `json:"repository"`
+	Actor          webhookActor       `json:"actor"`
+	Approval       *webhookApproval   `json:"approval"`
+	ChangesRequest *webhookApproval   `json:"changes_request"`
+}
+
+type webhookApproval struct {
+	Date time.Time    `json:"date"`
+	User webhookActor `json:"user"`
+}
+
+type webhookIssue struct {
+	Issue struct {
+		ID      int    `json:"id"`
+		Title   string `json:"title"`
+		State   string `json:"state"`
+		Kind    string `json:"kind"`
+		Content struct {
+			Raw string `json:"raw"`
+		} `json:"content"`
+		Reporter  webhookActor  `json:"reporter"`
+		Assignee  *webhookActor `json:"assignee"`
+		CreatedOn time.Time     `json:"created_on"`
+		UpdatedOn time.Time     `json:"updated_on"`
+		Links     struct {
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+		} `json:"links"`
+	} `json:"issue"`
+	Changes struct {
+		Status *struct {
+			Old string `json:"old"`
+			New string `json:"new"`
+		} `json:"status"`
+		Assignee *struct {
+			Old *webhookActor `json:"old"`
+			New *webhookActor `json:"new"`
+		} `json:"assignee"`
+	} `json:"changes"`
+	Comment    *issueComment     `json:"comment"`
+	Repository webhookRepository `json:"repository"`
+	Actor      webhookActor      `json:"actor"`
+}
+
+type webhookFork struct {
+	Repository webhookRepository `json:"repository"`
+	Fork       webhookRepository `json:"fork"`
+	Actor      webhookActor      `json:"actor"`
+}
+
+type webhookCommitStatus struct {
+	CommitStatus struct {
+		Key         string    `json:"key"`
+		Name        string    `json:"name"`
+		Description string    `json:"description"`
+		State       string    `json:"state"`
+		URL         string    `json:"url"`
+		Type        string    `json:"type"`
+		Refname     string    `json:"refname"`
+		CreatedOn   time.Time `json:"created_on"`
+		UpdatedOn   time.Time `json:"updated_on"`
+		Commit      struct {
+			Hash string `json:"hash"`
+		} `json:"commit"`
+		Links struct {
+			Commit struct {
+				Href string `json:"href"`
+			} `json:"commit"`
+		} `json:"links"`
+	} `json:"commit_status"`
+	Repository webhookRepository `json:"repository"`
+	Actor      webhookActor      `json:"actor"`
+}
+
 type webhookPRComment struct {
 	PullRequest *webhookPullRequest `json:"pullrequest"`
 	Comment     *prComment          `json:"comment"` //this struct definition is available in pr.go
@@ -569,6 +710,7 @@ func (s *webhookService) convertPushHook(src *pushHook) (*scm.PushHook, error) {
 	if change.New.Type == "tag" {
 		dst.Ref = scm.ExpandRef(change.New.Name, "refs/tags/")
 	}
+	dst.Commits = convertPushCommits(change.Commits)
 	dst.After = sha
 	if len(sha) <= 12 && sha != "" {
 		// lets convert to a full hash
@@ -585,6 +727,28 @@ func (s *webhookService) convertPushHook(src *pushHook) (*scm.PushHook, error) {
 	return dst, nil
 }
 
+func convertPushCommits(src []pushHookCommit) []scm.PushCommit {
+	var dst []scm.PushCommit
+	for _, c := range src {
+		// bitbucket only reports the commit author, so the
+		// committer is left empty.
+		dst = append(dst, scm.PushCommit{
+			ID:      c.Hash,
+			Message: c.Message,
+			Author: scm.Signature{
+				Login:  validUser(c.Author.User.AccountID, c.Author.User.Username),
+				Email:  extractEmail(c.Author.Raw),
+				Name:   c.Author.User.DisplayName,
+				Avatar: c.Author.User.Links.Avatar.Href,
+				Date:   c.Date,
+			},
+			Timestamp: c.Date,
+			Link:      c.Links.HTML.Href,
+		})
+	}
+	return dst
+}
+
 func convertBranchCreateHook(src *pushHook) *scm.BranchHook {
 	namespace, name := scm.Split(src.Repository.FullName)
 	change := src.Push.Changes[0].New
@@ -914,3 +1078,190 @@ func (s *webhookService) convertPullRequestCommentHook(src *webhookPRComment) (*
 	}
 	return dst, nil
 }
+
+//
+// review hooks
+//
+
+func (s *webhookService) convertPullRequestReviewHook(src *webhookPRReview, event string) (*scm.ReviewHook, error) {
+	pr, err := s.convertPullRequestHook(&webhook{
+		PullRequest: src.PullRequest,
+		Repository:  src.Repository,
+		Actor:       src.Actor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	approval := src.Approval
+	if approval == nil {
+		approval = src.ChangesRequest
+	}
+	if approval == nil {
+		approval = &webhookApproval{User: src.Actor}
+	}
+	dst := &scm.ReviewHook{
+		PullRequest: pr.PullRequest,
+		Repo:        pr.Repo,
+		Review: scm.Review{
+			Sha:     pr.PullRequest.Sha,
+			Link:    pr.PullRequest.Link,
+			Author:  convertWebhookActor(&approval.User),
+			Created: approval.Date,
+			Updated: approval.Date,
+		},
+	}
+	switch event {
+	case "pullrequest:approved":
+		dst.Action = scm.ActionSubmitted
+		dst.Review.State = scm.ReviewStateApproved
+	case "pullrequest:unapproved":
+		dst.Action = scm.ActionDismissed
+		dst.Review.State = scm.ReviewStateDismissed
+	case "pullrequest:changes_request_created":
+		dst.Action = scm.ActionSubmitted
+		dst.Review.State = scm.ReviewStateChangesRequested
+	case "pullrequest:changes_request_removed":
+		dst.Action = scm.ActionDismissed
+		dst.Review.State = scm.ReviewStateDismissed
+	}
+	return dst, nil
+}
+
+//
+// issue hooks
+//
+
+func convertIssueHook(src *webhookIssue, event string) *scm.IssueHook {
+	dst := &scm.IssueHook{
+		Action: scm.ActionOpen,
+		Repo:   convertWebhookRepository(&src.Repository),
+		Issue:  convertWebhookIssue(src),
+		Sender: convertWebhookActor(&src.Actor),
+	}
+	if event != "issue:updated" {
+		return dst
+	}
+	switch {
+	case src.Changes.Status != nil && isIssueClosed(src.Changes.Status.New) && !isIssueClosed(src.Changes.Status.Old):
+		dst.Action = scm.ActionClose
+	case src.Changes.Status != nil && !isIssueClosed(src.Changes.Status.New) && isIssueClosed(src.Changes.Status.Old):
+		dst.Action = scm.ActionReopen
+	case src.Changes.Assignee != nil && src.Changes.Assignee.New != nil:
+		dst.Action = scm.ActionAssigned
+	case src.Changes.Assignee != nil:
+		dst.Action = scm.ActionUnassigned
+	default:
+		dst.Action = scm.ActionUpdate
+	}
+	return dst
+}
+
+func convertIssueCommentHook(src *webhookIssue) *scm.IssueCommentHook {
+	dst := &scm.IssueCommentHook{
+		Action: scm.ActionCreate,
+		Repo:   convertWebhookRepository(&src.Repository),
+		Issue:  convertWebhookIssue(src),
+		Sender: convertWebhookActor(&src.Actor),
+	}
+	if src.Comment != nil {
+		dst.Comment = *convertIssueComment(src.Comment)
+	}
+	return dst
+}
+
+func convertWebhookIssue(src *webhookIssue) scm.Issue {
+	dst := scm.Issue{
+		Number:  src.Issue.ID,
+		Title:   src.Issue.Title,
+		Body:    src.Issue.Content.Raw,
+		Link:    src.Issue.Links.HTML.Href,
+		State:   src.Issue.State,
+		Closed:  isIssueClosed(src.Issue.State),
+		Author:  convertWebhookActor(&src.Issue.Reporter),
+		Created: src.Issue.CreatedOn,
+		Updated: src.Issue.UpdatedOn,
+	}
+	if src.Issue.Kind != "" {
+		dst.Labels = []string{src.Issue.Kind}
+	}
+	if src.Issue.Assignee != nil {
+		dst.Assignees = []scm.User{convertWebhookActor(src.Issue.Assignee)}
+	}
+	return dst
+}
+
+// isIssueClosed returns true if the bitbucket issue state
+// is one of the resolved states.
+func isIssueClosed(state string) bool {
+	switch state {
+	case "resolved", "invalid", "duplicate", "wontfix", "closed":
+		return true
+	default:
+		return false
+	}
+}
+
+//
+// repository hooks
+//
+
+func convertForkHook(src *webhookFork) *scm.ForkHook {
+	return &scm.ForkHook{
+		Repo:   convertWebhookRepository(&src.Repository),
+		Fork:   convertWebhookRepository(&src.Fork),
+		Sender: convertWebhookActor(&src.Actor),
+	}
+}
+
+func convertStatusHook(src *webhookCommitStatus, event string) *scm.StatusHook {
+	status := src.CommitStatus
+	dst := &scm.StatusHook{
+		Action: scm.ActionCreate,
+		Repo:   convertWebhookRepository(&src.Repository),
+		Status: scm.Status{
+			State:  convertState(status.State),
+			Label:  status.Key,
+			Desc:   status.Description,
+			Target: status.URL,
+			Link:   status.Links.Commit.Href,
+		},
+		Commit: scm.Commit{
+			Sha:  status.Commit.Hash,
+			Link: status.Links.Commit.Href,
+		},
+		Sender: convertWebhookActor(&src.Actor),
+	}
+	if status.Refname != "" {
+		dst.Branches = []scm.Reference{{
+			Name: scm.TrimRef(status.Refname),
+			Path: scm.ExpandRef(status.Refname, "refs/heads/"),
+			Sha:  status.Commit.Hash,
+		}}
+	}
+	if event == "repo:commit_status_updated" {
+		dst.Action = scm.ActionUpdate
+	}
+	return dst
+}
+
+func convertWebhookRepository(src *webhookRepository) scm.Repository {
+	namespace, name := scm.Split(src.FullName)
+	return scm.Repository{
+		ID:        src.UUID,
+		Namespace: namespace,
+		Name:      name,
+		FullName:  src.FullName,
+		Private:   src.IsPrivate,
+		Clone:     fmt.Sprintf("https://bitbucket.org/%s.git", src.FullName),
+		CloneSSH:  fmt.Sprintf("git@bitbucket.org:%s.git", src.FullName),
+		Link:      src.Links.HTML.Href,
+	}
+}
+
+func convertWebhookActor(src *webhookActor) scm.User {
+	return scm.User{
+		Login:  validUser(src.AccountID, src.Username),
+		Name:   src.DisplayName,
+		Avatar: src.Links.Avatar.Href,
+	}
+}
@@ -124,6 +124,88 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_declined.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		// pull request approved
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pullrequest:approved",
+			before: "testdata/webhooks/pr_approved.json",
+			after:  "testdata/webhooks/pr_approved.json.golden",
+			obj:    new(scm.ReviewHook),
+		},
+		// pull request unapproved
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pullrequest:unapproved",
+			before: "testdata/webhooks/pr_unapproved.json",
+			after:  "testdata/webhooks/pr_unapproved.json.golden",
+			obj:    new(scm.ReviewHook),
+		},
+		// pull request changes requested
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pullrequest:changes_request_created",
+			before: "testdata/webhooks/pr_changes_request_created.json",
+			after:  "testdata/webhooks/pr_changes_request_created.json.golden",
+			obj:    new(scm.ReviewHook),
+		},
+
+		//
+		// issue events
+		//
+
+		// issue created
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "issue:created",
+			before: "testdata/webhooks/issue_created.json",
+			after:  "testdata/webhooks/issue_created.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		// issue updated (resolved)
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "issue:updated",
+			before: "testdata/webhooks/issue_updated.json",
+			after:  "testdata/webhooks/issue_updated.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		// issue comment created
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "issue:comment_created",
+			before: "testdata/webhooks/issue_comment_created.json",
+			after:  "testdata/webhooks/issue_comment_created.json.golden",
+			obj:    new(scm.IssueCommentHook),
+		},
+
+		//
+		// repository events
+		//
+
+		// repository forked
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:fork",
+			before: "testdata/webhooks/repo_fork.json",
+			after:  "testdata/webhooks/repo_fork.json.golden",
+			obj:    new(scm.ForkHook),
+		},
+		// commit status created
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:commit_status_created",
+			before: "testdata/webhooks/commit_status_created.json",
+			after:  "testdata/webhooks/commit_status_created.json.golden",
+			obj:    new(scm.StatusHook),
+		},
+		// commit status updated
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:commit_status_updated",
+			before: "testdata/webhooks/commit_status_updated.json",
+			after:  "testdata/webhooks/commit_status_updated.json.golden",
+			obj:    new(scm.StatusHook),
+		},
 		// 		// pull request labeled
 		// 		{
 		// 			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
@@ -219,6 +301,18 @@ func TestWebhookValidated(t *testing.T) {
 	}
 }
 
+func TestWebhookUnknown(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/?secret=71295b197fa25f4356d2fb9965df3f2379d903d7", bytes.NewBuffer(f))
+	r.Header.Set("x-event-key", "repo:transfer")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if !scm.IsUnknownWebhook(err) {
+		t.Errorf("Expect unknown webhook error, got %v", err)
+	}
+}
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "71295b197fa25f4356d2fb9965df3f2379d903d7", nil
 }
@@ -0,0 +1,76 @@
+package fake_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksDegradeToStatus(t *testing.T) {
+	client, data := fake.NewDefault()
+	ctx := context.Background()
+
+	input := &scm.CheckRunInput{
+		Name:       "lint",
+		HeadSha:    "abc123",
+		DetailsURL: "https://ci.example.com/lint/1",
+		Status:     "in_progress",
+		Output: &scm.CheckRunOutputInput{
+			Title: "Linting",
+		},
+		PullRequest: 1,
+	}
+	run, _, err := client.Checks.CreateCheckRun(ctx, "foo/repo", input)
+	require.Equal(t, scm.ErrChecksDegraded, err)
+	require.NotNil(t, run)
+	assert.Equal(t, "lint", run.Name)
+	assert.Empty(t, data.PullRequestCommentsAdded, "no comment until the check run completes")
+
+	input.Status = "completed"
+	input.Conclusion = "failure"
+	input.Output = &scm.CheckRunOutputInput{
+		Title:   "2 problems",
+		Summary: "Found 2 problems.",
+		Annotations: []*scm.CheckRunAnnotation{
+			{Path: "main.go", StartLine: 3, EndLine: 3, Level: "failure", Message: "unused variable"},
+			{Path: "main.go", StartLine: 7, EndLine: 7, Level: "warning", Message: "missing doc comment"},
+		},
+	}
+	_, _, err = client.Checks.UpdateCheckRun(ctx, "foo/repo", run.ID, input)
+	require.Equal(t, scm.ErrChecksDegraded, err)
+
+	statuses := data.Statuses["abc123"]
+	require.Len(t, statuses, 1)
+	assert.Equal(t, scm.StateFailure, statuses[0].State)
+	assert.Equal(t, "2 problems", statuses[0].Desc)
+	assert.Equal(t, "https://ci.example.com/lint/1", statuses[0].Target)
+
+	require.Len(t, data.PullRequestCommentsAdded, 1)
+	comment := data.PullRequestCommentsAdded[0]
+	assert.True(t, strings.HasPrefix(comment, "foo/repo#1:**lint**: failure"), comment)
+	assert.Contains(t, comment, "- **failure** `main.go:3`: unused variable")
+
+	input.Conclusion = "success"
+	input.Output = &scm.CheckRunOutputInput{Title: "No problems"}
+	_, _, err = client.Checks.UpdateCheckRun(ctx, "foo/repo", run.ID, input)
+	require.Equal(t, scm.ErrChecksDegraded, err)
+
+	require.Len(t, data.PullRequestCommentsAdded, 1, "the summary comment is edited")
+	comments := data.PullRequestComments[1]
+	require.Len(t, comments, 1)
+	assert.True(t, strings.HasPrefix(comments[0].Body, "**lint**: success"), comments[0].Body)
+
+	runs, _, err := client.Checks.ListCheckRuns(ctx, "foo/repo", "abc123", scm.CheckRunListOptions{})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "completed", runs[0].Status)
+	assert.Equal(t, "success", runs[0].Conclusion)
+
+	_, _, err = client.Checks.ListCheckSuites(ctx, "foo/repo", "abc123", scm.ListOptions{})
+	assert.Equal(t, scm.ErrNotSupported, err)
+}
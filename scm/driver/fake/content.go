@@ -2,6 +2,8 @@ package fake
 
 import (
 	"context"
+	"crypto/sha1" // #nosec
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -108,6 +110,76 @@ func (c contentService) Delete(_ context.Context, repo, path, ref string) (*scm.
 	return nil, nil
 }
 
+func (c contentService) CommitFiles(_ context.Context, repo string, params *scm.CommitFilesParams) (*scm.Commit, *scm.Response, error) {
+	branch := params.Branch
+	if branch == "" {
+		branch = params.Base
+	}
+	hash := sha1.New() // #nosec
+	fmt.Fprintf(hash, "%s\n%s\n", branch, params.Message)
+	for _, change := range params.Changes {
+		f, err := c.path(repo, change.Path, branch)
+		if err != nil {
+			return nil, nil, err
+		}
+		data := change.Data
+		switch change.Action {
+		case scm.FileDelete:
+			if err := os.Remove(f); err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to delete file %s", f)
+			}
+			data = nil
+		case scm.FileMove:
+			from, err := c.path(repo, change.PreviousPath, branch)
+			if err != nil {
+				return nil, nil, err
+			}
+			if data == nil {
+				data, err = ioutil.ReadFile(from) // #nosec
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "failed to read file %s", from)
+				}
+			}
+			if err := os.Remove(from); err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to delete file %s", from)
+			}
+		}
+		if change.Action != scm.FileDelete && change.Action != scm.FileChmod {
+			if err := os.MkdirAll(filepath.Dir(f), os.ModePerm); err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to create directory for %s", f)
+			}
+			if err := ioutil.WriteFile(f, data, DefaultFileWritePermissions); err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to write file %s", f)
+			}
+		}
+		if change.Executable != nil {
+			mode := os.FileMode(DefaultFileWritePermissions)
+			if *change.Executable {
+				mode = 0755
+			}
+			if err := os.Chmod(f, mode); err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to change the mode of file %s", f)
+			}
+		}
+		fmt.Fprintf(hash, "%s %s %s\n%s\n", change.Action, change.PreviousPath, change.Path, data)
+	}
+
+	commit := &scm.Commit{
+		Sha:     fmt.Sprintf("%x", hash.Sum(nil)),
+		Message: params.Message,
+		Author:  params.Author,
+	}
+	c.data.Commits[commit.Sha] = commit
+	for _, ref := range c.data.Branches[repo] {
+		if ref.Name == branch {
+			ref.Sha = commit.Sha
+			return commit, nil, nil
+		}
+	}
+	c.data.Branches[repo] = append(c.data.Branches[repo], &scm.Reference{Name: branch, Path: scm.ExpandRef(branch, "refs/heads"), Sha: commit.Sha})
+	return commit, nil, nil
+}
+
 func (c contentService) path(repo string, path string, ref string) (string, error) {
 	if c.data.ContentDir == "" {
 		return "", errors.Errorf("no data.ContentDir configured")
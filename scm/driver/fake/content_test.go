@@ -2,10 +2,13 @@ package fake_test
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/jenkins-x/go-scm/scm"
 	"github.com/jenkins-x/go-scm/scm/driver/fake"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -77,3 +80,53 @@ func TestContentWithRefs(t *testing.T) {
 		t.Logf("loaded repo %s path %s ref %s got %s\n", repo, ref, path, text)
 	}
 }
+
+func TestContentCommitFiles(t *testing.T) {
+	client, fakeData := fake.NewDefault()
+	dir, err := ioutil.TempDir("", "test-commit-files-")
+	require.NoError(t, err, "failed to create temp dir")
+	defer os.RemoveAll(dir)
+	fakeData.ContentDir = dir
+
+	ctx := context.Background()
+	repo := "myorg/myrepo"
+	repoDir := filepath.Join(dir, "myorg", "myrepo")
+	require.NoError(t, os.MkdirAll(repoDir, os.ModePerm))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(repoDir, "README.md"), []byte("hello"), fake.DefaultFileWritePermissions))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(repoDir, "old.txt"), []byte("old"), fake.DefaultFileWritePermissions))
+
+	executable := true
+	commit, _, err := client.Contents.CommitFiles(ctx, repo, &scm.CommitFilesParams{
+		Base:    "master",
+		Message: "add build script",
+		Changes: []scm.FileChange{
+			{Action: scm.FileCreate, Path: "scripts/build.sh", Data: []byte("make"), Executable: &executable},
+			{Action: scm.FileMove, Path: "docs/README.md", PreviousPath: "README.md"},
+			{Action: scm.FileDelete, Path: "old.txt"},
+		},
+	})
+	require.NoError(t, err, "failed to commit files")
+	assert.NotEmpty(t, commit.Sha)
+
+	c, _, err := client.Contents.Find(ctx, repo, "docs/README.md", "master")
+	require.NoError(t, err, "failed to find moved file")
+	assert.Equal(t, "hello", string(c.Data))
+
+	info, err := os.Stat(filepath.Join(repoDir, "scripts", "build.sh"))
+	require.NoError(t, err, "failed to stat created file")
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+	for _, path := range []string{"README.md", "old.txt"} {
+		_, _, err = client.Contents.Find(ctx, repo, path, "master")
+		assert.Error(t, err, "file %s should have been removed", path)
+	}
+
+	found, _, err := client.Git.FindCommit(ctx, repo, commit.Sha)
+	require.NoError(t, err)
+	assert.Equal(t, commit, found)
+
+	branches, _, err := client.Git.ListBranches(ctx, repo, scm.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, branches, 1)
+	assert.Equal(t, commit.Sha, branches[0].Sha)
+}
@@ -26,17 +26,37 @@ type Data struct {
 	PullRequestsCreated        map[int]*scm.PullRequestInput
 	PullRequestID              int
 	CreateRepositories         []*scm.RepositoryInput
-	Organizations              []*scm.Organization
-	Repositories               []*scm.Repository
-	CurrentUser                scm.User
-	Users                      []*scm.User
-	Hooks                      map[string][]*scm.Hook
-	Releases                   map[string]map[int]*scm.Release
-	Deployments                map[string][]*scm.Deployment
-	DeploymentStatus           map[string][]*scm.DeploymentStatus
+	// settings passed to Update keyed by repository full name
+	UpdatedRepositories map[string][]*scm.RepositorySettings
+	Organizations       []*scm.Organization
+	Repositories        []*scm.Repository
+	CurrentUser         scm.User
+	Users               []*scm.User
+	Hooks               map[string][]*scm.Hook
+	OrgHooks            map[string][]*scm.Hook
+	Releases            map[string]map[int]*scm.Release
+	Deployments         map[string][]*scm.Deployment
+	DeploymentStatus    map[string][]*scm.DeploymentStatus
+	DeployKeys          map[string][]*scm.DeployKey
+
+	// secrets and their values keyed by org, org/repo or
+	// org/repo:environment, then by secret name
+	Secrets      map[string]map[string]*scm.Secret
+	SecretValues map[string]map[string]string
+
+	// teams keyed by org
+	Teams map[string][]*scm.Team
+	// member roles and repository permissions keyed by
+	// org/team, then by user or repository
+	TeamMembers      map[string]map[string]string
+	TeamRepositories map[string]map[string]string
+	// member roles keyed by org, then by user
+	OrgMemberRoles map[string]map[string]string
 
 	//All Labels That Exist In The Repo
 	RepoLabelsExisting []string
+	// color and description of the existing labels keyed by name
+	RepoLabels map[string]*scm.Label
 	// org/repo#number:label
 	IssueLabelsAdded    []string
 	IssueLabelsExisting []string
@@ -47,8 +67,9 @@ type Data struct {
 	// org/repo#issuecommentid
 	IssueCommentsDeleted []string
 
+	// org/repo#number:reaction
+	IssueReactionsAdded []string
 	// org/repo#issuecommentid:reaction
-	IssueReactionsAdded   []string
 	CommentReactionsAdded []string
 
 	// org/repo#number:assignee
@@ -69,6 +90,11 @@ type Data struct {
 	// A list of refs that got deleted via DeleteRef
 	RefsDeleted []DeletedRef
 
+	// Branches and Tags are the git references of each
+	// repository keyed by the repository full name
+	Branches map[string][]*scm.Reference
+	Tags     map[string][]*scm.Reference
+
 	UserPermissions map[string]map[string]string
 
 	// Invitations the current pending invitations
@@ -115,7 +141,19 @@ func NewData() *Data {
 		AssigneesAdded:            []string{},
 		UserPermissions:           map[string]map[string]string{},
 		Hooks:                     map[string][]*scm.Hook{},
+		OrgHooks:                  map[string][]*scm.Hook{},
 		Deployments:               map[string][]*scm.Deployment{},
 		DeploymentStatus:          map[string][]*scm.DeploymentStatus{},
+		DeployKeys:                map[string][]*scm.DeployKey{},
+		UpdatedRepositories:       map[string][]*scm.RepositorySettings{},
+		Secrets:                   map[string]map[string]*scm.Secret{},
+		SecretValues:              map[string]map[string]string{},
+		Teams:                     map[string][]*scm.Team{},
+		TeamMembers:               map[string]map[string]string{},
+		TeamRepositories:          map[string]map[string]string{},
+		OrgMemberRoles:            map[string]map[string]string{},
+		Branches:                  map[string][]*scm.Reference{},
+		Tags:                      map[string][]*scm.Reference{},
+		RepoLabels:                map[string]*scm.Label{},
 	}
 }
@@ -0,0 +1,68 @@
+package fake
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type deployKeyService struct {
+	client *wrapper
+	data   *Data
+}
+
+func (s *deployKeyService) Find(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	for _, k := range s.data.DeployKeys[repo] {
+		if k.ID == id {
+			return k, nil, nil
+		}
+	}
+	return nil, nil, scm.ErrNotFound
+}
+
+func (s *deployKeyService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	return s.data.DeployKeys[repo], nil, nil
+}
+
+func (s *deployKeyService) Create(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	keys := s.data.DeployKeys[repo]
+	k := &scm.DeployKey{
+		ID:       "key-" + strconv.Itoa(len(keys)+1),
+		Title:    input.Title,
+		Key:      input.Key,
+		ReadOnly: input.ReadOnly,
+	}
+	s.data.DeployKeys[repo] = append(keys, k)
+	return k, nil, nil
+}
+
+func (s *deployKeyService) Delete(ctx context.Context, repo, id string) (*scm.Response, error) {
+	keys := s.data.DeployKeys[repo]
+	for i, k := range keys {
+		if k.ID == id {
+			s.data.DeployKeys[repo] = append(keys[:i:i], keys[i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, scm.ErrNotFound
+}
+
+// Enable copies a deploy key of another repository to the
+// repository as a read-only key.
+func (s *deployKeyService) Enable(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	for other, keys := range s.data.DeployKeys {
+		if other == repo {
+			continue
+		}
+		for _, k := range keys {
+			if k.ID == id {
+				enabled := *k
+				enabled.ReadOnly = true
+				s.data.DeployKeys[repo] = append(s.data.DeployKeys[repo], &enabled)
+				return &enabled, nil, nil
+			}
+		}
+	}
+	return nil, nil, scm.ErrNotFound
+}
@@ -0,0 +1,40 @@
+package fake_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployKeys(t *testing.T) {
+	client, _ := fake.NewDefault()
+	ctx := context.Background()
+	repo := "myorg/myrepo"
+
+	input := &scm.DeployKeyInput{
+		Title:    "gitops-agent",
+		Key:      "ssh-rsa AAA...",
+		ReadOnly: true,
+	}
+	key, _, err := client.DeployKeys.Create(ctx, repo, input)
+	require.NoError(t, err, "failed to create deploy key in repo %s", repo)
+
+	found, _, err := client.DeployKeys.Find(ctx, repo, key.ID)
+	require.NoError(t, err, "failed to find deploy key %s in repo %s", key.ID, repo)
+	assert.Equal(t, key, found)
+
+	enabled, _, err := client.DeployKeys.Enable(ctx, "myorg/other", key.ID)
+	require.NoError(t, err, "failed to enable deploy key %s in repo myorg/other", key.ID)
+	assert.Equal(t, input.Key, enabled.Key)
+
+	_, err = client.DeployKeys.Delete(ctx, repo, key.ID)
+	require.NoError(t, err, "failed to delete deploy key %s in repo %s", key.ID, repo)
+
+	keys, _, err := client.DeployKeys.List(ctx, repo, scm.ListOptions{})
+	require.NoError(t, err, "failed to list deploy keys in repo %s", repo)
+	assert.Empty(t, keys)
+}
@@ -23,8 +23,10 @@ func NewDefault() (*scm.Client, *Data) {
 	// initialize services
 	client.Driver = scm.DriverFake
 
+	client.Checks = scm.NewStatusChecksService(client.Client)
 	client.Contents = &contentService{client: client, data: data}
 	client.Deployments = &deploymentService{client: client, data: data}
+	client.DeployKeys = &deployKeyService{client: client, data: data}
 	client.Git = &gitService{client: client, data: data}
 	client.Issues = &issueService{client: client, data: data}
 	client.Organizations = &organizationService{client: client, data: data}
@@ -32,6 +34,7 @@ func NewDefault() (*scm.Client, *Data) {
 	client.Repositories = &repositoryService{client: client, data: data}
 	client.Releases = &releaseService{client: client, data: data}
 	client.Reviews = &reviewService{client: client, data: data}
+	client.Secrets = &secretService{client: client, data: data}
 	client.Users = &userService{client: client, data: data}
 
 	client.Username = data.CurrentUser.Login
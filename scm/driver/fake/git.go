@@ -48,7 +48,7 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 }
 
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
-	panic("implement me")
+	return append([]*scm.Reference{}, s.data.Branches[repo]...), nil, nil
 }
 
 func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
@@ -64,5 +64,5 @@ func (s *gitService) CompareCommits(ctx context.Context, repo, ref1, ref2 string
 }
 
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
-	panic("implement me")
+	return append([]*scm.Reference{}, s.data.Tags[repo]...), nil, nil
 }
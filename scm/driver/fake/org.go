@@ -3,6 +3,8 @@ package fake
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"strings"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -142,3 +144,204 @@ func (s *organizationService) AcceptOrganizationInvitation(_ context.Context, or
 	}
 	return nil, scm.ErrNotFound
 }
+
+func (s *organizationService) ListHooks(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	return s.data.OrgHooks[org], nil, nil
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	/* #nosec */
+	hook := &scm.Hook{
+		ID:         fmt.Sprintf("%d", rand.Int()),
+		Name:       input.Name,
+		Target:     input.Target,
+		Events:     input.NativeEvents,
+		Active:     true,
+		SkipVerify: input.SkipVerify,
+	}
+	s.data.OrgHooks[org] = append(s.data.OrgHooks[org], hook)
+	return hook, nil, nil
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	for _, h := range s.data.OrgHooks[org] {
+		if h.ID == input.ID {
+			h.Name = input.Name
+			h.Target = input.Target
+			h.Events = input.NativeEvents
+			h.Active = true
+			h.SkipVerify = input.SkipVerify
+			return h, nil, nil
+		}
+	}
+	return nil, nil, scm.ErrNotFound
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, org string, id string) (*scm.Response, error) {
+	hooks := s.data.OrgHooks[org]
+	for i, h := range hooks {
+		if h.ID == id {
+			s.data.OrgHooks[org] = append(hooks[0:i], hooks[i+1:]...)
+			break
+		}
+	}
+	return nil, nil
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, org string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	team := &scm.Team{
+		ID:           len(s.data.Teams[org]) + 1,
+		Name:         input.Name,
+		Slug:         input.Slug,
+		Description:  input.Description,
+		Privacy:      input.Privacy,
+		ParentTeamID: input.ParentTeamID,
+	}
+	if team.Slug == "" {
+		team.Slug = strings.ToLower(strings.ReplaceAll(input.Name, " ", "-"))
+	}
+	s.data.Teams[org] = append(s.data.Teams[org], team)
+	return team, nil, nil
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, org, slug string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	team := s.findTeam(org, slug)
+	if team == nil {
+		return nil, nil, scm.ErrNotFound
+	}
+	if input.Name != "" {
+		team.Name = input.Name
+	}
+	if input.Slug != "" {
+		team.Slug = input.Slug
+	}
+	if input.Description != "" {
+		team.Description = input.Description
+	}
+	if input.Privacy != "" {
+		team.Privacy = input.Privacy
+	}
+	if input.ParentTeamID != 0 {
+		team.ParentTeamID = input.ParentTeamID
+	}
+	return team, nil, nil
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, org, slug string) (*scm.Response, error) {
+	teams := s.data.Teams[org]
+	for i, t := range teams {
+		if t.Slug == slug {
+			s.data.Teams[org] = append(teams[:i], teams[i+1:]...)
+			delete(s.data.TeamMembers, org+"/"+slug)
+			delete(s.data.TeamRepositories, org+"/"+slug)
+			return nil, nil
+		}
+	}
+	return nil, scm.ErrNotFound
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, org, slug, user, role string) (*scm.Response, error) {
+	if s.findTeam(org, slug) == nil {
+		return nil, scm.ErrNotFound
+	}
+	key := org + "/" + slug
+	if s.data.TeamMembers[key] == nil {
+		s.data.TeamMembers[key] = map[string]string{}
+	}
+	s.data.TeamMembers[key][user] = role
+	return nil, nil
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, org, slug, user string) (*scm.Response, error) {
+	key := org + "/" + slug
+	if _, ok := s.data.TeamMembers[key][user]; !ok {
+		return nil, scm.ErrNotFound
+	}
+	delete(s.data.TeamMembers[key], user)
+	return nil, nil
+}
+
+func (s *organizationService) AddTeamRepository(ctx context.Context, org, slug, repo, permission string) (*scm.Response, error) {
+	if s.findTeam(org, slug) == nil {
+		return nil, scm.ErrNotFound
+	}
+	key := org + "/" + slug
+	if s.data.TeamRepositories[key] == nil {
+		s.data.TeamRepositories[key] = map[string]string{}
+	}
+	s.data.TeamRepositories[key][repo] = permission
+	return nil, nil
+}
+
+func (s *organizationService) RemoveTeamRepository(ctx context.Context, org, slug, repo string) (*scm.Response, error) {
+	key := org + "/" + slug
+	if _, ok := s.data.TeamRepositories[key][repo]; !ok {
+		return nil, scm.ErrNotFound
+	}
+	delete(s.data.TeamRepositories[key], repo)
+	return nil, nil
+}
+
+func (s *organizationService) InviteMember(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	if !s.isOrgMember(org, user) {
+		s.data.OrgMembers[org] = append(s.data.OrgMembers[org], user)
+	}
+	return s.SetMemberRole(ctx, org, user, role)
+}
+
+func (s *organizationService) RemoveMember(ctx context.Context, org, user string) (*scm.Response, error) {
+	members := s.data.OrgMembers[org]
+	for i, m := range members {
+		if m == user {
+			s.data.OrgMembers[org] = append(members[:i], members[i+1:]...)
+			delete(s.data.OrgMemberRoles[org], user)
+			return nil, nil
+		}
+	}
+	return nil, scm.ErrNotFound
+}
+
+func (s *organizationService) SetMemberRole(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	if !s.isOrgMember(org, user) {
+		return nil, scm.ErrNotFound
+	}
+	if s.data.OrgMemberRoles[org] == nil {
+		s.data.OrgMemberRoles[org] = map[string]string{}
+	}
+	s.data.OrgMemberRoles[org][user] = role
+	return nil, nil
+}
+
+// ListOutsideCollaborators returns the collaborators that are
+// not members of the organization.
+func (s *organizationService) ListOutsideCollaborators(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.TeamMember, *scm.Response, error) {
+	members := map[string]bool{}
+	for _, m := range s.data.OrgMembers[org] {
+		members[m] = true
+	}
+	var out []*scm.TeamMember
+	for _, c := range s.data.Collaborators {
+		if !members[c] {
+			out = append(out, &scm.TeamMember{Login: c})
+		}
+	}
+	return out, nil, nil
+}
+
+func (s *organizationService) findTeam(org, slug string) *scm.Team {
+	for _, t := range s.data.Teams[org] {
+		if t.Slug == slug {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *organizationService) isOrgMember(org, user string) bool {
+	for _, m := range s.data.OrgMembers[org] {
+		if m == user {
+			return true
+		}
+	}
+	return false
+}
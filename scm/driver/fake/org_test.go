@@ -0,0 +1,66 @@
+package fake_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationTeams(t *testing.T) {
+	client, data := fake.NewDefault()
+	ctx := context.Background()
+	org := "myorg"
+
+	team, _, err := client.Organizations.CreateTeam(ctx, org, &scm.Team{Name: "Release Managers"})
+	require.NoError(t, err, "failed to create team in org %s", org)
+	assert.Equal(t, "release-managers", team.Slug)
+
+	updated, _, err := client.Organizations.UpdateTeam(ctx, org, team.Slug, &scm.Team{Description: "Cut releases"})
+	require.NoError(t, err, "failed to update team %s", team.Slug)
+	assert.Equal(t, "Release Managers", updated.Name)
+	assert.Equal(t, "Cut releases", updated.Description)
+
+	_, err = client.Organizations.AddTeamMember(ctx, org, team.Slug, "alice", scm.MaintainerRole)
+	require.NoError(t, err, "failed to add member to team %s", team.Slug)
+	_, err = client.Organizations.AddTeamRepository(ctx, org, team.Slug, "myorg/myrepo", scm.WritePermission)
+	require.NoError(t, err, "failed to add repository to team %s", team.Slug)
+	assert.Equal(t, map[string]string{"alice": scm.MaintainerRole}, data.TeamMembers["myorg/release-managers"])
+	assert.Equal(t, map[string]string{"myorg/myrepo": scm.WritePermission}, data.TeamRepositories["myorg/release-managers"])
+
+	_, err = client.Organizations.RemoveTeamMember(ctx, org, team.Slug, "bob")
+	assert.Equal(t, scm.ErrNotFound, err)
+
+	_, err = client.Organizations.DeleteTeam(ctx, org, team.Slug)
+	require.NoError(t, err, "failed to delete team %s", team.Slug)
+	assert.Empty(t, data.Teams[org])
+	assert.Empty(t, data.TeamMembers)
+}
+
+func TestOrganizationMembers(t *testing.T) {
+	client, data := fake.NewDefault()
+	ctx := context.Background()
+	org := "myorg"
+	data.Collaborators = []string{"alice", "bob"}
+
+	_, err := client.Organizations.SetMemberRole(ctx, org, "alice", scm.AdminRole)
+	assert.Equal(t, scm.ErrNotFound, err)
+
+	_, err = client.Organizations.InviteMember(ctx, org, "alice", scm.MemberRole)
+	require.NoError(t, err, "failed to invite member to org %s", org)
+	_, err = client.Organizations.SetMemberRole(ctx, org, "alice", scm.AdminRole)
+	require.NoError(t, err, "failed to set member role in org %s", org)
+	assert.Equal(t, []string{"alice"}, data.OrgMembers[org])
+	assert.Equal(t, scm.AdminRole, data.OrgMemberRoles[org]["alice"])
+
+	outside, _, err := client.Organizations.ListOutsideCollaborators(ctx, org, scm.ListOptions{})
+	require.NoError(t, err, "failed to list outside collaborators of org %s", org)
+	assert.Equal(t, []*scm.TeamMember{{Login: "bob"}}, outside)
+
+	_, err = client.Organizations.RemoveMember(ctx, org, "alice")
+	require.NoError(t, err, "failed to remove member from org %s", org)
+	assert.Empty(t, data.OrgMembers[org])
+}
@@ -173,7 +173,14 @@ func (s *pullService) DeleteComment(ctx context.Context, repo string, number int
 }
 
 func (s *pullService) EditComment(ctx context.Context, repo string, number int, id int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	f := s.data
+	for _, comment := range f.PullRequestComments[number] {
+		if comment.ID == id {
+			comment.Body = input.Body
+			return comment, nil, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("could not find pull request comment %d", id)
 }
 
 func (s *pullService) AssignIssue(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
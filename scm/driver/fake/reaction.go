@@ -0,0 +1,89 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+func (s *issueService) ListReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return listReactions(s.data.IssueReactionsAdded, fmt.Sprintf("%s#%d", repo, number)), nil, nil
+}
+
+func (s *issueService) CreateReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	return addReaction(&s.data.IssueReactionsAdded, fmt.Sprintf("%s#%d", repo, number), content), nil, nil
+}
+
+func (s *issueService) DeleteReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Response, error) {
+	return nil, removeReaction(&s.data.IssueReactionsAdded, fmt.Sprintf("%s#%d", repo, number), content)
+}
+
+func (s *issueService) ListCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return listReactions(s.data.CommentReactionsAdded, fmt.Sprintf("%s#%d", repo, id)), nil, nil
+}
+
+func (s *issueService) CreateCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	return addReaction(&s.data.CommentReactionsAdded, fmt.Sprintf("%s#%d", repo, id), content), nil, nil
+}
+
+func (s *issueService) DeleteCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Response, error) {
+	return nil, removeReaction(&s.data.CommentReactionsAdded, fmt.Sprintf("%s#%d", repo, id), content)
+}
+
+func (s *pullService) ListReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return listReactions(s.data.IssueReactionsAdded, fmt.Sprintf("%s#%d", repo, number)), nil, nil
+}
+
+func (s *pullService) CreateReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	return addReaction(&s.data.IssueReactionsAdded, fmt.Sprintf("%s#%d", repo, number), content), nil, nil
+}
+
+func (s *pullService) DeleteReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Response, error) {
+	return nil, removeReaction(&s.data.IssueReactionsAdded, fmt.Sprintf("%s#%d", repo, number), content)
+}
+
+func (s *pullService) ListCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return listReactions(s.data.CommentReactionsAdded, fmt.Sprintf("%s#%d", repo, id)), nil, nil
+}
+
+func (s *pullService) CreateCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	return addReaction(&s.data.CommentReactionsAdded, fmt.Sprintf("%s#%d", repo, id), content), nil, nil
+}
+
+func (s *pullService) DeleteCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Response, error) {
+	return nil, removeReaction(&s.data.CommentReactionsAdded, fmt.Sprintf("%s#%d", repo, id), content)
+}
+
+func listReactions(added []string, key string) []*scm.Reaction {
+	answer := []*scm.Reaction{}
+	for _, r := range added {
+		if strings.HasPrefix(r, key+":") {
+			answer = append(answer, &scm.Reaction{
+				Content: scm.ToReactionContent(strings.TrimPrefix(r, key+":")),
+				Author:  scm.User{Login: botName},
+			})
+		}
+	}
+	return answer
+}
+
+func addReaction(added *[]string, key string, content scm.ReactionContent) *scm.Reaction {
+	*added = append(*added, fmt.Sprintf("%s:%s", key, content))
+	return &scm.Reaction{
+		Content: content,
+		Author:  scm.User{Login: botName},
+	}
+}
+
+func removeReaction(added *[]string, key string, content scm.ReactionContent) error {
+	reaction := fmt.Sprintf("%s:%s", key, content)
+	for i, r := range *added {
+		if r == reaction {
+			*added = append((*added)[:i], (*added)[i+1:]...)
+			return nil
+		}
+	}
+	return scm.ErrNotFound
+}
@@ -0,0 +1,45 @@
+package fake
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+func TestReactions(t *testing.T) {
+	ctx := context.Background()
+	client, data := NewDefault()
+
+	if _, _, err := client.Issues.CreateReaction(ctx, "test/test", 1, scm.ReactionEyes); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.PullRequests.CreateCommentReaction(ctx, "test/test", 2, 5, scm.ReactionPlusOne); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"test/test#1:eyes"}; !reflect.DeepEqual(data.IssueReactionsAdded, want) {
+		t.Errorf("IssueReactionsAdded got %#v, want %#v", data.IssueReactionsAdded, want)
+	}
+	if want := []string{"test/test#5:+1"}; !reflect.DeepEqual(data.CommentReactionsAdded, want) {
+		t.Errorf("CommentReactionsAdded got %#v, want %#v", data.CommentReactionsAdded, want)
+	}
+
+	got, _, err := client.PullRequests.ListCommentReactions(ctx, "test/test", 2, 5, scm.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Content != scm.ReactionPlusOne {
+		t.Errorf("ListCommentReactions got %#v", got)
+	}
+
+	if _, err := client.Issues.DeleteReaction(ctx, "test/test", 1, scm.ReactionEyes); err != nil {
+		t.Fatal(err)
+	}
+	if len(data.IssueReactionsAdded) != 0 {
+		t.Errorf("IssueReactionsAdded got %#v, want none", data.IssueReactionsAdded)
+	}
+	if _, err := client.Issues.DeleteReaction(ctx, "test/test", 1, scm.ReactionEyes); err != scm.ErrNotFound {
+		t.Errorf("Expect ErrNotFound, got %v", err)
+	}
+}
@@ -137,11 +137,77 @@ func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions)
 	f := s.data
 	la := []*scm.Label{}
 	for _, l := range f.RepoLabelsExisting {
+		if details, ok := f.RepoLabels[l]; ok {
+			la = append(la, details)
+			continue
+		}
 		la = append(la, &scm.Label{Name: l})
 	}
 	return la, nil, nil
 }
 
+func (s *repositoryService) CreateLabel(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	f := s.data
+	for _, l := range f.RepoLabelsExisting {
+		if l == input.Name {
+			return nil, nil, fmt.Errorf("label %s already exists in %s", input.Name, repo)
+		}
+	}
+	label := &scm.Label{
+		Name:        input.Name,
+		Color:       strings.TrimPrefix(input.Color, "#"),
+		Description: input.Description,
+	}
+	f.RepoLabelsExisting = append(f.RepoLabelsExisting, label.Name)
+	if f.RepoLabels == nil {
+		f.RepoLabels = map[string]*scm.Label{}
+	}
+	f.RepoLabels[label.Name] = label
+	return label, nil, nil
+}
+
+func (s *repositoryService) UpdateLabel(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	f := s.data
+	for i, l := range f.RepoLabelsExisting {
+		if l != name {
+			continue
+		}
+		label, ok := f.RepoLabels[name]
+		if !ok {
+			label = &scm.Label{Name: name}
+		}
+		if input.Name != "" {
+			label.Name = input.Name
+		}
+		if input.Color != "" {
+			label.Color = strings.TrimPrefix(input.Color, "#")
+		}
+		if input.Description != "" {
+			label.Description = input.Description
+		}
+		f.RepoLabelsExisting[i] = label.Name
+		if f.RepoLabels == nil {
+			f.RepoLabels = map[string]*scm.Label{}
+		}
+		delete(f.RepoLabels, name)
+		f.RepoLabels[label.Name] = label
+		return label, nil, nil
+	}
+	return nil, nil, scm.ErrNotFound
+}
+
+func (s *repositoryService) DeleteLabel(ctx context.Context, repo, name string) (*scm.Response, error) {
+	f := s.data
+	for i, l := range f.RepoLabelsExisting {
+		if l == name {
+			f.RepoLabelsExisting = append(f.RepoLabelsExisting[:i], f.RepoLabelsExisting[i+1:]...)
+			delete(f.RepoLabels, name)
+			return nil, nil
+		}
+	}
+	return nil, scm.ErrNotFound
+}
+
 func (s *repositoryService) ListStatus(ctx context.Context, repo string, ref string, opt scm.ListOptions) ([]*scm.Status, *scm.Response, error) {
 	f := s.data
 	result := make([]*scm.Status, 0, len(f.Statuses))
@@ -183,17 +249,33 @@ func (s *repositoryService) ListHooks(ctx context.Context, fullName string, opts
 func (s *repositoryService) CreateHook(ctx context.Context, fullName string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	/* #nosec */
 	hook := &scm.Hook{
-		ID:     fmt.Sprintf("%d", rand.Int()),
-		Name:   input.Name,
-		Target: input.Target,
-		Active: true,
+		ID:         fmt.Sprintf("%d", rand.Int()),
+		Name:       input.Name,
+		Target:     input.Target,
+		Events:     input.NativeEvents,
+		Active:     true,
+		SkipVerify: input.SkipVerify,
 	}
 	s.data.Hooks[fullName] = append(s.data.Hooks[fullName], hook)
 	return hook, nil, nil
 }
 
-func (s *repositoryService) UpdateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *repositoryService) UpdateHook(ctx context.Context, fullName string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	for _, h := range s.data.Hooks[fullName] {
+		if h.ID == input.ID {
+			h.Name = input.Name
+			h.Target = input.Target
+			h.Events = input.NativeEvents
+			h.Active = true
+			h.SkipVerify = input.SkipVerify
+			return h, nil, nil
+		}
+	}
+	return nil, nil, scm.ErrNotFound
+}
+
+func (s *repositoryService) EnsureHook(ctx context.Context, fullName string, input *scm.HookInput) (*scm.EnsureHookResult, *scm.Response, error) {
+	return scm.EnsureHook(ctx, s, fullName, input, input.NativeEvents)
 }
 
 func (s *repositoryService) DeleteHook(ctx context.Context, fullName string, hookID string) (*scm.Response, error) {
@@ -228,3 +310,47 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo string, ref s
 func (s *repositoryService) Delete(context.Context, string) (*scm.Response, error) {
 	panic("implement me")
 }
+
+func (s *repositoryService) Update(ctx context.Context, fullName string, input *scm.RepositorySettings) (*scm.Repository, *scm.Response, error) {
+	repo, res, err := s.Find(ctx, fullName)
+	if err != nil {
+		return nil, res, err
+	}
+	s.data.UpdatedRepositories[fullName] = append(s.data.UpdatedRepositories[fullName], input)
+	if input.DefaultBranch != "" {
+		repo.Branch = input.DefaultBranch
+	}
+	if input.Visibility != "" {
+		repo.Private = input.Visibility != scm.VisibilityPublic
+	}
+	if input.Archived != nil {
+		repo.Archived = *input.Archived
+	}
+	return repo, res, nil
+}
+
+func (s *repositoryService) Rename(ctx context.Context, fullName, name string) (*scm.Repository, *scm.Response, error) {
+	repo, res, err := s.Find(ctx, fullName)
+	if err != nil {
+		return nil, res, err
+	}
+	moveRepository(repo, repo.Namespace, name)
+	return repo, res, nil
+}
+
+func (s *repositoryService) Transfer(ctx context.Context, fullName, namespace string) (*scm.Repository, *scm.Response, error) {
+	repo, res, err := s.Find(ctx, fullName)
+	if err != nil {
+		return nil, res, err
+	}
+	moveRepository(repo, namespace, repo.Name)
+	return repo, res, nil
+}
+
+func moveRepository(repo *scm.Repository, namespace, name string) {
+	repo.Namespace = namespace
+	repo.Name = name
+	repo.FullName = scm.Join(namespace, name)
+	repo.Link = fmt.Sprintf("https://fake.com/%s.git", repo.FullName)
+	repo.Clone = repo.Link
+}
@@ -61,6 +61,39 @@ func TestHookCreateDelete(t *testing.T) {
 	}
 }
 
+func TestHookEnsure(t *testing.T) {
+	client, _ := fake.NewDefault()
+
+	in := &scm.HookInput{
+		Target:       "https://example.com",
+		NativeEvents: []string{"push"},
+	}
+
+	result, _, err := client.Repositories.EnsureHook(context.Background(), "foo/repo", in)
+	require.NoError(t, err)
+	assert.True(t, result.Created)
+
+	// a duplicate hook should be removed
+	_, _, err = client.Repositories.CreateHook(context.Background(), "foo/repo", in)
+	require.NoError(t, err)
+
+	result, _, err = client.Repositories.EnsureHook(context.Background(), "foo/repo", in)
+	require.NoError(t, err)
+	assert.False(t, result.Created)
+	assert.False(t, result.Updated)
+	assert.Len(t, result.Deleted, 1)
+
+	in.NativeEvents = []string{"push", "pull_request"}
+	result, _, err = client.Repositories.EnsureHook(context.Background(), "foo/repo", in)
+	require.NoError(t, err)
+	assert.True(t, result.Updated)
+	assert.Equal(t, []string{"push", "pull_request"}, result.Hook.Events)
+
+	hooks, _, err := client.Repositories.ListHooks(context.Background(), "foo/repo", scm.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, hooks, 1)
+}
+
 func TestForkRepository(t *testing.T) {
 	client, _ := fake.NewDefault()
 
@@ -92,3 +125,33 @@ func TestForkRepository(t *testing.T) {
 	repository := fake.AssertRepoExists(t, ctx, client, forkFullName)
 	assert.Equal(t, expectedGitURL, repository.Clone, "forked repository clone URL")
 }
+
+func TestUpdateRepository(t *testing.T) {
+	client, data := fake.NewDefault()
+	ctx := context.Background()
+
+	_, _, err := client.Repositories.Create(ctx, &scm.RepositoryInput{Namespace: "myorg", Name: "myrepo"})
+	require.NoError(t, err, "failed to create repository")
+
+	archived := true
+	in := &scm.RepositorySettings{
+		Visibility:    scm.VisibilityPrivate,
+		DefaultBranch: "main",
+		Archived:      &archived,
+	}
+	repo, _, err := client.Repositories.Update(ctx, "myorg/myrepo", in)
+	require.NoError(t, err, "failed to update repository")
+	assert.True(t, repo.Private)
+	assert.True(t, repo.Archived)
+	assert.Equal(t, "main", repo.Branch)
+	assert.Equal(t, []*scm.RepositorySettings{in}, data.UpdatedRepositories["myorg/myrepo"])
+
+	_, _, err = client.Repositories.Rename(ctx, "myorg/myrepo", "renamed")
+	require.NoError(t, err, "failed to rename repository")
+	repo, _, err = client.Repositories.Transfer(ctx, "myorg/renamed", "otherorg")
+	require.NoError(t, err, "failed to transfer repository")
+	assert.Equal(t, "otherorg/renamed", repo.FullName)
+
+	_, _, err = client.Repositories.Find(ctx, "myorg/myrepo")
+	assert.Equal(t, scm.ErrNotFound, err)
+}
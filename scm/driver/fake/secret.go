@@ -0,0 +1,86 @@
+package fake
+
+import (
+	"context"
+	"sort"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type secretService struct {
+	client *wrapper
+	data   *Data
+}
+
+func (s *secretService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return s.list(repo), nil, nil
+}
+
+func (s *secretService) Set(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	s.set(repo, "", input)
+	return nil, nil
+}
+
+func (s *secretService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	return nil, s.delete(repo, name)
+}
+
+func (s *secretService) ListEnvironment(ctx context.Context, repo, env string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return s.list(repo + ":" + env), nil, nil
+}
+
+func (s *secretService) SetEnvironment(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	s.set(repo+":"+env, env, input)
+	return nil, nil
+}
+
+func (s *secretService) DeleteEnvironment(ctx context.Context, repo, env, name string) (*scm.Response, error) {
+	return nil, s.delete(repo+":"+env, name)
+}
+
+func (s *secretService) ListOrganization(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return s.list(org), nil, nil
+}
+
+func (s *secretService) SetOrganization(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	s.set(org, "", input)
+	return nil, nil
+}
+
+func (s *secretService) DeleteOrganization(ctx context.Context, org, name string) (*scm.Response, error) {
+	return nil, s.delete(org, name)
+}
+
+func (s *secretService) list(key string) []*scm.Secret {
+	secrets := []*scm.Secret{}
+	for _, secret := range s.data.Secrets[key] {
+		secrets = append(secrets, secret)
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets
+}
+
+func (s *secretService) set(key, env string, input *scm.SecretInput) {
+	if s.data.Secrets[key] == nil {
+		s.data.Secrets[key] = map[string]*scm.Secret{}
+		s.data.SecretValues[key] = map[string]string{}
+	}
+	s.data.Secrets[key][input.Name] = &scm.Secret{
+		Name:        input.Name,
+		Environment: env,
+		Masked:      input.Masked,
+		Protected:   input.Protected,
+	}
+	s.data.SecretValues[key][input.Name] = input.Value
+}
+
+func (s *secretService) delete(key, name string) error {
+	if _, ok := s.data.Secrets[key][name]; !ok {
+		return scm.ErrNotFound
+	}
+	delete(s.data.Secrets[key], name)
+	delete(s.data.SecretValues[key], name)
+	return nil
+}
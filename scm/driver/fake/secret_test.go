@@ -0,0 +1,41 @@
+package fake_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecrets(t *testing.T) {
+	client, data := fake.NewDefault()
+	ctx := context.Background()
+	repo := "myorg/myrepo"
+
+	input := &scm.SecretInput{
+		Name:   "DEPLOY_TOKEN",
+		Value:  "s3cr3t",
+		Masked: true,
+	}
+	_, err := client.Secrets.SetEnvironment(ctx, repo, "production", input)
+	require.NoError(t, err, "failed to set secret in repo %s", repo)
+	assert.Equal(t, "s3cr3t", data.SecretValues[repo+":production"]["DEPLOY_TOKEN"])
+
+	secrets, _, err := client.Secrets.ListEnvironment(ctx, repo, "production", scm.ListOptions{})
+	require.NoError(t, err, "failed to list secrets in repo %s", repo)
+	require.Len(t, secrets, 1)
+	assert.Equal(t, "production", secrets[0].Environment)
+
+	secrets, _, err = client.Secrets.List(ctx, repo, scm.ListOptions{})
+	require.NoError(t, err, "failed to list secrets in repo %s", repo)
+	assert.Empty(t, secrets)
+
+	_, err = client.Secrets.DeleteEnvironment(ctx, repo, "production", "DEPLOY_TOKEN")
+	require.NoError(t, err, "failed to delete secret in repo %s", repo)
+
+	_, err = client.Secrets.DeleteEnvironment(ctx, repo, "production", "DEPLOY_TOKEN")
+	assert.Equal(t, scm.ErrNotFound, err)
+}
@@ -7,7 +7,10 @@ package gitea
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/jenkins-x/go-scm/scm"
@@ -95,6 +98,113 @@ func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*s
 	return nil, scm.ErrNotSupported
 }
 
+// CommitFiles applies the file changes with the change files API.
+// Gitea cannot change the mode of files.
+func (s *contentService) CommitFiles(ctx context.Context, repo string, params *scm.CommitFilesParams) (*scm.Commit, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	in := &changeFilesInput{
+		Branch:  params.Branch,
+		Message: params.Message,
+		Author:  identity{Name: params.Author.Name, Email: params.Author.Email},
+	}
+	if in.Branch == "" {
+		in.Branch = params.Base
+	} else if in.Branch != params.Base {
+		_, resp, err := s.client.GiteaClient.GetRepoBranch(namespace, name, in.Branch)
+		switch {
+		case resp != nil && resp.StatusCode == http.StatusNotFound:
+			in.Branch, in.NewBranch = params.Base, params.Branch
+		case err != nil:
+			return nil, toSCMResponse(resp), err
+		}
+	}
+
+	for _, c := range params.Changes {
+		if c.Action == scm.FileChmod || c.Executable != nil {
+			return nil, nil, scm.ErrNotSupported
+		}
+		file := &changeFile{
+			Operation: c.Action,
+			Path:      c.Path,
+			Content:   base64.StdEncoding.EncodeToString(c.Data),
+		}
+		if c.Action != scm.FileCreate {
+			from := c.Path
+			if c.Action == scm.FileMove {
+				file.Operation = scm.FileUpdate
+				file.FromPath = c.PreviousPath
+				from = c.PreviousPath
+			}
+			old, resp, err := s.client.GiteaClient.GetContents(namespace, name, in.Branch, from)
+			if err != nil {
+				return nil, toSCMResponse(resp), err
+			}
+			file.SHA = old.SHA
+			if c.Action == scm.FileMove && c.Data == nil && old.Content != nil {
+				file.Content = *old.Content
+			}
+		}
+		in.Files = append(in.Files, file)
+	}
+
+	out := new(changeFilesOutput)
+	res, err := s.client.do(ctx, "POST", fmt.Sprintf("api/v1/repos/%s/contents", repo), in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertFileCommit(out.Commit), res, nil
+}
+
+type identity struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type changeFile struct {
+	Operation string `json:"operation"`
+	Path      string `json:"path"`
+	Content   string `json:"content,omitempty"`
+	FromPath  string `json:"from_path,omitempty"`
+	SHA       string `json:"sha,omitempty"`
+}
+
+type changeFilesInput struct {
+	Branch    string        `json:"branch"`
+	NewBranch string        `json:"new_branch,omitempty"`
+	Message   string        `json:"message"`
+	Author    identity      `json:"author"`
+	Files     []*changeFile `json:"files"`
+}
+
+type changeFilesOutput struct {
+	Commit *gitea.FileCommitResponse `json:"commit"`
+}
+
+func convertFileCommit(src *gitea.FileCommitResponse) *scm.Commit {
+	if src == nil {
+		return nil
+	}
+	return &scm.Commit{
+		Sha:       src.SHA,
+		Link:      src.HTMLURL,
+		Message:   src.Message,
+		Author:    convertCommitUser(src.Author),
+		Committer: convertCommitUser(src.Committer),
+	}
+}
+
+func convertCommitUser(src *gitea.CommitUser) scm.Signature {
+	if src == nil {
+		return scm.Signature{}
+	}
+	date, _ := time.Parse(time.RFC3339, src.Date)
+	return scm.Signature{
+		Name:  src.Name,
+		Email: src.Email,
+		Date:  date,
+	}
+}
+
 func convertEntryList(out []*gitea.ContentsResponse) []*scm.FileEntry {
 	answer := make([]*scm.FileEntry, 0, len(out))
 	for _, o := range out {
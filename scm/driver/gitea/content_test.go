@@ -184,6 +184,85 @@ func TestContentDelete(t *testing.T) {
 	}
 }
 
+func TestContentCommitFiles(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/branches/feature").
+		Reply(404).
+		Type("application/json").
+		BodyString(`{"message": "branch not found"}`)
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/contents/.gitignore").
+		MatchParam("ref", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/content_find.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/contents").
+		JSON(map[string]interface{}{
+			"branch":     "master",
+			"new_branch": "feature",
+			"message":    "update gitignore",
+			"author":     map[string]string{"name": "Jane Doe", "email": "jane@example.com"},
+			"files": []map[string]string{
+				{"operation": "update", "path": ".gitignore", "content": encode([]byte("*.exe\n")), "sha": "8d8863546a1b476ec51d4a9f150a031264d35eef"},
+				{"operation": "create", "path": "CHANGELOG.md", "content": encode([]byte("# Changelog\n"))},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/change_files.json")
+
+	params := &scm.CommitFilesParams{
+		Base:    "master",
+		Branch:  "feature",
+		Message: "update gitignore",
+		Author:  scm.Signature{Name: "Jane Doe", Email: "jane@example.com"},
+		Changes: []scm.FileChange{
+			{Action: scm.FileUpdate, Path: ".gitignore", Data: []byte("*.exe\n")},
+			{Action: scm.FileCreate, Path: "CHANGELOG.md", Data: []byte("# Changelog\n")},
+		},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Contents.CommitFiles(context.Background(), "go-gitea/gitea", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/change_files.json.golden")
+	err = json.Unmarshal(raw, want)
+	assert.NoError(t, err)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentCommitFilesChmod(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	executable := true
+	params := &scm.CommitFilesParams{
+		Base:    "master",
+		Changes: []scm.FileChange{{Action: scm.FileChmod, Path: "build.sh", Executable: &executable}},
+	}
+	_, _, err := client.Contents.CommitFiles(context.Background(), "go-gitea/gitea", params)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func encode(b []byte) string {
 	return base64.StdEncoding.EncodeToString([]byte(b))
 }
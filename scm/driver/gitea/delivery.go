@@ -0,0 +1,35 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// deliveryService triggers test deliveries. Gitea records
+// the delivery history but does not expose it in the api.
+type deliveryService struct {
+	client *wrapper
+}
+
+func (s *deliveryService) List(ctx context.Context, repo, hook string, opts scm.ListOptions) ([]*scm.WebhookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deliveryService) Find(ctx context.Context, repo, hook, id string) (*scm.WebhookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deliveryService) Redeliver(ctx context.Context, repo, hook, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *deliveryService) Ping(ctx context.Context, repo, hook string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/hooks/%s/tests", repo, hook)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
@@ -0,0 +1,41 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestDeliveryPing(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/hooks/20/tests").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Deliveries.Ping(context.Background(), "go-gitea/gitea", "20")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDeliveryList(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Deliveries.List(context.Background(), "go-gitea/gitea", "20", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
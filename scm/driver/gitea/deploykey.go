@@ -0,0 +1,80 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"strconv"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type deployKeyService struct {
+	client *wrapper
+}
+
+func (s *deployKeyService) Find(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	keyID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, nil, err
+	}
+	out, resp, err := s.client.GiteaClient.GetDeployKey(namespace, name, keyID)
+	return convertDeployKey(out), toSCMResponse(resp), err
+}
+
+func (s *deployKeyService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.ListDeployKeys(namespace, name, gitea.ListDeployKeysOptions{ListOptions: toGiteaListOptions(opts)})
+	return convertDeployKeyList(out), toSCMResponse(resp), err
+}
+
+func (s *deployKeyService) Create(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	in := gitea.CreateKeyOption{
+		Title:    input.Title,
+		Key:      input.Key,
+		ReadOnly: input.ReadOnly,
+	}
+	out, resp, err := s.client.GiteaClient.CreateDeployKey(namespace, name, in)
+	return convertDeployKey(out), toSCMResponse(resp), err
+}
+
+func (s *deployKeyService) Delete(ctx context.Context, repo, id string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	keyID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.GiteaClient.DeleteDeployKey(namespace, name, keyID)
+	return toSCMResponse(resp), err
+}
+
+func (s *deployKeyService) Enable(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func convertDeployKeyList(from []*gitea.DeployKey) []*scm.DeployKey {
+	to := []*scm.DeployKey{}
+	for _, v := range from {
+		to = append(to, convertDeployKey(v))
+	}
+	return to
+}
+
+func convertDeployKey(from *gitea.DeployKey) *scm.DeployKey {
+	if from == nil {
+		return nil
+	}
+	return &scm.DeployKey{
+		ID:       strconv.FormatInt(from.ID, 10),
+		Title:    from.Title,
+		Key:      from.Key,
+		ReadOnly: from.ReadOnly,
+		Link:     from.URL,
+		Created:  from.Created,
+	}
+}
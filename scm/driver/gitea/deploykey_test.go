@@ -0,0 +1,122 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestDeployKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/keys/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/deploy_key.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.DeployKeys.Find(context.Background(), "go-gitea/gitea", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/deploy_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyList(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/keys").
+		Reply(200).
+		Type("application/json").
+		File("testdata/deploy_keys.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.DeployKeys.List(context.Background(), "go-gitea/gitea", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.DeployKey{}
+	raw, _ := ioutil.ReadFile("testdata/deploy_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/keys").
+		Reply(201).
+		Type("application/json").
+		File("testdata/deploy_key.json")
+
+	input := &scm.DeployKeyInput{
+		Title:    "gitops-agent",
+		Key:      "ssh-rsa AAA...",
+		ReadOnly: true,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.DeployKeys.Create(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/deploy_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/keys/1").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.DeployKeys.Delete(context.Background(), "go-gitea/gitea", "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/jenkins-x/go-scm/pkg/hmac"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// Generate renders the webhook as the request gitea would
+// send, signed with the secret.
+func (s *webhookService) Generate(hook scm.Webhook, secret string) (*http.Request, error) {
+	var event, guid string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event, guid, payload = "push", v.GUID, convertFromPushHook(v)
+	case *scm.BranchHook:
+		event, payload = convertFromRefAction(v.Action), convertFromBranchHook(v)
+	case *scm.TagHook:
+		event, payload = convertFromRefAction(v.Action), convertFromTagHook(v)
+	case *scm.PullRequestHook:
+		event, payload = "pull_request", convertFromPullRequestHook(v)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if guid == "" {
+		guid = scm.NewDeliveryID()
+	}
+	header := http.Header{}
+	header.Set("X-Gitea-Event", event)
+	header.Set("X-Gitea-Delivery", guid)
+	if secret != "" {
+		header.Set("X-Gitea-Signature", hmac.Sign(sha256.New, data, []byte(secret)))
+	}
+	return scm.NewWebhookRequest("/", data, header)
+}
+
+func convertFromRefAction(from scm.Action) string {
+	if from == scm.ActionDelete {
+		return "delete"
+	}
+	return "create"
+}
+
+func convertFromPushHook(from *scm.PushHook) *pushHook {
+	to := &pushHook{
+		Ref:        from.Ref,
+		Before:     from.Before,
+		After:      from.After,
+		Compare:    from.Compare,
+		Repository: convertFromRepository(&from.Repo),
+		Sender:     convertFromUser(&from.Sender),
+	}
+	// gitea lists the pushed commits, starting with the head
+	// commit, or only the pusher if no commits were pushed.
+	for _, c := range from.Commits {
+		to.Commits = append(to.Commits, commit{
+			ID:      c.ID,
+			Message: c.Message,
+			URL:     c.Link,
+			Author: signature{
+				Name:     c.Author.Name,
+				Email:    c.Author.Email,
+				Username: c.Author.Login,
+			},
+			Committer: signature{
+				Name:     c.Committer.Name,
+				Email:    c.Committer.Email,
+				Username: c.Committer.Login,
+			},
+			Timestamp: c.Timestamp,
+			Added:     c.Added,
+			Removed:   c.Removed,
+			Modified:  c.Modified,
+		})
+	}
+	if len(to.Commits) == 0 && (from.Commit.Message != "" || !from.Commit.Author.Date.IsZero()) {
+		to.Commits = []commit{{
+			ID:      from.Commit.Sha,
+			Message: from.Commit.Message,
+			URL:     from.Commit.Link,
+			Author: signature{
+				Name:     from.Commit.Author.Name,
+				Email:    from.Commit.Author.Email,
+				Username: from.Commit.Author.Login,
+			},
+			Committer: signature{
+				Name:     from.Commit.Committer.Name,
+				Email:    from.Commit.Committer.Email,
+				Username: from.Commit.Committer.Login,
+			},
+			Timestamp: from.Commit.Author.Date,
+		}}
+	}
+	to.Pusher = gitea.User{
+		UserName: from.Commit.Author.Login,
+		FullName: from.Commit.Author.Name,
+		Email:    from.Commit.Author.Email,
+	}
+	return to
+}
+
+func convertFromBranchHook(from *scm.BranchHook) *createHook {
+	return &createHook{
+		Ref:           from.Ref.Name,
+		RefType:       "branch",
+		Sha:           from.Ref.Sha,
+		DefaultBranch: from.Repo.Branch,
+		Repository:    convertFromRepository(&from.Repo),
+		Sender:        convertFromUser(&from.Sender),
+	}
+}
+
+func convertFromTagHook(from *scm.TagHook) *createHook {
+	return &createHook{
+		Ref:           from.Ref.Name,
+		RefType:       "tag",
+		Sha:           from.Ref.Sha,
+		DefaultBranch: from.Repo.Branch,
+		Repository:    convertFromRepository(&from.Repo),
+		Sender:        convertFromUser(&from.Sender),
+	}
+}
+
+func convertFromPullRequestHook(from *scm.PullRequestHook) *pullRequestHook {
+	return &pullRequestHook{
+		Action:      convertFromPullRequestAction(from.Action),
+		Number:      from.PullRequest.Number,
+		PullRequest: convertFromPullRequest(&from.PullRequest),
+		Repository:  convertFromRepository(&from.Repo),
+		Sender:      convertFromUser(&from.Sender),
+	}
+}
+
+func convertFromPullRequestAction(from scm.Action) string {
+	switch from {
+	case scm.ActionUpdate:
+		return "edited"
+	case scm.ActionClose, scm.ActionMerge:
+		return "closed"
+	case scm.ActionReopen:
+		return "reopened"
+	case scm.ActionLabel:
+		return "label_updated"
+	case scm.ActionUnlabel:
+		return "label_cleared"
+	case scm.ActionSync:
+		return "synchronized"
+	case scm.ActionAssigned:
+		return "assigned"
+	case scm.ActionUnassigned:
+		return "unassigned"
+	default:
+		return "opened"
+	}
+}
+
+func convertFromPullRequest(from *scm.PullRequest) gitea.PullRequest {
+	created, updated := from.Created, from.Updated
+	to := gitea.PullRequest{
+		Index:     int64(from.Number),
+		Title:     from.Title,
+		Body:      from.Body,
+		State:     gitea.StateOpen,
+		DiffURL:   from.DiffLink,
+		HTMLURL:   from.Link,
+		HasMerged: from.Merged,
+		Mergeable: from.Mergeable,
+		Created:   &created,
+		Updated:   &updated,
+		Head:      convertFromPullRequestBranch(&from.Head, from.Source),
+		Base:      convertFromPullRequestBranch(&from.Base, from.Target),
+	}
+	if from.Closed {
+		to.State = gitea.StateClosed
+	}
+	if from.MergeSha != "" {
+		sha := from.MergeSha
+		to.MergedCommitID = &sha
+	}
+	poster := convertFromUser(&from.Author)
+	to.Poster = &poster
+	for i := range from.Assignees {
+		assignee := convertFromUser(&from.Assignees[i])
+		to.Assignees = append(to.Assignees, &assignee)
+	}
+	for _, l := range from.Labels {
+		to.Labels = append(to.Labels, &gitea.Label{
+			ID:          l.ID,
+			Name:        l.Name,
+			Description: l.Description,
+			URL:         l.URL,
+			Color:       l.Color,
+		})
+	}
+	return to
+}
+
+func convertFromPullRequestBranch(from *scm.PullRequestBranch, name string) *gitea.PRBranchInfo {
+	repo := convertFromRepository(&from.Repo)
+	return &gitea.PRBranchInfo{
+		Name:       name,
+		Ref:        from.Ref,
+		Sha:        from.Sha,
+		RepoID:     repo.ID,
+		Repository: &repo,
+	}
+}
+
+func convertFromRepository(from *scm.Repository) gitea.Repository {
+	id, _ := strconv.ParseInt(from.ID, 10, 64)
+	to := gitea.Repository{
+		ID:            id,
+		Owner:         &gitea.User{UserName: from.Namespace},
+		Name:          from.Name,
+		FullName:      from.FullName,
+		DefaultBranch: from.Branch,
+		Private:       from.Private,
+		CloneURL:      from.Clone,
+		SSHURL:        from.CloneSSH,
+		HTMLURL:       from.Link,
+		Created:       from.Created,
+		Updated:       from.Updated,
+	}
+	if from.Perm != nil {
+		to.Permissions = &gitea.Permission{
+			Admin: from.Perm.Admin,
+			Push:  from.Perm.Push,
+			Pull:  from.Perm.Pull,
+		}
+	}
+	return to
+}
+
+func convertFromUser(from *scm.User) gitea.User {
+	return gitea.User{
+		ID:        int64(from.ID),
+		UserName:  from.Login,
+		FullName:  from.Name,
+		Email:     from.Email,
+		AvatarURL: from.Avatar,
+	}
+}
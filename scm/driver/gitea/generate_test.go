@@ -0,0 +1,43 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm/driver/internal/webhooktest"
+)
+
+func TestWebhookGenerate(t *testing.T) {
+	tests := []webhooktest.Test{
+		{Event: "push", File: "push.json"},
+		{Event: "create", File: "branch_create.json"},
+		{Event: "delete", File: "branch_delete.json"},
+		{Event: "create", File: "tag_create.json"},
+		{Event: "delete", File: "tag_delete.json"},
+		{Event: "pull_request", File: "pull_request_opened.json"},
+		{Event: "pull_request", File: "pull_request_closed.json"},
+		{Event: "pull_request", File: "pull_request_edited.json"},
+		{Event: "pull_request", File: "pull_request_label.json"},
+		{Event: "pull_request", File: "pull_request_merged.json"},
+		{Event: "pull_request", File: "pull_request_reopened.json"},
+		{Event: "pull_request", File: "pull_request_assign.json"},
+		{Event: "pull_request", File: "pull_request_synchronized.json"},
+	}
+	webhooktest.RoundTrip(t, new(webhookService), generateHeader, tests)
+}
+
+func TestWebhookGenerateNotSupported(t *testing.T) {
+	webhooktest.NotSupported(t, new(webhookService))
+}
+
+// generateHeader returns the headers Gitea sends with the event.
+func generateHeader(event string) http.Header {
+	header := http.Header{}
+	header.Set("X-Gitea-Event", event)
+	header.Set("X-Gitea-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+	return header
+}
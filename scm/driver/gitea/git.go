@@ -118,6 +118,9 @@ type (
 		Author    signature `json:"author"`
 		Committer signature `json:"committer"`
 		Timestamp time.Time `json:"timestamp"`
+		Added     []string  `json:"added"`
+		Removed   []string  `json:"removed"`
+		Modified  []string  `json:"modified"`
 	}
 
 	// gitea signature object.
@@ -47,15 +47,22 @@ func NewWithToken(uri string, token string) (*scm.Client, error) {
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverGitea
+	client.BranchProtections = &branchProtectionService{client}
+	client.Checks = scm.NewStatusChecksService(client.Client)
 	client.Contents = &contentService{client}
+	client.DeployKeys = &deployKeyService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
 	client.Releases = &releaseService{client}
+	client.SystemHooks = &systemHookService{client}
+	client.Deliveries = &deliveryService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -79,14 +86,21 @@ func NewWithBasicAuth(uri string, user, password string) (*scm.Client, error) {
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverGitea
+	client.BranchProtections = &branchProtectionService{client}
+	client.Checks = scm.NewStatusChecksService(client.Client)
 	client.Contents = &contentService{client}
+	client.DeployKeys = &deployKeyService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
+	client.SystemHooks = &systemHookService{client}
+	client.Deliveries = &deliveryService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
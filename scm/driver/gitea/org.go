@@ -6,6 +6,8 @@ package gitea
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/jenkins-x/go-scm/scm"
@@ -105,6 +107,207 @@ func (s *organizationService) ListMemberships(ctx context.Context, opts scm.List
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *organizationService) ListHooks(_ context.Context, org string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	out, resp, err := s.client.GiteaClient.ListOrgHooks(org, gitea.ListHooksOptions{ListOptions: toGiteaListOptions(opts)})
+	return convertHookList(out), toSCMResponse(resp), err
+}
+
+func (s *organizationService) CreateHook(_ context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	config, err := convertHookConfig(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	in := gitea.CreateHookOption{
+		Type:   "gitea",
+		Config: config,
+		Events: append(
+			input.NativeEvents,
+			convertHookEvent(input.Events)...,
+		),
+		Active: true,
+	}
+	out, resp, err := s.client.GiteaClient.CreateOrgHook(org, in)
+	return convertHook(out), toSCMResponse(resp), err
+}
+
+func (s *organizationService) UpdateHook(_ context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	id, err := strconv.ParseInt(input.ID, 10, 64)
+	if err != nil {
+		return nil, nil, err
+	}
+	config, err := convertHookConfig(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	active := true
+	in := gitea.EditHookOption{
+		Config: config,
+		Events: append(
+			input.NativeEvents,
+			convertHookEvent(input.Events)...,
+		),
+		Active: &active,
+	}
+	resp, err := s.client.GiteaClient.EditOrgHook(org, id, in)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	out, resp, err := s.client.GiteaClient.GetOrgHook(org, id)
+	return convertHook(out), toSCMResponse(resp), err
+}
+
+func (s *organizationService) DeleteHook(_ context.Context, org string, id string) (*scm.Response, error) {
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.GiteaClient.DeleteOrgHook(org, idInt)
+	return toSCMResponse(resp), err
+}
+
+func (s *organizationService) CreateTeam(_ context.Context, org string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	in := gitea.CreateTeamOption{
+		Name:        input.Name,
+		Description: input.Description,
+		Permission:  gitea.AccessModeRead,
+		Units:       defaultTeamUnits,
+	}
+	out, resp, err := s.client.GiteaClient.CreateTeam(org, in)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	return convertTeam(out), toSCMResponse(resp), scm.CheckUnsupportedFields(input, "Privacy", "ParentTeamID")
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, org, team string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	existing, res, err := s.findTeam(ctx, org, team)
+	if err != nil {
+		return nil, res, err
+	}
+	in := gitea.EditTeamOption{
+		Name:        existing.Name,
+		Description: &existing.Description,
+		Permission:  existing.Permission,
+		Units:       existing.Units,
+	}
+	if input.Name != "" {
+		in.Name = input.Name
+	}
+	if input.Description != "" {
+		in.Description = &input.Description
+	}
+	resp, err := s.client.GiteaClient.EditTeam(existing.ID, in)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	out, resp, err := s.client.GiteaClient.GetTeam(existing.ID)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	return convertTeam(out), toSCMResponse(resp), scm.CheckUnsupportedFields(input, "Privacy", "ParentTeamID")
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, org, team string) (*scm.Response, error) {
+	existing, res, err := s.findTeam(ctx, org, team)
+	if err != nil {
+		return res, err
+	}
+	resp, err := s.client.GiteaClient.DeleteTeam(existing.ID)
+	return toSCMResponse(resp), err
+}
+
+// AddTeamMember adds the user to the team. Gitea has no team
+// member roles, so the role is ignored.
+func (s *organizationService) AddTeamMember(ctx context.Context, org, team, user, role string) (*scm.Response, error) {
+	existing, res, err := s.findTeam(ctx, org, team)
+	if err != nil {
+		return res, err
+	}
+	resp, err := s.client.GiteaClient.AddTeamMember(existing.ID, user)
+	return toSCMResponse(resp), err
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, org, team, user string) (*scm.Response, error) {
+	existing, res, err := s.findTeam(ctx, org, team)
+	if err != nil {
+		return res, err
+	}
+	resp, err := s.client.GiteaClient.RemoveTeamMember(existing.ID, user)
+	return toSCMResponse(resp), err
+}
+
+// AddTeamRepository adds the repository to the team. Gitea
+// grants the team permission on all its repositories, so the
+// permission is ignored.
+func (s *organizationService) AddTeamRepository(ctx context.Context, org, team, repo, permission string) (*scm.Response, error) {
+	existing, res, err := s.findTeam(ctx, org, team)
+	if err != nil {
+		return res, err
+	}
+	owner, name := scm.Split(repo)
+	resp, err := s.client.GiteaClient.AddTeamRepository(existing.ID, owner, name)
+	return toSCMResponse(resp), err
+}
+
+func (s *organizationService) RemoveTeamRepository(ctx context.Context, org, team, repo string) (*scm.Response, error) {
+	existing, res, err := s.findTeam(ctx, org, team)
+	if err != nil {
+		return res, err
+	}
+	owner, name := scm.Split(repo)
+	resp, err := s.client.GiteaClient.RemoveTeamRepository(existing.ID, owner, name)
+	return toSCMResponse(resp), err
+}
+
+func (s *organizationService) InviteMember(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveMember(_ context.Context, org, user string) (*scm.Response, error) {
+	resp, err := s.client.GiteaClient.DeleteOrgMembership(org, user)
+	return toSCMResponse(resp), err
+}
+
+func (s *organizationService) SetMemberRole(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListOutsideCollaborators(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.TeamMember, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// helper function returns the organization team with the
+// given name, since the team API is addressed by id.
+func (s *organizationService) findTeam(_ context.Context, org, name string) (*gitea.Team, *scm.Response, error) {
+	opts := gitea.ListTeamsOptions{ListOptions: gitea.ListOptions{Page: 1, PageSize: 50}}
+	for {
+		teams, resp, err := s.client.GiteaClient.ListOrgTeams(org, opts)
+		if err != nil {
+			return nil, toSCMResponse(resp), err
+		}
+		for _, t := range teams {
+			if strings.EqualFold(t.Name, name) {
+				return t, toSCMResponse(resp), nil
+			}
+		}
+		if len(teams) < opts.PageSize {
+			return nil, toSCMResponse(resp), scm.ErrNotFound
+		}
+		opts.Page++
+	}
+}
+
+// defaultTeamUnits are the repository units new teams can access.
+var defaultTeamUnits = []string{
+	"repo.code",
+	"repo.issues",
+	"repo.ext_issues",
+	"repo.wiki",
+	"repo.pulls",
+	"repo.releases",
+	"repo.ext_wiki",
+}
+
 //
 // native data structure conversion
 //
@@ -72,3 +72,263 @@ func TestOrgList(t *testing.T) {
 
 	t.Run("Page", testPage(res))
 }
+
+func TestOrgHookList(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/hooks").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hooks.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.ListHooks(context.Background(), "gogits", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Hook{}
+	raw, _ := ioutil.ReadFile("testdata/hooks.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgHookCreate(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/orgs/gogits/hooks").
+		Reply(201).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.CreateHook(context.Background(), "gogits", &scm.HookInput{})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/orgs/gogits/hooks/20").
+		Reply(200).
+		Type("application/json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/hooks/20").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.UpdateHook(context.Background(), "gogits", &scm.HookInput{ID: "20"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgHookDelete(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/orgs/gogits/hooks/20").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.DeleteHook(context.Background(), "gogits", "20")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTeamCreate(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/orgs/gogits/teams").
+		Reply(201).
+		Type("application/json").
+		File("testdata/team.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.CreateTeam(context.Background(), "gogits", &scm.Team{Name: "Developers", Description: "The developers."})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/team.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestTeamUpdate(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/teams").
+		Reply(200).
+		Type("application/json").
+		File("testdata/teams.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/teams/3").
+		Reply(200).
+		Type("application/json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/teams/3").
+		Reply(200).
+		Type("application/json").
+		File("testdata/team.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.UpdateTeam(context.Background(), "gogits", "developers", &scm.Team{Description: "The developers."})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/team.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestTeamDelete_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/teams").
+		Reply(200).
+		Type("application/json").
+		File("testdata/teams.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.DeleteTeam(context.Background(), "gogits", "owners")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want error %v, got %v", scm.ErrNotFound, err)
+	}
+}
+
+func TestTeamAddMember(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/teams").
+		Reply(200).
+		Type("application/json").
+		File("testdata/teams.json")
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/teams/3/members/jcitizen").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.AddTeamMember(context.Background(), "gogits", "Developers", "jcitizen", scm.MemberRole)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTeamAddRepository(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/teams").
+		Reply(200).
+		Type("application/json").
+		File("testdata/teams.json")
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/teams/3/repos/gogits/gogs").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.AddTeamRepository(context.Background(), "gogits", "Developers", "gogits/gogs", scm.WritePermission)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRemoveMember(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/orgs/gogits/members/jcitizen").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.RemoveMember(context.Background(), "gogits", "jcitizen")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInviteMember(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.InviteMember(context.Background(), "gogits", "jcitizen", scm.MemberRole)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
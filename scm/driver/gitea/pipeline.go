@@ -0,0 +1,223 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// pipelineService manages gitea actions runs, which the sdk
+// does not support, using the actions api.
+type pipelineService struct {
+	client *wrapper
+}
+
+type actionRunList struct {
+	TotalCount   int          `json:"total_count"`
+	WorkflowRuns []*actionRun `json:"workflow_runs"`
+}
+
+type actionRun struct {
+	ID          int64     `json:"id"`
+	RunNumber   int       `json:"run_number"`
+	Event       string    `json:"event"`
+	Status      string    `json:"status"`
+	Conclusion  string    `json:"conclusion"`
+	HeadBranch  string    `json:"head_branch"`
+	HeadSha     string    `json:"head_sha"`
+	HTMLURL     string    `json:"html_url"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+type actionJobList struct {
+	TotalCount int          `json:"total_count"`
+	Jobs       []*actionJob `json:"jobs"`
+}
+
+type actionJob struct {
+	ID          int64     `json:"id"`
+	RunID       int64     `json:"run_id"`
+	Name        string    `json:"name"`
+	Status      string    `json:"status"`
+	Conclusion  string    `json:"conclusion"`
+	HeadBranch  string    `json:"head_branch"`
+	HeadSha     string    `json:"head_sha"`
+	HTMLURL     string    `json:"html_url"`
+	RunnerName  string    `json:"runner_name"`
+	CreatedAt   time.Time `json:"created_at"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+type actionDispatch struct {
+	Ref    string            `json:"ref"`
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
+
+func (s *pipelineService) List(ctx context.Context, repo string, opts scm.PipelineListOptions) ([]*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/runs?%s", repo, encodePipelineListOptions(opts))
+	out := new(actionRunList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertActionRunList(out.WorkflowRuns), res, err
+}
+
+func (s *pipelineService) Find(ctx context.Context, repo string, id int) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/runs/%d", repo, id)
+	out := new(actionRun)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertActionRun(out), res, err
+}
+
+// Trigger dispatches the workflow named by the input. The
+// dispatch api does not return the workflow run.
+func (s *pipelineService) Trigger(ctx context.Context, repo string, input *scm.PipelineInput) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/workflows/%s/dispatches", repo, input.Workflow)
+	in := &actionDispatch{
+		Ref:    input.Ref,
+		Inputs: input.Variables,
+	}
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	return nil, res, err
+}
+
+func (s *pipelineService) Cancel(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pipelineService) Retry(context.Context, string, int) (*scm.Pipeline, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pipelineService) ListJobs(ctx context.Context, repo string, id int, opts scm.ListOptions) ([]*scm.PipelineJob, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/runs/%d/jobs?%s", repo, id, encodeListOptions(opts))
+	out := new(actionJobList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertActionJobList(out.Jobs), res, err
+}
+
+func (s *pipelineService) JobLog(ctx context.Context, repo string, _, job int) ([]byte, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/jobs/%d/logs", repo, job)
+	buf := new(bytes.Buffer)
+	res, err := s.client.do(ctx, "GET", path, nil, buf)
+	return buf.Bytes(), res, err
+}
+
+func encodeListOptions(opts scm.ListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
+func encodePipelineListOptions(opts scm.PipelineListOptions) string {
+	params := url.Values{}
+	if opts.Ref != "" {
+		params.Set("branch", opts.Ref)
+	}
+	if opts.Sha != "" {
+		params.Set("head_sha", opts.Sha)
+	}
+	if opts.Status != "" {
+		params.Set("status", opts.Status)
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
+func convertActionRunList(from []*actionRun) []*scm.Pipeline {
+	to := []*scm.Pipeline{}
+	for _, v := range from {
+		to = append(to, convertActionRun(v))
+	}
+	return to
+}
+
+func convertActionRun(from *actionRun) *scm.Pipeline {
+	to := &scm.Pipeline{
+		ID:             int(from.ID),
+		Number:         from.RunNumber,
+		Status:         convertActionState(from.Status, from.Conclusion),
+		DetailedStatus: from.Status,
+		Ref:            from.HeadBranch,
+		Sha:            from.HeadSha,
+		Source:         from.Event,
+		Link:           from.HTMLURL,
+		Created:        from.StartedAt,
+		Finished:       from.CompletedAt,
+	}
+	if from.Status == "completed" {
+		to.DetailedStatus = from.Conclusion
+		to.Duration = from.CompletedAt.Sub(from.StartedAt)
+	}
+	return to
+}
+
+func convertActionJobList(from []*actionJob) []*scm.PipelineJob {
+	to := []*scm.PipelineJob{}
+	for _, v := range from {
+		to = append(to, convertActionJob(v))
+	}
+	return to
+}
+
+func convertActionJob(from *actionJob) *scm.PipelineJob {
+	to := &scm.PipelineJob{
+		ID:             int(from.ID),
+		PipelineID:     int(from.RunID),
+		Name:           from.Name,
+		Status:         convertActionState(from.Status, from.Conclusion),
+		DetailedStatus: from.Status,
+		Ref:            from.HeadBranch,
+		Sha:            from.HeadSha,
+		Link:           from.HTMLURL,
+		Runner:         from.RunnerName,
+		Created:        from.CreatedAt,
+		Started:        from.StartedAt,
+		Finished:       from.CompletedAt,
+	}
+	if from.Status == "completed" {
+		to.DetailedStatus = from.Conclusion
+		to.Duration = from.CompletedAt.Sub(from.StartedAt)
+	}
+	return to
+}
+
+// helper function returns the pipeline state of an actions
+// run or job status and conclusion.
+func convertActionState(status, conclusion string) scm.State {
+	switch status {
+	case "queued", "waiting", "pending", "blocked":
+		return scm.StatePending
+	case "in_progress":
+		return scm.StateRunning
+	}
+	switch conclusion {
+	case "success", "skipped":
+		return scm.StateSuccess
+	case "cancelled":
+		return scm.StateCanceled
+	case "failure":
+		return scm.StateFailure
+	default:
+		return scm.StateUnknown
+	}
+}
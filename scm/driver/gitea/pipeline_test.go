@@ -0,0 +1,176 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestPipelineFind(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/actions/runs/21").
+		Reply(200).
+		Type("application/json").
+		File("testdata/action_run.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Pipelines.Find(context.Background(), "go-gitea/gitea", 21)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Pipeline)
+	raw, _ := ioutil.ReadFile("testdata/action_run.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineList(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/actions/runs").
+		MatchParam("branch", "main").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockPageHeaders).
+		File("testdata/action_runs.json")
+
+	client, _ := New("https://try.gitea.io")
+	opts := scm.PipelineListOptions{Ref: "main", Page: 1, Size: 30}
+	got, res, err := client.Pipelines.List(context.Background(), "go-gitea/gitea", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Pipeline{}
+	raw, _ := ioutil.ReadFile("testdata/action_runs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Page", testPage(res))
+}
+
+func TestPipelineTrigger(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/actions/workflows/release.yml/dispatches").
+		JSON(map[string]interface{}{
+			"ref": "main",
+			"inputs": map[string]string{
+				"version": "1.0.0",
+			},
+		}).
+		Reply(204)
+
+	input := &scm.PipelineInput{
+		Ref:       "main",
+		Workflow:  "release.yml",
+		Variables: map[string]string{"version": "1.0.0"},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Pipelines.Trigger(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got != nil {
+		t.Errorf("Want nil pipeline for workflow dispatch")
+	}
+}
+
+func TestPipelineCancel(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	if _, err := client.Pipelines.Cancel(context.Background(), "go-gitea/gitea", 21); err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+	if _, _, err := client.Pipelines.Retry(context.Background(), "go-gitea/gitea", 21); err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPipelineListJobs(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/actions/runs/21/jobs").
+		Reply(200).
+		Type("application/json").
+		File("testdata/action_jobs.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Pipelines.ListJobs(context.Background(), "go-gitea/gitea", 21, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PipelineJob{}
+	raw, _ := ioutil.ReadFile("testdata/action_jobs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineJobLog(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/actions/jobs/45/logs").
+		Reply(200).
+		Type("text/plain").
+		BodyString("2024-12-04T10:12:09Z Set up job\n")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Pipelines.JobLog(context.Background(), "go-gitea/gitea", 21, 45)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if want := "2024-12-04T10:12:09Z Set up job\n"; string(got) != want {
+		t.Errorf("Want log %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"net/http"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type branchProtectionService struct {
+	client *wrapper
+}
+
+func (s *branchProtectionService) Find(ctx context.Context, repo, branch string) (*scm.BranchProtection, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.GetBranchProtection(namespace, name, branch)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	return convertBranchProtection(out), toSCMResponse(resp), nil
+}
+
+// Set creates or edits the branch protection. Gitea always
+// blocks force pushes to, and deletion of, protected branches.
+func (s *branchProtectionService) Set(ctx context.Context, repo string, input *scm.BranchProtection) (*scm.BranchProtection, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	in := convertBranchProtectionInput(input)
+	_, resp, err := s.client.GiteaClient.GetBranchProtection(namespace, name, input.Branch)
+	var out *gitea.BranchProtection
+	switch {
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		out, resp, err = s.client.GiteaClient.CreateBranchProtection(namespace, name, in)
+	case err == nil:
+		out, resp, err = s.client.GiteaClient.EditBranchProtection(namespace, name, input.Branch, gitea.EditBranchProtectionOption{
+			EnablePush:              &in.EnablePush,
+			EnablePushWhitelist:     &in.EnablePushWhitelist,
+			PushWhitelistUsernames:  in.PushWhitelistUsernames,
+			PushWhitelistTeams:      in.PushWhitelistTeams,
+			EnableMergeWhitelist:    &in.EnableMergeWhitelist,
+			MergeWhitelistUsernames: in.MergeWhitelistUsernames,
+			MergeWhitelistTeams:     in.MergeWhitelistTeams,
+			EnableStatusCheck:       &in.EnableStatusCheck,
+			StatusCheckContexts:     in.StatusCheckContexts,
+			RequiredApprovals:       &in.RequiredApprovals,
+			BlockOnOutdatedBranch:   &in.BlockOnOutdatedBranch,
+			DismissStaleApprovals:   &in.DismissStaleApprovals,
+		})
+	}
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	return convertBranchProtection(out), toSCMResponse(resp), scm.CheckUnsupportedFields(input,
+		"RequireCodeOwnerReview",
+		"AllowForcePush",
+		"AllowDeletion",
+		"RequireLinearHistory",
+	)
+}
+
+func (s *branchProtectionService) Delete(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	resp, err := s.client.GiteaClient.DeleteBranchProtection(namespace, name, branch)
+	return toSCMResponse(resp), err
+}
+
+func convertBranchProtectionInput(from *scm.BranchProtection) gitea.CreateBranchProtectionOption {
+	to := gitea.CreateBranchProtectionOption{
+		BranchName:            from.Branch,
+		EnablePush:            true,
+		EnableStatusCheck:     len(from.RequiredStatusChecks) > 0,
+		StatusCheckContexts:   from.RequiredStatusChecks,
+		RequiredApprovals:     int64(from.RequiredApprovals),
+		BlockOnOutdatedBranch: from.StrictStatusChecks,
+		DismissStaleApprovals: from.DismissStaleReviews,
+	}
+	if v := from.Push; v != nil {
+		to.EnablePush = len(v.Users) > 0 || len(v.Teams) > 0
+		to.EnablePushWhitelist = to.EnablePush
+		to.PushWhitelistUsernames = v.Users
+		to.PushWhitelistTeams = v.Teams
+	}
+	if v := from.Merge; v != nil {
+		to.EnableMergeWhitelist = true
+		to.MergeWhitelistUsernames = v.Users
+		to.MergeWhitelistTeams = v.Teams
+	}
+	return to
+}
+
+func convertBranchProtection(from *gitea.BranchProtection) *scm.BranchProtection {
+	to := &scm.BranchProtection{
+		Branch:              from.BranchName,
+		StrictStatusChecks:  from.BlockOnOutdatedBranch,
+		RequiredApprovals:   int(from.RequiredApprovals),
+		DismissStaleReviews: from.DismissStaleApprovals,
+	}
+	if from.EnableStatusCheck {
+		to.RequiredStatusChecks = from.StatusCheckContexts
+	}
+	switch {
+	case !from.EnablePush:
+		to.Push = &scm.BranchRestrictions{}
+	case from.EnablePushWhitelist:
+		to.Push = &scm.BranchRestrictions{
+			Users: from.PushWhitelistUsernames,
+			Teams: from.PushWhitelistTeams,
+		}
+	}
+	if from.EnableMergeWhitelist {
+		to.Merge = &scm.BranchRestrictions{
+			Users: from.MergeWhitelistUsernames,
+			Teams: from.MergeWhitelistTeams,
+		}
+	}
+	return to
+}
@@ -0,0 +1,138 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestBranchProtectionFind(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/branch_protections/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_protection.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.BranchProtections.Find(context.Background(), "go-gitea/gitea", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestBranchProtectionSet(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/branch_protections/master").
+		Reply(404).
+		Type("application/json").
+		BodyString(`{"message":"Not Found"}`)
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/branch_protections").
+		Reply(201).
+		Type("application/json").
+		File("testdata/branch_protection.json")
+
+	input := &scm.BranchProtection{
+		Branch:               "master",
+		RequiredStatusChecks: []string{"ci/drone"},
+		StrictStatusChecks:   true,
+		RequiredApprovals:    2,
+		DismissStaleReviews:  true,
+		Push: &scm.BranchRestrictions{
+			Users: []string{"gitea"},
+			Teams: []string{"Owners"},
+		},
+		AllowForcePush: true,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.BranchProtections.Set(context.Background(), "go-gitea/gitea", input)
+	unsupported, ok := err.(*scm.UnsupportedFieldsError)
+	if !ok {
+		t.Errorf("Expect UnsupportedFieldsError, got %v", err)
+		return
+	}
+	if diff := cmp.Diff(unsupported.Fields, []string{"AllowForcePush"}); diff != "" {
+		t.Errorf("Unexpected unsupported fields")
+		t.Log(diff)
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestBranchProtectionSet_Edit(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/branch_protections/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_protection.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/branch_protections/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_protection.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.BranchProtections.Set(context.Background(), "go-gitea/gitea", &scm.BranchProtection{Branch: "master"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect branch protection edited")
+	}
+}
+
+func TestBranchProtectionDelete(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/branch_protections/master").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	if _, err := client.BranchProtections.Delete(context.Background(), "go-gitea/gitea", "master"); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// ListReactions returns the reactions on an issue or pull
+// request. Gitea does not paginate reactions, so the list
+// options are ignored.
+func (s *issueService) ListReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.GetIssueReactions(namespace, name, int64(number))
+	return convertReactionList(out), toSCMResponse(resp), err
+}
+
+func (s *issueService) CreateReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.PostIssueReaction(namespace, name, int64(number), content.String())
+	return convertReaction(out), toSCMResponse(resp), err
+}
+
+func (s *issueService) DeleteReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	resp, err := s.client.GiteaClient.DeleteIssueReaction(namespace, name, int64(number), content.String())
+	return toSCMResponse(resp), err
+}
+
+// ListCommentReactions returns the reactions on a comment.
+// Pull request review comments are issue comments, so the
+// pull request service shares this method.
+func (s *issueService) ListCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.GetIssueCommentReactions(namespace, name, int64(id))
+	return convertReactionList(out), toSCMResponse(resp), err
+}
+
+func (s *issueService) CreateCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.PostIssueCommentReaction(namespace, name, int64(id), content.String())
+	return convertReaction(out), toSCMResponse(resp), err
+}
+
+func (s *issueService) DeleteCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	resp, err := s.client.GiteaClient.DeleteIssueCommentReaction(namespace, name, int64(id), content.String())
+	return toSCMResponse(resp), err
+}
+
+func convertReactionList(from []*gitea.Reaction) []*scm.Reaction {
+	to := []*scm.Reaction{}
+	for _, v := range from {
+		to = append(to, convertReaction(v))
+	}
+	return to
+}
+
+func convertReaction(from *gitea.Reaction) *scm.Reaction {
+	if from == nil {
+		return nil
+	}
+	to := &scm.Reaction{
+		Content: scm.ToReactionContent(from.Reaction),
+		Created: from.Created,
+	}
+	if user := convertUser(from.User); user != nil {
+		to.Author = *user
+	}
+	return to
+}
@@ -0,0 +1,97 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestIssueListReactions(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues/1/reactions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/reactions.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Issues.ListReactions(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reaction{}
+	raw, _ := ioutil.ReadFile("testdata/reactions.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullCreateCommentReaction(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/issues/comments/2/reactions").
+		Reply(201).
+		Type("application/json").
+		File("testdata/reaction.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.PullRequests.CreateCommentReaction(context.Background(), "go-gitea/gitea", 1, 2, scm.ReactionEyes)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reaction)
+	raw, _ := ioutil.ReadFile("testdata/reaction.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueDeleteReaction(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/issues/1/reactions").
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.DeleteReaction(context.Background(), "go-gitea/gitea", 1, scm.ReactionPlusOne)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/jenkins-x/go-scm/scm"
@@ -118,6 +119,65 @@ func (s *repositoryService) ListLabels(_ context.Context, repo string, opts scm.
 	return convertLabels(out), toSCMResponse(resp), err
 }
 
+func (s *repositoryService) CreateLabel(_ context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	in := gitea.CreateLabelOption{
+		Name:        input.Name,
+		Color:       convertLabelColor(input.Color),
+		Description: input.Description,
+	}
+	out, resp, err := s.client.GiteaClient.CreateLabel(namespace, name, in)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	return convertLabels([]*gitea.Label{out})[0], toSCMResponse(resp), nil
+}
+
+func (s *repositoryService) UpdateLabel(ctx context.Context, repo, label string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	labelID, res, err := s.lookupLabel(ctx, repo, label)
+	if err != nil {
+		return nil, res, err
+	}
+	namespace, name := scm.Split(repo)
+	in := gitea.EditLabelOption{}
+	if input.Name != "" {
+		in.Name = &input.Name
+	}
+	if input.Color != "" {
+		color := convertLabelColor(input.Color)
+		in.Color = &color
+	}
+	if input.Description != "" {
+		in.Description = &input.Description
+	}
+	out, resp, err := s.client.GiteaClient.EditLabel(namespace, name, labelID, in)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	return convertLabels([]*gitea.Label{out})[0], toSCMResponse(resp), nil
+}
+
+func (s *repositoryService) DeleteLabel(ctx context.Context, repo, label string) (*scm.Response, error) {
+	labelID, res, err := s.lookupLabel(ctx, repo, label)
+	if err != nil {
+		return res, err
+	}
+	namespace, name := scm.Split(repo)
+	resp, err := s.client.GiteaClient.DeleteLabel(namespace, name, labelID)
+	return toSCMResponse(resp), err
+}
+
+// helper function returns the id of the named label, or
+// ErrNotFound if the repository has no such label.
+func (s *repositoryService) lookupLabel(ctx context.Context, repo, label string) (int64, *scm.Response, error) {
+	issues := &issueService{s.client}
+	labelID, res, err := issues.lookupLabel(ctx, repo, label)
+	if err == nil && labelID == -1 {
+		err = scm.ErrNotFound
+	}
+	return labelID, res, err
+}
+
 func (s *repositoryService) Find(_ context.Context, repo string) (*scm.Repository, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	out, resp, err := s.client.GiteaClient.GetRepo(namespace, name)
@@ -170,22 +230,14 @@ func (s *repositoryService) ListStatus(_ context.Context, repo string, ref strin
 }
 
 func (s *repositoryService) CreateHook(_ context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	target, err := url.Parse(input.Target)
+	config, err := convertHookConfig(input)
 	if err != nil {
 		return nil, nil, err
 	}
-	params := target.Query()
-	params.Set("secret", input.Secret)
-	target.RawQuery = params.Encode()
-
 	namespace, name := scm.Split(repo)
 	in := gitea.CreateHookOption{
-		Type: "gitea",
-		Config: map[string]string{
-			"secret":       input.Secret,
-			"content_type": "json",
-			"url":          target.String(),
-		},
+		Type:   "gitea",
+		Config: config,
 		Events: append(
 			input.NativeEvents,
 			convertHookEvent(input.Events)...,
@@ -197,7 +249,37 @@ func (s *repositoryService) CreateHook(_ context.Context, repo string, input *sc
 }
 
 func (s *repositoryService) UpdateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	idInt, err := strconv.ParseInt(input.ID, 10, 64)
+	if err != nil {
+		return nil, nil, err
+	}
+	config, err := convertHookConfig(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	namespace, name := scm.Split(repo)
+	active := true
+	in := gitea.EditHookOption{
+		Config: config,
+		Events: append(
+			input.NativeEvents,
+			convertHookEvent(input.Events)...,
+		),
+		Active: &active,
+	}
+	resp, err := s.client.GiteaClient.EditRepoHook(namespace, name, idInt, in)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	return s.FindHook(ctx, repo, input.ID)
+}
+
+func (s *repositoryService) EnsureHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.EnsureHookResult, *scm.Response, error) {
+	// gitea hooks do not support skipping tls verification.
+	in := *input
+	in.SkipVerify = false
+	events := append(input.NativeEvents, convertHookEvent(input.Events)...)
+	return scm.EnsureHook(ctx, s, repo, &in, events)
 }
 
 func (s *repositoryService) CreateStatus(_ context.Context, repo string, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
@@ -228,6 +310,58 @@ func (s *repositoryService) Delete(_ context.Context, repo string) (*scm.Respons
 	return toSCMResponse(resp), err
 }
 
+func (s *repositoryService) Update(_ context.Context, repo string, input *scm.RepositorySettings) (*scm.Repository, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	in := gitea.EditRepoOption{
+		HasIssues:   input.HasIssues,
+		HasWiki:     input.HasWiki,
+		AllowMerge:  input.AllowMergeCommit,
+		AllowRebase: input.AllowRebaseMerge,
+		AllowSquash: input.AllowSquashMerge,
+		Archived:    input.Archived,
+	}
+	if input.Description != "" {
+		in.Description = &input.Description
+	}
+	if input.Homepage != "" {
+		in.Website = &input.Homepage
+	}
+	if input.DefaultBranch != "" {
+		in.DefaultBranch = &input.DefaultBranch
+	}
+	unsupported := []string{"DeleteBranchOnMerge"}
+	switch input.Visibility {
+	case scm.VisibilityPublic, scm.VisibilityPrivate:
+		private := input.Visibility == scm.VisibilityPrivate
+		in.Private = &private
+	case scm.VisibilityInternal:
+		unsupported = append(unsupported, "Visibility")
+	}
+	out, resp, err := s.client.GiteaClient.EditRepo(namespace, name, in)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	if input.Topics != nil {
+		resp, err = s.client.GiteaClient.SetRepoTopics(namespace, name, input.Topics)
+		if err != nil {
+			return nil, toSCMResponse(resp), err
+		}
+	}
+	return convertRepository(out), toSCMResponse(resp), scm.CheckUnsupportedFields(input, unsupported...)
+}
+
+func (s *repositoryService) Rename(_ context.Context, repo, name string) (*scm.Repository, *scm.Response, error) {
+	namespace, current := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.EditRepo(namespace, current, gitea.EditRepoOption{Name: &name})
+	return convertRepository(out), toSCMResponse(resp), err
+}
+
+func (s *repositoryService) Transfer(_ context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	owner, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.TransferRepo(owner, name, gitea.TransferRepoOption{NewOwner: namespace})
+	return convertRepository(out), toSCMResponse(resp), err
+}
+
 //
 // native data structure conversion
 //
@@ -288,19 +422,34 @@ func convertHook(from *gitea.Hook) *scm.Hook {
 	}
 }
 
+func convertHookConfig(from *scm.HookInput) (map[string]string, error) {
+	target, err := url.Parse(from.Target)
+	if err != nil {
+		return nil, err
+	}
+	params := target.Query()
+	params.Set("secret", from.Secret)
+	target.RawQuery = params.Encode()
+	return map[string]string{
+		"secret":       from.Secret,
+		"content_type": "json",
+		"url":          target.String(),
+	}, nil
+}
+
 func convertHookEvent(from scm.HookEvents) []string {
 	var events []string
 	if from.PullRequest {
-		events = append(events, "pull_request")
+		events = append(events, "pull_request", "pull_request_label", "pull_request_assign", "pull_request_milestone", "pull_request_sync")
 	}
 	if from.Review {
-		events = append(events, "pull_request_review")
+		events = append(events, "pull_request_review", "pull_request_review_approved", "pull_request_review_rejected")
 	}
 	if from.ReviewComment {
 		events = append(events, "pull_request_review_comment")
 	}
 	if from.Issue {
-		events = append(events, "issues")
+		events = append(events, "issues", "issue_label", "issue_assign")
 	}
 	if from.IssueComment || from.PullRequestComment {
 		events = append(events, "issue_comment")
@@ -315,6 +464,15 @@ func convertHookEvent(from scm.HookEvents) []string {
 	if from.Release {
 		events = append(events, "release")
 	}
+	if from.Fork {
+		events = append(events, "fork")
+	}
+	if from.Repository {
+		events = append(events, "repository")
+	}
+	if from.Status {
+		events = append(events, "status")
+	}
 	return events
 }
 
@@ -362,3 +520,12 @@ func convertFromState(from scm.State) gitea.StatusState {
 		return gitea.StatusError
 	}
 }
+
+// helper function returns the label color with the leading #
+// expected by gitea.
+func convertLabelColor(from string) string {
+	if from == "" || strings.HasPrefix(from, "#") {
+		return from
+	}
+	return "#" + from
+}
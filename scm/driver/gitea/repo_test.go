@@ -227,6 +227,89 @@ func TestHookDelete(t *testing.T) {
 	}
 }
 
+func TestHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/hooks/20").
+		Reply(200).
+		Type("application/json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/hooks/20").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.UpdateHook(context.Background(), "go-gitea/gitea", &scm.HookInput{ID: "20"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestHookEnsure(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/hooks").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/hooks.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/hooks/20").
+		Reply(200).
+		Type("application/json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/hooks/20").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://try.gitea.io")
+	in := &scm.HookInput{
+		Target:       "http://gogs.io",
+		NativeEvents: []string{"create", "push"},
+	}
+	got, _, err := client.Repositories.EnsureHook(context.Background(), "go-gitea/gitea", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Changed() {
+		t.Errorf("Want unchanged hook, got %+v", got)
+	}
+
+	in.NativeEvents = []string{"push"}
+	got, _, err = client.Repositories.EnsureHook(context.Background(), "go-gitea/gitea", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got.Updated {
+		t.Errorf("Want updated hook, got %+v", got)
+	}
+	if got, want := got.Hook.ID, "20"; got != want {
+		t.Errorf("Want hook ID %q, got %q", want, got)
+	}
+}
+
 func TestHookEvents(t *testing.T) {
 	tests := []struct {
 		in  scm.HookEvents
@@ -250,15 +333,15 @@ func TestHookEvents(t *testing.T) {
 		},
 		{
 			in:  scm.HookEvents{Issue: true},
-			out: []string{"issues"},
+			out: []string{"issues", "issue_label", "issue_assign"},
 		},
 		{
 			in:  scm.HookEvents{PullRequest: true},
-			out: []string{"pull_request"},
+			out: []string{"pull_request", "pull_request_label", "pull_request_assign", "pull_request_milestone", "pull_request_sync"},
 		},
 		{
 			in:  scm.HookEvents{Review: true},
-			out: []string{"pull_request_review"},
+			out: []string{"pull_request_review", "pull_request_review_approved", "pull_request_review_rejected"},
 		},
 		{
 			in:  scm.HookEvents{Release: true},
@@ -277,7 +360,11 @@ func TestHookEvents(t *testing.T) {
 				ReviewComment:      true,
 				Tag:                true,
 			},
-			out: []string{"pull_request", "pull_request_review", "pull_request_review_comment", "issues", "issue_comment", "create", "delete", "push", "release"},
+			out: []string{"pull_request", "pull_request_label", "pull_request_assign", "pull_request_milestone", "pull_request_sync", "pull_request_review", "pull_request_review_approved", "pull_request_review_rejected", "pull_request_review_comment", "issues", "issue_label", "issue_assign", "issue_comment", "create", "delete", "push", "release"},
+		},
+		{
+			in:  scm.HookEvents{Fork: true, Repository: true, Status: true},
+			out: []string{"fork", "repository", "status"},
 		},
 	}
 	for _, test := range tests {
@@ -354,3 +441,141 @@ func TestStatusCreate(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestRepoUpdateLabel(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/labels/7").
+		Reply(200).
+		Type("application/json").
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name:  "kind/bug",
+		Color: "d73a4a",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.UpdateLabel(context.Background(), "go-gitea/gitea", "bug", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoDeleteLabel_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.DeleteLabel(context.Background(), "go-gitea/gitea", "kind/feature")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want error %v, got %v", scm.ErrNotFound, err)
+	}
+}
+
+func TestRepoUpdate(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/topics").
+		Reply(204)
+
+	wiki := false
+	in := &scm.RepositorySettings{
+		Description: "Git with a cup of tea",
+		Visibility:  scm.VisibilityPublic,
+		HasWiki:     &wiki,
+		Topics:      []string{"git"},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.Update(context.Background(), "go-gitea/gitea", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoUpdate_Internal(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Repositories.Update(context.Background(), "go-gitea/gitea", &scm.RepositorySettings{Visibility: scm.VisibilityInternal})
+	if _, ok := err.(*scm.UnsupportedFieldsError); !ok {
+		t.Errorf("Want unsupported fields error, got %v", err)
+	}
+}
+
+func TestRepoTransfer(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/gitea/gitea/transfer").
+		Reply(202).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.Transfer(context.Background(), "gitea/gitea", "go-gitea")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.FullName, "go-gitea/gitea"; got != want {
+		t.Errorf("Want repository %s, got %s", want, got)
+	}
+}
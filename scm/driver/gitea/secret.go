@@ -0,0 +1,97 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// secretService manages gitea actions secrets, which the sdk
+// does not support, using the actions api. Gitea has no
+// environment secrets.
+type secretService struct {
+	client *wrapper
+}
+
+type secret struct {
+	Name    string    `json:"name"`
+	Created time.Time `json:"created_at"`
+}
+
+type secretInput struct {
+	Data string `json:"data"`
+}
+
+func (s *secretService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/secrets?%s", repo, encodeListOptions(opts))
+	out := []*secret{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertSecretList(out), res, err
+}
+
+func (s *secretService) Set(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/secrets/%s", repo, input.Name)
+	return s.set(ctx, path, input)
+}
+
+func (s *secretService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/secrets/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *secretService) ListEnvironment(context.Context, string, string, scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *secretService) SetEnvironment(context.Context, string, string, *scm.SecretInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) DeleteEnvironment(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) ListOrganization(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/actions/secrets?%s", org, encodeListOptions(opts))
+	out := []*secret{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertSecretList(out), res, err
+}
+
+func (s *secretService) SetOrganization(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/actions/secrets/%s", org, input.Name)
+	return s.set(ctx, path, input)
+}
+
+func (s *secretService) DeleteOrganization(ctx context.Context, org, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/actions/secrets/%s", org, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function creates or updates the secret. Gitea
+// secrets are always masked and cannot be protected.
+func (s *secretService) set(ctx context.Context, path string, input *scm.SecretInput) (*scm.Response, error) {
+	res, err := s.client.do(ctx, "PUT", path, &secretInput{Data: input.Value}, nil)
+	if err != nil {
+		return res, err
+	}
+	return res, scm.CheckUnsupportedFields(input, "Protected", "Visibility", "SelectedRepositoryIDs")
+}
+
+func convertSecretList(from []*secret) []*scm.Secret {
+	to := []*scm.Secret{}
+	for _, v := range from {
+		to = append(to, &scm.Secret{
+			Name:    v.Name,
+			Masked:  true,
+			Created: v.Created,
+		})
+	}
+	return to
+}
@@ -0,0 +1,78 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestSecretList(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/actions/secrets").
+		Reply(200).
+		Type("application/json").
+		File("testdata/secrets.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Secrets.List(context.Background(), "go-gitea/gitea", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/secrets.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSecretSetOrganization(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/orgs/go-gitea/actions/secrets/DEPLOY_TOKEN").
+		JSON(map[string]string{"data": "s3cr3t"}).
+		Reply(204)
+
+	input := &scm.SecretInput{
+		Name:  "DEPLOY_TOKEN",
+		Value: "s3cr3t",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Secrets.SetOrganization(context.Background(), "go-gitea", input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSecretSetEnvironment(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Secrets.SetEnvironment(context.Background(), "go-gitea/gitea", "production", &scm.SecretInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Want error %v, got %v", scm.ErrNotSupported, err)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// systemHookService manages the gitea system webhooks, which
+// the sdk does not support, using the admin hooks api.
+type systemHookService struct {
+	client *wrapper
+}
+
+func (s *systemHookService) Find(ctx context.Context, id string) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/admin/hooks/%s", id)
+	out := new(gitea.Hook)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *systemHookService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	path := fmt.Sprintf("api/v1/admin/hooks?%s", params.Encode())
+	out := []*gitea.Hook{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookList(out), res, err
+}
+
+func (s *systemHookService) Create(ctx context.Context, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	config, err := convertHookConfig(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	in := gitea.CreateHookOption{
+		Type:   "gitea",
+		Config: config,
+		Events: append(
+			input.NativeEvents,
+			convertHookEvent(input.Events)...,
+		),
+		Active: true,
+	}
+	out := new(gitea.Hook)
+	res, err := s.client.do(ctx, "POST", "api/v1/admin/hooks", in, out)
+	return convertHook(out), res, err
+}
+
+func (s *systemHookService) Update(ctx context.Context, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	config, err := convertHookConfig(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	active := true
+	in := gitea.EditHookOption{
+		Config: config,
+		Events: append(
+			input.NativeEvents,
+			convertHookEvent(input.Events)...,
+		),
+		Active: &active,
+	}
+	path := fmt.Sprintf("api/v1/admin/hooks/%s", input.ID)
+	out := new(gitea.Hook)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertHook(out), res, err
+}
+
+func (s *systemHookService) Delete(ctx context.Context, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/admin/hooks/%s", id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
@@ -44,16 +44,22 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parseCreateHook(data)
 	case "delete":
 		hook, err = s.parseDeleteHook(data)
-	case "issues":
+	case "fork":
+		hook, err = s.parseForkHook(data)
+	case "issues", "issue_label", "issue_assign":
 		hook, err = s.parseIssueHook(data)
-	case "issue_comment":
+	case "issue_comment", "pull_request_comment":
 		hook, err = s.parseIssueCommentHook(data, guid)
-	case "pull_request":
+	case "pull_request", "pull_request_label", "pull_request_assign", "pull_request_milestone", "pull_request_sync":
 		hook, err = s.parsePullRequestHook(data)
-	case "reviewed":
-		hook, err = s.parsePullRequestReviewHook(data)
+	case "reviewed", "pull_request_review_approved", "pull_request_review_rejected", "pull_request_review_comment":
+		hook, err = s.parsePullRequestReviewHook(data, event)
 	case "release":
 		hook, err = s.parseReleaseHook(data)
+	case "repository":
+		hook, err = s.parseRepositoryHook(data)
+	case "status":
+		hook, err = s.parseStatusHook(data)
 	default:
 		return nil, scm.UnknownWebhook{Event: event}
 	}
@@ -158,9 +164,14 @@ func (s *webhookService) parsePullRequestHook(data []byte) (scm.Webhook, error)
 	return convertPullRequestHook(dst), err
 }
 
-func (s *webhookService) parsePullRequestReviewHook(data []byte) (scm.Webhook, error) {
+func (s *webhookService) parsePullRequestReviewHook(data []byte, event string) (scm.Webhook, error) {
 	dst := new(pullRequestReviewHook)
 	err := json.Unmarshal(data, dst)
+	// newer servers send the review type as the event
+	// header and may omit it from the payload.
+	if dst.Review.Type == "" {
+		dst.Review.Type = event
+	}
 	return convertPullRequestReviewHook(dst), err
 }
 
@@ -170,6 +181,24 @@ func (s *webhookService) parseReleaseHook(data []byte) (scm.Webhook, error) {
 	return convertReleaseHook(dst), err
 }
 
+func (s *webhookService) parseForkHook(data []byte) (scm.Webhook, error) {
+	dst := new(forkHook)
+	err := json.Unmarshal(data, dst)
+	return convertForkHook(dst), err
+}
+
+func (s *webhookService) parseRepositoryHook(data []byte) (scm.Webhook, error) {
+	dst := new(repositoryHook)
+	err := json.Unmarshal(data, dst)
+	return convertRepositoryHook(dst), err
+}
+
+func (s *webhookService) parseStatusHook(data []byte) (scm.Webhook, error) {
+	dst := new(statusHook)
+	err := json.Unmarshal(data, dst)
+	return convertStatusHook(dst), err
+}
+
 //
 // native data structures
 //
@@ -239,6 +268,34 @@ type (
 		Repository gitea.Repository `json:"repository"`
 		Sender     gitea.User       `json:"sender"`
 	}
+
+	// gitea fork webhook payload
+	forkHook struct {
+		Forkee     gitea.Repository `json:"forkee"`
+		Repository gitea.Repository `json:"repository"`
+		Sender     gitea.User       `json:"sender"`
+	}
+
+	// gitea repository webhook payload
+	repositoryHook struct {
+		Action       string           `json:"action"`
+		Repository   gitea.Repository `json:"repository"`
+		Organization gitea.User       `json:"organization"`
+		Sender       gitea.User       `json:"sender"`
+	}
+
+	// gitea commit status webhook payload
+	statusHook struct {
+		ID          int64             `json:"id"`
+		Sha         string            `json:"sha"`
+		State       gitea.StatusState `json:"state"`
+		Context     string            `json:"context"`
+		Description string            `json:"description"`
+		TargetURL   string            `json:"target_url"`
+		Commit      commit            `json:"commit"`
+		Repository  gitea.Repository  `json:"repository"`
+		Sender      gitea.User        `json:"sender"`
+	}
 )
 
 //
@@ -292,8 +349,9 @@ func convertPushHook(src *pushHook) *scm.PushHook {
 					Date:  src.Commits[0].Timestamp,
 				},
 			},
-			Repo:   *convertRepository(&src.Repository),
-			Sender: *convertUser(&src.Sender),
+			Commits: convertPushCommits(src.Commits),
+			Repo:    *convertRepository(&src.Repository),
+			Sender:  *convertUser(&src.Sender),
 		}
 	}
 	return &scm.PushHook{
@@ -320,6 +378,34 @@ func convertPushHook(src *pushHook) *scm.PushHook {
 	}
 }
 
+func convertPushCommits(src []commit) []scm.PushCommit {
+	var dst []scm.PushCommit
+	for _, c := range src {
+		dst = append(dst, scm.PushCommit{
+			ID:      c.ID,
+			Message: c.Message,
+			Author: scm.Signature{
+				Login: c.Author.Username,
+				Email: c.Author.Email,
+				Name:  c.Author.Name,
+				Date:  c.Timestamp,
+			},
+			Committer: scm.Signature{
+				Login: c.Committer.Username,
+				Email: c.Committer.Email,
+				Name:  c.Committer.Name,
+				Date:  c.Timestamp,
+			},
+			Timestamp: c.Timestamp,
+			Link:      c.URL,
+			Added:     c.Added,
+			Removed:   c.Removed,
+			Modified:  c.Modified,
+		})
+	}
+	return dst
+}
+
 func convertPullRequestHook(dst *pullRequestHook) *scm.PullRequestHook {
 	return &scm.PullRequestHook{
 		Action:      convertAction(dst.Action),
@@ -383,6 +469,56 @@ func convertReleaseHook(dst *releaseHook) *scm.ReleaseHook {
 	}
 }
 
+func convertForkHook(dst *forkHook) *scm.ForkHook {
+	return &scm.ForkHook{
+		Repo:   *convertRepository(&dst.Forkee),
+		Sender: *convertUser(&dst.Sender),
+	}
+}
+
+func convertRepositoryHook(dst *repositoryHook) *scm.RepositoryHook {
+	return &scm.RepositoryHook{
+		Action: convertAction(dst.Action),
+		Repo:   *convertRepository(&dst.Repository),
+		Sender: *convertUser(&dst.Sender),
+	}
+}
+
+func convertStatusHook(dst *statusHook) *scm.StatusHook {
+	sha := dst.Commit.ID
+	if sha == "" {
+		sha = dst.Sha
+	}
+	return &scm.StatusHook{
+		Action: scm.ActionCreate,
+		Repo:   *convertRepository(&dst.Repository),
+		Status: scm.Status{
+			State:  convertState(dst.State),
+			Label:  dst.Context,
+			Desc:   dst.Description,
+			Target: dst.TargetURL,
+		},
+		Commit: scm.Commit{
+			Sha:     sha,
+			Message: dst.Commit.Message,
+			Link:    dst.Commit.URL,
+			Author: scm.Signature{
+				Login: dst.Commit.Author.Username,
+				Email: dst.Commit.Author.Email,
+				Name:  dst.Commit.Author.Name,
+				Date:  dst.Commit.Timestamp,
+			},
+			Committer: scm.Signature{
+				Login: dst.Commit.Committer.Username,
+				Email: dst.Commit.Committer.Email,
+				Name:  dst.Commit.Committer.Name,
+				Date:  dst.Commit.Timestamp,
+			},
+		},
+		Sender: *convertUser(&dst.Sender),
+	}
+}
+
 func convertReviewAction(src string) (action scm.Action) {
 	switch src {
 	case "pull_request_review_approved":
@@ -422,6 +558,8 @@ func convertAction(src string) (action scm.Action) {
 		return scm.ActionAssigned
 	case "unassigned":
 		return scm.ActionUnassigned
+	case "milestoned", "demilestoned":
+		return scm.ActionUpdate
 	case "reviewed":
 		return scm.ActionSubmitted
 	default:
@@ -67,6 +67,18 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/issues_opened.json.golden",
 			obj:    new(scm.IssueHook),
 		},
+		{
+			event:  "issue_label",
+			before: "testdata/webhooks/issue_label.json",
+			after:  "testdata/webhooks/issue_label.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		{
+			event:  "issue_assign",
+			before: "testdata/webhooks/issue_assign.json",
+			after:  "testdata/webhooks/issue_assign.json.golden",
+			obj:    new(scm.IssueHook),
+		},
 		// issue comment hooks
 		{
 			event:  "issue_comment",
@@ -111,6 +123,30 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pull_request_merged.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		{
+			event:  "pull_request_label",
+			before: "testdata/webhooks/pull_request_label.json",
+			after:  "testdata/webhooks/pull_request_label.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		{
+			event:  "pull_request_assign",
+			before: "testdata/webhooks/pull_request_assign.json",
+			after:  "testdata/webhooks/pull_request_assign.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		{
+			event:  "pull_request_milestone",
+			before: "testdata/webhooks/pull_request_milestone.json",
+			after:  "testdata/webhooks/pull_request_milestone.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		{
+			event:  "pull_request_sync",
+			before: "testdata/webhooks/pull_request_sync.json",
+			after:  "testdata/webhooks/pull_request_sync.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
 		// pull request comment hooks
 		{
 			event:  "issue_comment",
@@ -134,6 +170,18 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/review_approved.json.golden",
 			obj:    new(scm.ReviewHook),
 		},
+		{
+			event:  "pull_request_review_rejected",
+			before: "testdata/webhooks/review_rejected.json",
+			after:  "testdata/webhooks/review_rejected.json.golden",
+			obj:    new(scm.ReviewHook),
+		},
+		{
+			event:  "pull_request_review_comment",
+			before: "testdata/webhooks/review_comment.json",
+			after:  "testdata/webhooks/review_comment.json.golden",
+			obj:    new(scm.ReviewHook),
+		},
 		// release hooks
 		{
 			event:  "release",
@@ -141,6 +189,27 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/release.json.golden",
 			obj:    new(scm.ReleaseHook),
 		},
+		// fork hooks
+		{
+			event:  "fork",
+			before: "testdata/webhooks/fork.json",
+			after:  "testdata/webhooks/fork.json.golden",
+			obj:    new(scm.ForkHook),
+		},
+		// repository hooks
+		{
+			event:  "repository",
+			before: "testdata/webhooks/repository.json",
+			after:  "testdata/webhooks/repository.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// status hooks
+		{
+			event:  "status",
+			before: "testdata/webhooks/status.json",
+			after:  "testdata/webhooks/status.json.golden",
+			obj:    new(scm.StatusHook),
+		},
 	}
 
 	defer gock.Off()
@@ -0,0 +1,260 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// maxAnnotations is the number of annotations github accepts
+// in a single check run request.
+const maxAnnotations = 50
+
+type checksService struct {
+	client *wrapper
+}
+
+type checkRunInput struct {
+	Name        string            `json:"name,omitempty"`
+	HeadSha     string            `json:"head_sha,omitempty"`
+	ExternalID  string            `json:"external_id,omitempty"`
+	DetailsURL  string            `json:"details_url,omitempty"`
+	Status      string            `json:"status,omitempty"`
+	Conclusion  string            `json:"conclusion,omitempty"`
+	StartedAt   *time.Time        `json:"started_at,omitempty"`
+	CompletedAt *time.Time        `json:"completed_at,omitempty"`
+	Output      *checkOutputInput `json:"output,omitempty"`
+	Actions     []*checkAction    `json:"actions,omitempty"`
+}
+
+type checkOutputInput struct {
+	Title       string             `json:"title"`
+	Summary     string             `json:"summary"`
+	Text        string             `json:"text,omitempty"`
+	Annotations []*checkAnnotation `json:"annotations,omitempty"`
+}
+
+type checkAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	EndLine         int    `json:"end_line"`
+	StartColumn     int    `json:"start_column,omitempty"`
+	EndColumn       int    `json:"end_column,omitempty"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title,omitempty"`
+	Message         string `json:"message"`
+	RawDetails      string `json:"raw_details,omitempty"`
+}
+
+type checkAction struct {
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	Identifier  string `json:"identifier"`
+}
+
+type checkRunList struct {
+	TotalCount int         `json:"total_count"`
+	CheckRuns  []*checkRun `json:"check_runs"`
+}
+
+type checkSuiteList struct {
+	TotalCount  int           `json:"total_count"`
+	CheckSuites []*checkSuite `json:"check_suites"`
+}
+
+func (s *checksService) CreateCheckRun(ctx context.Context, repo string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	in, batches := convertCheckRunInput(input)
+	path := fmt.Sprintf("repos/%s/check-runs", repo)
+	out := new(checkRun)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.annotate(ctx, repo, out, in.Output, batches, res)
+}
+
+func (s *checksService) UpdateCheckRun(ctx context.Context, repo string, id int64, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	in, batches := convertCheckRunInput(input)
+	path := fmt.Sprintf("repos/%s/check-runs/%d", repo, id)
+	out := new(checkRun)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.annotate(ctx, repo, out, in.Output, batches, res)
+}
+
+func (s *checksService) FindCheckRun(ctx context.Context, repo string, id int64) (*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-runs/%d", repo, id)
+	out := new(checkRun)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	run := convertCheckRun(out)
+	return &run, res, nil
+}
+
+func (s *checksService) ListCheckRuns(ctx context.Context, repo, ref string, opts scm.CheckRunListOptions) ([]*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/commits/%s/check-runs?%s", repo, ref, encodeCheckRunListOptions(opts))
+	out := new(checkRunList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	var runs []*scm.CheckRun
+	for _, v := range out.CheckRuns {
+		run := convertCheckRun(v)
+		runs = append(runs, &run)
+	}
+	return runs, res, err
+}
+
+func (s *checksService) ListCheckSuites(ctx context.Context, repo, ref string, opts scm.ListOptions) ([]*scm.CheckSuite, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/commits/%s/check-suites?%s", repo, ref, encodeListOptions(opts))
+	out := new(checkSuiteList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	var suites []*scm.CheckSuite
+	for _, v := range out.CheckSuites {
+		suites = append(suites, convertCheckSuite(v))
+	}
+	return suites, res, err
+}
+
+func (s *checksService) ListAnnotations(ctx context.Context, repo string, id int64, opts scm.ListOptions) ([]*scm.CheckRunAnnotation, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-runs/%d/annotations?%s", repo, id, encodeListOptions(opts))
+	out := []*checkAnnotation{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCheckAnnotationList(out), res, err
+}
+
+func (s *checksService) RerequestCheckRun(ctx context.Context, repo string, id int64) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-runs/%d/rerequest", repo, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *checksService) RerequestCheckSuite(ctx context.Context, repo string, id int64) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-suites/%d/rerequest", repo, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// helper function sends the remaining annotation batches.
+// github appends the annotations of every update to the
+// check run.
+func (s *checksService) annotate(ctx context.Context, repo string, out *checkRun, output *checkOutputInput, batches [][]*checkAnnotation, res *scm.Response) (*scm.CheckRun, *scm.Response, error) {
+	for _, batch := range batches {
+		path := fmt.Sprintf("repos/%s/check-runs/%d", repo, out.ID)
+		in := &checkRunInput{
+			Output: &checkOutputInput{
+				Title:       output.Title,
+				Summary:     output.Summary,
+				Text:        output.Text,
+				Annotations: batch,
+			},
+		}
+		var err error
+		out = new(checkRun)
+		res, err = s.client.do(ctx, "PATCH", path, in, out)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	run := convertCheckRun(out)
+	return &run, res, nil
+}
+
+func encodeCheckRunListOptions(opts scm.CheckRunListOptions) string {
+	params := url.Values{}
+	if opts.Name != "" {
+		params.Set("check_name", opts.Name)
+	}
+	if opts.Status != "" {
+		params.Set("status", opts.Status)
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
+// helper function converts the check run input, returning
+// the annotations above the request limit as batches.
+func convertCheckRunInput(from *scm.CheckRunInput) (*checkRunInput, [][]*checkAnnotation) {
+	to := &checkRunInput{
+		Name:       from.Name,
+		HeadSha:    from.HeadSha,
+		ExternalID: from.ExternalID,
+		DetailsURL: from.DetailsURL,
+		Status:     from.Status,
+		Conclusion: from.Conclusion,
+	}
+	if !from.Started.IsZero() {
+		to.StartedAt = &from.Started
+	}
+	if !from.Completed.IsZero() {
+		to.CompletedAt = &from.Completed
+	}
+	for _, action := range from.Actions {
+		to.Actions = append(to.Actions, &checkAction{
+			Label:       action.Label,
+			Description: action.Description,
+			Identifier:  action.Identifier,
+		})
+	}
+	if from.Output == nil {
+		return to, nil
+	}
+	to.Output = &checkOutputInput{
+		Title:   from.Output.Title,
+		Summary: from.Output.Summary,
+		Text:    from.Output.Text,
+	}
+	var annotations []*checkAnnotation
+	for _, a := range from.Output.Annotations {
+		annotations = append(annotations, &checkAnnotation{
+			Path:            a.Path,
+			StartLine:       a.StartLine,
+			EndLine:         a.EndLine,
+			StartColumn:     a.StartColumn,
+			EndColumn:       a.EndColumn,
+			AnnotationLevel: a.Level,
+			Title:           a.Title,
+			Message:         a.Message,
+			RawDetails:      a.RawDetails,
+		})
+	}
+	var batches [][]*checkAnnotation
+	for len(annotations) > maxAnnotations {
+		batches = append(batches, annotations[:maxAnnotations])
+		annotations = annotations[maxAnnotations:]
+	}
+	batches = append(batches, annotations)
+	to.Output.Annotations = batches[0]
+	return to, batches[1:]
+}
+
+func convertCheckAnnotationList(from []*checkAnnotation) []*scm.CheckRunAnnotation {
+	to := []*scm.CheckRunAnnotation{}
+	for _, v := range from {
+		to = append(to, &scm.CheckRunAnnotation{
+			Path:        v.Path,
+			StartLine:   v.StartLine,
+			EndLine:     v.EndLine,
+			StartColumn: v.StartColumn,
+			EndColumn:   v.EndColumn,
+			Level:       v.AnnotationLevel,
+			Title:       v.Title,
+			Message:     v.Message,
+			RawDetails:  v.RawDetails,
+		})
+	}
+	return to
+}
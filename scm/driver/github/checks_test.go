@@ -0,0 +1,330 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestChecksCreateCheckRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/check-runs").
+		JSON(map[string]interface{}{
+			"name":        "mighty_readme",
+			"head_sha":    "ce587453ced02b1526dfb4cb910479d431683101",
+			"external_id": "42",
+			"details_url": "https://example.com",
+			"status":      "completed",
+			"conclusion":  "neutral",
+			"output": map[string]interface{}{
+				"title":   "Mighty Readme report",
+				"summary": "There are 0 failures, 2 warnings, and 1 notice.",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_run.json")
+
+	input := &scm.CheckRunInput{
+		Name:       "mighty_readme",
+		HeadSha:    "ce587453ced02b1526dfb4cb910479d431683101",
+		ExternalID: "42",
+		DetailsURL: "https://example.com",
+		Status:     "completed",
+		Conclusion: "neutral",
+		Output: &scm.CheckRunOutputInput{
+			Title:   "Mighty Readme report",
+			Summary: "There are 0 failures, 2 warnings, and 1 notice.",
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Checks.CreateCheckRun(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CheckRun)
+	raw, _ := ioutil.ReadFile("testdata/check_run.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestChecksCreateCheckRunAnnotations(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/check-runs").
+		AddMatcher(annotationsMatcher(50, "Fix the spelling of every warning.")).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_run.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/check-runs/4").
+		AddMatcher(annotationsMatcher(50, "Fix the spelling of every warning.")).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_run.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/check-runs/4").
+		AddMatcher(annotationsMatcher(20, "Fix the spelling of every warning.")).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_run.json")
+
+	input := &scm.CheckRunInput{
+		Name:    "mighty_readme",
+		HeadSha: "ce587453ced02b1526dfb4cb910479d431683101",
+		Status:  "in_progress",
+		Output: &scm.CheckRunOutputInput{
+			Title:   "Mighty Readme report",
+			Summary: "There are 120 warnings.",
+			Text:    "Fix the spelling of every warning.",
+		},
+	}
+	for i := 1; i <= 120; i++ {
+		input.Output.Annotations = append(input.Output.Annotations, &scm.CheckRunAnnotation{
+			Path:      "README.md",
+			StartLine: i,
+			EndLine:   i,
+			Level:     "warning",
+			Message:   fmt.Sprintf("Check your spelling on line %d.", i),
+		})
+	}
+
+	client := NewDefault()
+	_, _, err := client.Checks.CreateCheckRun(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect annotations sent in 3 requests")
+	}
+}
+
+func TestChecksUpdateCheckRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/check-runs/4").
+		JSON(map[string]interface{}{
+			"status":     "completed",
+			"conclusion": "neutral",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_run.json")
+
+	input := &scm.CheckRunInput{
+		Status:     "completed",
+		Conclusion: "neutral",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Checks.UpdateCheckRun(context.Background(), "octocat/hello-world", 4, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CheckRun)
+	raw, _ := ioutil.ReadFile("testdata/check_run.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestChecksFindCheckRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/check-runs/4").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_run.json")
+
+	client := NewDefault()
+	got, res, err := client.Checks.FindCheckRun(context.Background(), "octocat/hello-world", 4)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CheckRun)
+	raw, _ := ioutil.ReadFile("testdata/check_run.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestChecksListCheckRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits/master/check-runs").
+		MatchParam("check_name", "mighty_readme").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/check_runs.json")
+
+	client := NewDefault()
+	opts := scm.CheckRunListOptions{Name: "mighty_readme", Page: 1, Size: 30}
+	got, res, err := client.Checks.ListCheckRuns(context.Background(), "octocat/hello-world", "master", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CheckRun{}
+	raw, _ := ioutil.ReadFile("testdata/check_runs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestChecksListCheckSuites(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits/master/check-suites").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_suites.json")
+
+	client := NewDefault()
+	got, res, err := client.Checks.ListCheckSuites(context.Background(), "octocat/hello-world", "master", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CheckSuite{}
+	raw, _ := ioutil.ReadFile("testdata/check_suites.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestChecksListAnnotations(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/check-runs/4/annotations").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_annotations.json")
+
+	client := NewDefault()
+	got, res, err := client.Checks.ListAnnotations(context.Background(), "octocat/hello-world", 4, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CheckRunAnnotation{}
+	raw, _ := ioutil.ReadFile("testdata/check_annotations.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestChecksRerequest(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/check-runs/4/rerequest").
+		Reply(201).
+		SetHeaders(mockHeaders)
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/check-suites/5/rerequest").
+		Reply(201).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	if _, err := client.Checks.RerequestCheckRun(context.Background(), "octocat/hello-world", 4); err != nil {
+		t.Error(err)
+	}
+	if _, err := client.Checks.RerequestCheckSuite(context.Background(), "octocat/hello-world", 5); err != nil {
+		t.Error(err)
+	}
+}
+
+// helper function returns a matcher for a check run request
+// with the given output text and number of annotations.
+func annotationsMatcher(n int, text string) gock.MatchFunc {
+	return func(req *http.Request, _ *gock.Request) (bool, error) {
+		in := new(checkRunInput)
+		if err := json.NewDecoder(req.Body).Decode(in); err != nil {
+			return false, err
+		}
+		return in.Output != nil && in.Output.Text == text && len(in.Output.Annotations) == n, nil
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -63,6 +64,213 @@ func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*s
 	return nil, scm.ErrNotSupported
 }
 
+// CommitFiles creates the blobs, tree and commit of the file
+// changes with the Git data API, and then updates the branch.
+func (s *contentService) CommitFiles(ctx context.Context, repo string, params *scm.CommitFilesParams) (*scm.Commit, *scm.Response, error) {
+	branch := params.Branch
+	if branch == "" {
+		branch = params.Base
+	}
+	base, res, err := s.client.Git.FindRef(ctx, repo, "heads/"+branch)
+	created := err == scm.ErrNotFound && branch != params.Base
+	if created {
+		base, res, err = s.client.Git.FindRef(ctx, repo, "heads/"+params.Base)
+	}
+	if err != nil {
+		return nil, res, err
+	}
+	parent := new(gitCommit)
+	res, err = s.client.do(ctx, "GET", fmt.Sprintf("repos/%s/git/commits/%s", repo, base), nil, parent)
+	if err != nil {
+		return nil, res, err
+	}
+
+	// the existing entries are only needed to keep the mode,
+	// or the content, of changed files.
+	var paths []string
+	for _, c := range params.Changes {
+		switch {
+		case c.Action == scm.FileMove:
+			paths = append(paths, c.PreviousPath)
+		case c.Action == scm.FileChmod || (c.Action == scm.FileUpdate && c.Executable == nil):
+			paths = append(paths, c.Path)
+		}
+	}
+	existing, res, err := s.findTreeEntries(ctx, repo, parent.Tree.Sha, paths)
+	if err != nil {
+		return nil, res, err
+	}
+
+	var entries []*treeEntry
+	for _, c := range params.Changes {
+		from := c.Path
+		if c.Action == scm.FileMove {
+			from = c.PreviousPath
+		}
+		entry := &treeEntry{Path: c.Path, Mode: fileMode(c.Executable), Type: "blob"}
+		if old, ok := existing[from]; ok {
+			entry.Sha = old.Sha
+			if c.Executable == nil {
+				entry.Mode = old.Mode
+			}
+		} else if c.Action == scm.FileMove || c.Action == scm.FileChmod {
+			return nil, nil, fmt.Errorf("file %s not found in %s", from, base)
+		}
+		switch c.Action {
+		case scm.FileDelete:
+			entry.Sha = nil
+		case scm.FileMove:
+			entries = append(entries, &treeEntry{Path: c.PreviousPath, Mode: entry.Mode, Type: "blob"})
+		}
+		if c.Action == scm.FileCreate || c.Action == scm.FileUpdate || (c.Action == scm.FileMove && c.Data != nil) {
+			blob := new(gitObject)
+			in := &blobInput{Content: base64.StdEncoding.EncodeToString(c.Data), Encoding: "base64"}
+			res, err = s.client.do(ctx, "POST", fmt.Sprintf("repos/%s/git/blobs", repo), in, blob)
+			if err != nil {
+				return nil, res, err
+			}
+			entry.Sha = &blob.Sha
+		}
+		entries = append(entries, entry)
+	}
+
+	root := new(gitObject)
+	res, err = s.client.do(ctx, "POST", fmt.Sprintf("repos/%s/git/trees", repo), &treeInput{BaseTree: parent.Tree.Sha, Tree: entries}, root)
+	if err != nil {
+		return nil, res, err
+	}
+	in := &commitInput{
+		Message: params.Message,
+		Tree:    root.Sha,
+		Parents: []string{base},
+	}
+	if params.Author.Name != "" {
+		in.Author = &signatureInput{Name: params.Author.Name, Email: params.Author.Email}
+		if !params.Author.Date.IsZero() {
+			in.Author.Date = &params.Author.Date
+		}
+	}
+	out := new(gitCommit)
+	res, err = s.client.do(ctx, "POST", fmt.Sprintf("repos/%s/git/commits", repo), in, out)
+	if err != nil {
+		return nil, res, err
+	}
+
+	if created {
+		_, res, err = s.client.Git.CreateRef(ctx, repo, "refs/heads/"+branch, out.Sha)
+	} else {
+		path := fmt.Sprintf("repos/%s/git/refs/heads/%s", repo, branch)
+		res, err = s.client.do(ctx, "PATCH", path, &refInput{Sha: out.Sha}, nil)
+	}
+	return convertGitCommit(out), res, err
+}
+
+// helper function returns the tree entries of the paths and
+// their parent directories, keyed by path. Only the trees of
+// the parent directories are listed, since recursive listings
+// of large trees are truncated.
+func (s *contentService) findTreeEntries(ctx context.Context, repo, sha string, paths []string) (map[string]*treeEntry, *scm.Response, error) {
+	var res *scm.Response
+	entries := map[string]*treeEntry{}
+	trees := map[string]string{"": sha}
+	listed := map[string]bool{}
+	for _, p := range paths {
+		parts := strings.Split(p, "/")
+		for i := range parts {
+			dir := strings.Join(parts[:i], "/")
+			if listed[dir] {
+				continue
+			}
+			tsha, ok := trees[dir]
+			if !ok {
+				break
+			}
+			out := new(tree)
+			var err error
+			res, err = s.client.do(ctx, "GET", fmt.Sprintf("repos/%s/git/trees/%s", repo, tsha), nil, out)
+			if err != nil {
+				return nil, res, err
+			}
+			if out.Truncated {
+				return nil, res, fmt.Errorf("tree %s is too large to list", tsha)
+			}
+			listed[dir] = true
+			for _, e := range out.Tree {
+				if dir != "" {
+					e.Path = dir + "/" + e.Path
+				}
+				entries[e.Path] = e
+				if e.Type == "tree" && e.Sha != nil {
+					trees[e.Path] = *e.Sha
+				}
+			}
+		}
+	}
+	return entries, res, nil
+}
+
+type gitObject struct {
+	Sha string `json:"sha"`
+}
+
+type gitCommit struct {
+	Sha       string       `json:"sha"`
+	Message   string       `json:"message"`
+	HTMLURL   string       `json:"html_url"`
+	Tree      gitObject    `json:"tree"`
+	Author    commitAuthor `json:"author"`
+	Committer commitAuthor `json:"committer"`
+}
+
+type commitAuthor struct {
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Date  time.Time `json:"date"`
+}
+
+type signatureInput struct {
+	Name  string     `json:"name"`
+	Email string     `json:"email"`
+	Date  *time.Time `json:"date,omitempty"`
+}
+
+type tree struct {
+	Sha       string       `json:"sha"`
+	Tree      []*treeEntry `json:"tree"`
+	Truncated bool         `json:"truncated"`
+}
+
+// treeEntry is a tree entry, which deletes the path when
+// created without a sha.
+type treeEntry struct {
+	Path string  `json:"path"`
+	Mode string  `json:"mode"`
+	Type string  `json:"type"`
+	Sha  *string `json:"sha"`
+}
+
+type blobInput struct {
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+type treeInput struct {
+	BaseTree string       `json:"base_tree"`
+	Tree     []*treeEntry `json:"tree"`
+}
+
+type commitInput struct {
+	Message string          `json:"message"`
+	Tree    string          `json:"tree"`
+	Parents []string        `json:"parents"`
+	Author  *signatureInput `json:"author,omitempty"`
+}
+
+type refInput struct {
+	Sha   string `json:"sha"`
+	Force bool   `json:"force"`
+}
+
 type content struct {
 	Name    string `json:"name"`
 	Path    string `json:"path"`
@@ -120,3 +328,32 @@ func convertEntry(from *entry) *scm.FileEntry {
 		Link: from.URL,
 	}
 }
+
+func convertGitCommit(from *gitCommit) *scm.Commit {
+	return &scm.Commit{
+		Sha:     from.Sha,
+		Message: from.Message,
+		Tree: scm.CommitTree{
+			Sha: from.Tree.Sha,
+		},
+		Author: scm.Signature{
+			Name:  from.Author.Name,
+			Email: from.Author.Email,
+			Date:  from.Author.Date,
+		},
+		Committer: scm.Signature{
+			Name:  from.Committer.Name,
+			Email: from.Committer.Email,
+			Date:  from.Committer.Date,
+		},
+		Link: from.HTMLURL,
+	}
+}
+
+// helper function returns the git file mode.
+func fileMode(executable *bool) string {
+	if executable != nil && *executable {
+		return "100755"
+	}
+	return "100644"
+}
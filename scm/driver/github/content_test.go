@@ -9,6 +9,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -160,6 +161,123 @@ func TestContentDelete(t *testing.T) {
 	}
 }
 
+func TestContentCommitFiles(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/refs/heads/featureA").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ref.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/commits/aa218f56b14c9653891f9e74264a383fa43fefbd").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_commit.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/trees/691272480426f78a0138979dd3ce63b77f706feb").
+		AddMatcher(notRecursive).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_tree.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/trees/f484d249c660418515fb01c2b9662073663c242e").
+		AddMatcher(notRecursive).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_tree_scripts.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/blobs").
+		JSON(map[string]string{"content": encode([]byte("Hello World!")), "encoding": "base64"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_blob.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/trees").
+		JSON(map[string]interface{}{
+			"base_tree": "691272480426f78a0138979dd3ce63b77f706feb",
+			"tree": []map[string]interface{}{
+				{"path": "README", "mode": "100644", "type": "blob", "sha": "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15"},
+				{"path": "scripts/build.sh", "mode": "100755", "type": "blob", "sha": nil},
+				{"path": "scripts/ci.sh", "mode": "100755", "type": "blob", "sha": "45b983be36b73c0788dc9cbcb76cbb80fc7bb057"},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"sha": "827efc6d56897b048c772eb4087f854f46256132"}`)
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/commits").
+		JSON(map[string]interface{}{
+			"message": "update readme and move build script",
+			"tree":    "827efc6d56897b048c772eb4087f854f46256132",
+			"parents": []string{"aa218f56b14c9653891f9e74264a383fa43fefbd"},
+			"author":  map[string]string{"name": "Monalisa Octocat", "email": "octocat@github.com"},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_commit_create.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/git/refs/heads/featureA").
+		JSON(map[string]interface{}{"sha": "7638417db6d59f3c431d3e1f261cc637155684cd", "force": false}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ref.json")
+
+	params := &scm.CommitFilesParams{
+		Base:    "master",
+		Branch:  "featureA",
+		Message: "update readme and move build script",
+		Author:  scm.Signature{Name: "Monalisa Octocat", Email: "octocat@github.com"},
+		Changes: []scm.FileChange{
+			{Action: scm.FileUpdate, Path: "README", Data: []byte("Hello World!")},
+			{Action: scm.FileMove, Path: "scripts/ci.sh", PreviousPath: "scripts/build.sh"},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Contents.CommitFiles(context.Background(), "octocat/hello-world", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/git_commit_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+// helper function matches tree requests that do not list the
+// subtrees.
+func notRecursive(req *http.Request, _ *gock.Request) (bool, error) {
+	return req.URL.Query().Get("recursive") == "", nil
+}
+
 func encode(b []byte) string {
 	return base64.StdEncoding.EncodeToString([]byte(b))
 }
@@ -0,0 +1,119 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type deliveryService struct {
+	client *wrapper
+}
+
+type delivery struct {
+	ID          int64     `json:"id"`
+	GUID        string    `json:"guid"`
+	DeliveredAt time.Time `json:"delivered_at"`
+	Redelivery  bool      `json:"redelivery"`
+	Duration    float64   `json:"duration"`
+	Status      string    `json:"status"`
+	StatusCode  int       `json:"status_code"`
+	Event       string    `json:"event"`
+	Action      string    `json:"action"`
+	URL         string    `json:"url"`
+	Request     *struct {
+		Headers map[string]string `json:"headers"`
+		Payload json.RawMessage   `json:"payload"`
+	} `json:"request"`
+	Response *struct {
+		Headers map[string]string `json:"headers"`
+		Payload string            `json:"payload"`
+	} `json:"response"`
+}
+
+func (s *deliveryService) List(ctx context.Context, repo, hook string, opts scm.ListOptions) ([]*scm.WebhookDelivery, *scm.Response, error) {
+	// deliveries use cursor based pagination so the next
+	// page is requested using the url from the response.
+	path := opts.URL
+	if path == "" {
+		params := url.Values{}
+		if opts.Size != 0 {
+			params.Set("per_page", strconv.Itoa(opts.Size))
+		}
+		path = fmt.Sprintf("repos/%s/hooks/%s/deliveries?%s", repo, hook, params.Encode())
+	}
+	out := []*delivery{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeliveryList(out), res, err
+}
+
+func (s *deliveryService) Find(ctx context.Context, repo, hook, id string) (*scm.WebhookDelivery, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/deliveries/%s", repo, hook, id)
+	out := new(delivery)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDelivery(out), res, err
+}
+
+func (s *deliveryService) Redeliver(ctx context.Context, repo, hook, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/deliveries/%s/attempts", repo, hook, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *deliveryService) Ping(ctx context.Context, repo, hook string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/pings", repo, hook)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func convertDeliveryList(from []*delivery) []*scm.WebhookDelivery {
+	to := []*scm.WebhookDelivery{}
+	for _, v := range from {
+		to = append(to, convertDelivery(v))
+	}
+	return to
+}
+
+func convertDelivery(from *delivery) *scm.WebhookDelivery {
+	to := &scm.WebhookDelivery{
+		ID:         strconv.FormatInt(from.ID, 10),
+		GUID:       from.GUID,
+		Event:      from.Event,
+		Action:     from.Action,
+		Status:     from.Status,
+		StatusCode: from.StatusCode,
+		Duration:   time.Duration(from.Duration * float64(time.Second)),
+		Redelivery: from.Redelivery,
+		Delivered:  from.DeliveredAt,
+	}
+	if from.Request != nil {
+		to.Request = &scm.WebhookDeliveryRequest{
+			URL:    from.URL,
+			Header: convertDeliveryHeader(from.Request.Headers),
+			Body:   string(from.Request.Payload),
+		}
+	}
+	if from.Response != nil {
+		to.Response = &scm.WebhookDeliveryResponse{
+			Header: convertDeliveryHeader(from.Response.Headers),
+			Body:   from.Response.Payload,
+		}
+	}
+	return to
+}
+
+func convertDeliveryHeader(from map[string]string) http.Header {
+	to := http.Header{}
+	for k, v := range from {
+		to.Set(k, v)
+	}
+	return to
+}
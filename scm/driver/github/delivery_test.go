@@ -0,0 +1,138 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestDeliveryList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks/1/deliveries").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeader("Link", `<https://api.github.com/repositories/1/hooks/1/deliveries?per_page=30&cursor=v1_123>; rel="next"`).
+		File("testdata/deliveries.json")
+
+	client := NewDefault()
+	got, res, err := client.Deliveries.List(context.Background(), "octocat/hello-world", "1", scm.ListOptions{Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.WebhookDelivery{}
+	raw, _ := ioutil.ReadFile("testdata/deliveries.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if got, want := res.Page.NextURL, "https://api.github.com/repositories/1/hooks/1/deliveries?per_page=30&cursor=v1_123"; got != want {
+		t.Errorf("Want next url %q, got %q", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeliveryFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks/1/deliveries/12345678").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/delivery.json")
+
+	client := NewDefault()
+	got, res, err := client.Deliveries.Find(context.Background(), "octocat/hello-world", "1", "12345678")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.WebhookDelivery)
+	raw, _ := ioutil.ReadFile("testdata/delivery.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+
+	// the recorded request must be accepted by the webhook
+	// parser.
+	req, err := got.Request.HTTPRequest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hook, err := client.Webhooks.Parse(req, func(scm.Webhook) (string, error) {
+		return "", nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := hook.(*scm.PingHook); !ok {
+		t.Errorf("Expected ping hook, got %T", hook)
+	}
+}
+
+func TestDeliveryRedeliver(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/hooks/1/deliveries/12345678/attempts").
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Deliveries.Redeliver(context.Background(), "octocat/hello-world", "1", "12345678")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeliveryPing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/hooks/1/pings").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Deliveries.Ping(context.Background(), "octocat/hello-world", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
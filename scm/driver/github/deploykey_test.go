@@ -0,0 +1,141 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestDeployKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/keys/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deploy_key.json")
+
+	client := NewDefault()
+	got, res, err := client.DeployKeys.Find(context.Background(), "octocat/hello-world", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/deploy_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeployKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/keys").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/deploy_keys.json")
+
+	client := NewDefault()
+	got, res, err := client.DeployKeys.List(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.DeployKey{}
+	raw, _ := ioutil.ReadFile("testdata/deploy_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestDeployKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/keys").
+		JSON(map[string]interface{}{
+			"title":     "gitops-agent",
+			"key":       "ssh-rsa AAA...",
+			"read_only": true,
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deploy_key.json")
+
+	input := &scm.DeployKeyInput{
+		Title:    "gitops-agent",
+		Key:      "ssh-rsa AAA...",
+		ReadOnly: true,
+	}
+
+	client := NewDefault()
+	got, res, err := client.DeployKeys.Create(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/deploy_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeployKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/keys/1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.DeployKeys.Delete(context.Background(), "octocat/hello-world", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -0,0 +1,288 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/pkg/hmac"
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/null"
+)
+
+// Generate renders the webhook as the request github would
+// send, signed with the secret. Only push, pull request,
+// branch and tag webhooks can be generated, other webhooks
+// return ErrNotSupported.
+func (s *webhookService) Generate(hook scm.Webhook, secret string) (*http.Request, error) {
+	var event, guid string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event, guid, payload = "push", v.GUID, convertFromPushHook(v)
+	case *scm.PullRequestHook:
+		event, guid, payload = "pull_request", v.GUID, convertFromPullRequestHook(v)
+	case *scm.BranchHook:
+		event, payload = convertFromRefAction(v.Action), convertFromBranchHook(v)
+	case *scm.TagHook:
+		event, payload = convertFromRefAction(v.Action), convertFromTagHook(v)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if guid == "" {
+		guid = scm.NewDeliveryID()
+	}
+	header := http.Header{}
+	header.Set("X-GitHub-Event", event)
+	header.Set("X-GitHub-Delivery", guid)
+	if secret != "" {
+		header.Set("X-Hub-Signature", hmac.SignPrefix("sha1", data, []byte(secret)))
+		header.Set("X-Hub-Signature-256", hmac.SignPrefix("sha256", data, []byte(secret)))
+	}
+	return scm.NewWebhookRequest("/", data, header)
+}
+
+func convertFromPushHook(from *scm.PushHook) *pushHook {
+	to := &pushHook{
+		Ref:          from.Ref,
+		BaseRef:      from.BaseRef,
+		Before:       from.Before,
+		After:        from.After,
+		Compare:      from.Compare,
+		Created:      from.Created,
+		Deleted:      from.Deleted,
+		Forced:       from.Forced,
+		Pusher:       convertFromUser(&from.Sender),
+		Sender:       convertFromUser(&from.Sender),
+		Installation: convertFromInstallationRef(from.Installation),
+	}
+	to.Head.ID = from.Commit.Sha
+	to.Head.Message = from.Commit.Message
+	to.Head.Author.Name = from.Commit.Author.Name
+	to.Head.Author.Email = from.Commit.Author.Email
+	to.Head.Author.Username = from.Commit.Author.Login
+	to.Head.Committer.Name = from.Commit.Committer.Name
+	to.Head.Committer.Email = from.Commit.Committer.Email
+	to.Head.Committer.Username = from.Commit.Committer.Login
+	for _, c := range from.Commits {
+		commit := pushCommit{
+			ID:       c.ID,
+			Distinct: c.Distinct,
+			Message:  c.Message,
+			URL:      c.Link,
+			Added:    c.Added,
+			Removed:  c.Removed,
+			Modified: c.Modified,
+		}
+		if !c.Timestamp.IsZero() {
+			commit.Timestamp = c.Timestamp.Format(time.RFC3339)
+		}
+		commit.Author.Name = c.Author.Name
+		commit.Author.Email = c.Author.Email
+		commit.Author.Username = c.Author.Login
+		commit.Committer.Name = c.Committer.Name
+		commit.Committer.Email = c.Committer.Email
+		commit.Committer.Username = c.Committer.Login
+		to.Commits = append(to.Commits, commit)
+	}
+	id, _ := strconv.ParseInt(from.Repo.ID, 10, 64)
+	to.Repository.ID = id
+	to.Repository.Owner.Login = from.Repo.Namespace
+	to.Repository.Name = from.Repo.Name
+	to.Repository.FullName = from.Repo.FullName
+	to.Repository.DefaultBranch = from.Repo.Branch
+	to.Repository.Private = from.Repo.Private
+	to.Repository.CloneURL = from.Repo.Clone
+	to.Repository.SSHURL = from.Repo.CloneSSH
+	to.Repository.HTMLURL = from.Repo.Link
+	return to
+}
+
+func convertFromBranchHook(from *scm.BranchHook) *createDeleteHook {
+	return &createDeleteHook{
+		Ref:          from.Ref.Name,
+		RefType:      "branch",
+		Repository:   convertFromRepository(&from.Repo),
+		Sender:       convertFromUser(&from.Sender),
+		Installation: convertFromInstallationRef(from.Installation),
+	}
+}
+
+func convertFromTagHook(from *scm.TagHook) *createDeleteHook {
+	return &createDeleteHook{
+		Ref:          from.Ref.Name,
+		RefType:      "tag",
+		Repository:   convertFromRepository(&from.Repo),
+		Sender:       convertFromUser(&from.Sender),
+		Installation: convertFromInstallationRef(from.Installation),
+	}
+}
+
+// convertFromRefAction returns the github event for a branch
+// or tag action, which github reports as create or delete.
+func convertFromRefAction(from scm.Action) string {
+	if from == scm.ActionDelete {
+		return "delete"
+	}
+	return "create"
+}
+
+func convertFromPullRequestHook(from *scm.PullRequestHook) *pullRequestHook {
+	to := &pullRequestHook{
+		Action:       convertFromPullRequestAction(from.Action),
+		Number:       from.PullRequest.Number,
+		PullRequest:  convertFromPullRequest(&from.PullRequest),
+		Repository:   convertFromRepository(&from.Repo),
+		Label:        convertFromLabel(&from.Label),
+		Sender:       convertFromUser(&from.Sender),
+		Installation: convertFromInstallationRef(from.Installation),
+	}
+	to.Changes.Base.Ref.From = from.Changes.Base.Ref.From
+	to.Changes.Base.Sha.From = from.Changes.Base.Sha.From
+	return to
+}
+
+func convertFromPullRequestAction(from scm.Action) string {
+	switch from {
+	case scm.ActionAssigned:
+		return "assigned"
+	case scm.ActionUnassigned:
+		return "unassigned"
+	case scm.ActionReviewRequested:
+		return "review_requested"
+	case scm.ActionReviewRequestRemoved:
+		return "review_request_removed"
+	case scm.ActionLabel:
+		return "labeled"
+	case scm.ActionUnlabel:
+		return "unlabeled"
+	case scm.ActionUpdate:
+		return "edited"
+	case scm.ActionClose, scm.ActionMerge:
+		return "closed"
+	case scm.ActionReopen:
+		return "reopened"
+	case scm.ActionSync:
+		return "synchronize"
+	case scm.ActionReadyForReview:
+		return "ready_for_review"
+	case scm.ActionConvertedToDraft:
+		return "converted_to_draft"
+	default:
+		return "opened"
+	}
+}
+
+func convertFromPullRequest(from *scm.PullRequest) pr {
+	to := pr{
+		Number:             from.Number,
+		State:              from.State,
+		Title:              from.Title,
+		Body:               from.Body,
+		DiffURL:            from.DiffLink,
+		HTMLURL:            from.Link,
+		User:               convertFromUser(&from.Author),
+		RequestedReviewers: convertFromUsers(from.Reviewers),
+		Assignees:          convertFromUsers(from.Assignees),
+		Head:               convertFromPullRequestBranch(&from.Head),
+		Base:               convertFromPullRequestBranch(&from.Base),
+		Draft:              from.Draft,
+		Merged:             from.Merged,
+		Mergeable:          from.Mergeable,
+		MergeableState:     string(from.MergeableState),
+		Rebaseable:         from.Rebaseable,
+		MergeSha:           from.MergeSha,
+		CreatedAt:          from.Created,
+		UpdatedAt:          from.Updated,
+	}
+	for _, l := range from.Labels {
+		to.Labels = append(to.Labels, &label{
+			Name:        l.Name,
+			Description: l.Description,
+			URL:         l.URL,
+			Color:       l.Color,
+		})
+	}
+	return to
+}
+
+func convertFromPullRequestBranch(from *scm.PullRequestBranch) prBranch {
+	return prBranch{
+		Ref:  from.Ref,
+		Sha:  from.Sha,
+		Repo: convertFromRepository(&from.Repo),
+	}
+}
+
+func convertFromRepository(from *scm.Repository) repository {
+	id, _ := strconv.Atoi(from.ID)
+	to := repository{
+		ID:            id,
+		Name:          from.Name,
+		FullName:      from.FullName,
+		Private:       from.Private,
+		Archived:      from.Archived,
+		HTMLURL:       from.Link,
+		SSHURL:        from.CloneSSH,
+		CloneURL:      from.Clone,
+		DefaultBranch: from.Branch,
+		CreatedAt:     from.Created,
+		UpdatedAt:     from.Updated,
+	}
+	to.Owner.Login = from.Namespace
+	if from.Perm != nil {
+		to.Permissions.Admin = from.Perm.Admin
+		to.Permissions.Push = from.Perm.Push
+		to.Permissions.Pull = from.Perm.Pull
+	}
+	return to
+}
+
+func convertFromLabel(from *scm.Label) label {
+	return label{
+		URL:         from.URL,
+		Name:        from.Name,
+		Description: from.Description,
+		Color:       from.Color,
+	}
+}
+
+func convertFromUsers(from []scm.User) []user {
+	var to []user
+	for i := range from {
+		to = append(to, convertFromUser(&from[i]))
+	}
+	return to
+}
+
+func convertFromUser(from *scm.User) user {
+	return user{
+		ID:      from.ID,
+		Login:   from.Login,
+		Name:    from.Name,
+		Email:   null.NewString(from.Email, from.Email != ""),
+		Avatar:  from.Avatar,
+		HTMLURL: from.Link,
+		Created: from.Created,
+		Updated: from.Updated,
+	}
+}
+
+func convertFromInstallationRef(from *scm.InstallationRef) *installationRef {
+	if from == nil {
+		return nil
+	}
+	return &installationRef{
+		ID:     from.ID,
+		NodeID: from.NodeID,
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/internal/webhooktest"
+)
+
+func TestWebhookGenerate(t *testing.T) {
+	tests := []webhooktest.Test{
+		{Event: "push", File: "push.json"},
+		{Event: "push", File: "push_commits.json"},
+		{Event: "push", File: "push_branch_create.json"},
+		{Event: "push", File: "push_branch_delete.json"},
+		{Event: "push", File: "push_tag.json"},
+		{Event: "push", File: "push_tag_delete.json"},
+		{Event: "create", File: "branch_create.json"},
+		{Event: "delete", File: "branch_delete.json"},
+		{Event: "create", File: "tag_create.json"},
+		{Event: "delete", File: "tag_delete.json"},
+		{Event: "pull_request", File: "pr_opened.json"},
+		{Event: "pull_request", File: "pr_closed.json"},
+		{Event: "pull_request", File: "pr_edited.json"},
+		{Event: "pull_request", File: "pr_labeled.json"},
+		{Event: "pull_request", File: "pr_sync.json"},
+		{Event: "pull_request", File: "pr_ready_for_review.json"},
+	}
+	webhooktest.RoundTrip(t, new(webhookService), generateHeader, tests)
+}
+
+func TestWebhookGenerateNotSupported(t *testing.T) {
+	tests := []scm.Webhook{
+		&scm.CheckRunHook{},
+		&scm.CheckSuiteHook{},
+		&scm.CommitCommentHook{},
+		&scm.DeployHook{},
+		&scm.DeploymentStatusHook{},
+		&scm.ForkHook{},
+		&scm.InstallationHook{},
+		&scm.InstallationRepositoryHook{},
+		&scm.IssueCommentHook{},
+		&scm.IssueHook{},
+		&scm.JobHook{},
+		&scm.LabelHook{},
+		&scm.MemberHook{},
+		&scm.MembershipHook{},
+		&scm.MergeGroupHook{},
+		&scm.MilestoneHook{},
+		&scm.OrganizationHook{},
+		&scm.PingHook{},
+		&scm.PipelineHook{},
+		&scm.PublicHook{},
+		&scm.PullRequestCommentHook{},
+		&scm.ReleaseHook{},
+		&scm.RepositoryDispatchHook{},
+		&scm.RepositoryHook{},
+		&scm.ReviewCommentHook{},
+		&scm.ReviewHook{},
+		&scm.StarHook{},
+		&scm.StatusHook{},
+		&scm.TeamHook{},
+		&scm.WatchHook{},
+		&scm.WorkflowJobHook{},
+		&scm.WorkflowRunHook{},
+	}
+	client := NewDefault()
+	for _, hook := range tests {
+		_, err := scm.GenerateWebhook(client, hook, "topsecret")
+		if err != scm.ErrNotSupported {
+			t.Errorf("Expect Not Supported error for %T, got %v", hook, err)
+		}
+	}
+}
+
+// generateHeader returns the headers GitHub sends with the event.
+func generateHeader(event string) http.Header {
+	header := http.Header{}
+	header.Set("X-GitHub-Event", event)
+	header.Set("X-GitHub-Delivery", "f2467dea-70d6-11e8-8955-3c83993e0aef")
+	return header
+}
@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -44,18 +45,24 @@ func New(uri string) (*scm.Client, error) {
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverGithub
+	client.BranchProtections = &branchProtectionService{client}
+	client.Checks = &checksService{client}
 	client.Contents = &contentService{client}
 	client.Deployments = &deploymentService{client}
+	client.DeployKeys = &deployKeyService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
 	client.Milestones = &milestoneService{client}
 	client.Releases = &releaseService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.Deliveries = &deliveryService{client}
 	client.Apps = &appService{client}
 
 	graphqlEndpoint := scm.URLJoin(uri, "/graphql")
@@ -170,6 +177,13 @@ func (c *wrapper) doRequest(ctx context.Context, req *scm.Request, in, out inter
 		return res, nil
 	}
 
+	// if raw output is expected, copy to the provided
+	// buffer and exit.
+	if w, ok := out.(io.Writer); ok {
+		_, err := io.Copy(w, res.Body)
+		return res, err
+	}
+
 	// if a json response is expected, parse and return
 	// the json response.
 	return res, json.NewDecoder(res.Body).Decode(out)
@@ -52,10 +52,25 @@ type inviter struct {
 	Login string `json:"login"`
 }
 
+type teamInput struct {
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	Privacy      string `json:"privacy,omitempty"`
+	ParentTeamID int    `json:"parent_team_id,omitempty"`
+}
+
 type teamMember struct {
 	Login string `json:"login"`
 }
 
+type roleInput struct {
+	Role string `json:"role"`
+}
+
+type permissionInput struct {
+	Permission string `json:"permission"`
+}
+
 type membership struct {
 	Role         string       `json:"role"`
 	State        string       `json:"state"`
@@ -200,6 +215,102 @@ func (s *organizationService) AcceptOrganizationInvitation(ctx context.Context,
 	return s.client.doRequest(ctx, req, values, nil)
 }
 
+// ListHooks returns the organization webhooks.
+func (s *organizationService) ListHooks(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/hooks?%s", org, encodeListOptions(opts))
+	out := []*hook{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookList(out), res, err
+}
+
+// CreateHook creates an organization webhook.
+func (s *organizationService) CreateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/hooks", org)
+	in := convertFromHookInput(input)
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertHook(out), res, err
+}
+
+// UpdateHook updates an organization webhook.
+func (s *organizationService) UpdateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/hooks/%s", org, input.ID)
+	in := convertFromHookInput(input)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertHook(out), res, err
+}
+
+// DeleteHook deletes an organization webhook.
+func (s *organizationService) DeleteHook(ctx context.Context, org string, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/hooks/%s", org, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, org string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams", org)
+	out := new(team)
+	res, err := s.client.do(ctx, "POST", path, convertTeamInput(input), out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, org, slug string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s", org, slug)
+	out := new(team)
+	res, err := s.client.do(ctx, "PATCH", path, convertTeamInput(input), out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, org, slug string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s", org, slug)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// AddTeamMember adds the user to the team. Users that are
+// not organization members are invited to the organization.
+func (s *organizationService) AddTeamMember(ctx context.Context, org, slug, user, role string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", org, slug, user)
+	return s.client.do(ctx, "PUT", path, &roleInput{Role: role}, nil)
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, org, slug, user string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", org, slug, user)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) AddTeamRepository(ctx context.Context, org, slug, repo, permission string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s/repos/%s", org, slug, repo)
+	return s.client.do(ctx, "PUT", path, &permissionInput{Permission: convertTeamPermission(permission)}, nil)
+}
+
+func (s *organizationService) RemoveTeamRepository(ctx context.Context, org, slug, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s/repos/%s", org, slug, repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// InviteMember sets the organization membership of the user,
+// which invites users that are not organization members.
+func (s *organizationService) InviteMember(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/memberships/%s", org, user)
+	return s.client.do(ctx, "PUT", path, &roleInput{Role: role}, nil)
+}
+
+func (s *organizationService) RemoveMember(ctx context.Context, org, user string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/memberships/%s", org, user)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) SetMemberRole(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	return s.InviteMember(ctx, org, user, role)
+}
+
+func (s *organizationService) ListOutsideCollaborators(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.TeamMember, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/outside_collaborators?%s", org, encodeListOptions(opts))
+	out := []*teamMember{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertTeamMembers(out), res, err
+}
+
 func convertOrganisationPendingInvites(from []*pendingInvitations) []*scm.OrganizationPendingInvite {
 	to := []*scm.OrganizationPendingInvite{}
 	for _, v := range from {
@@ -293,3 +404,26 @@ func convertTeamMember(from *teamMember) *scm.TeamMember {
 		Login: from.Login,
 	}
 }
+
+func convertTeamInput(from *scm.Team) *teamInput {
+	return &teamInput{
+		Name:         from.Name,
+		Description:  from.Description,
+		Privacy:      from.Privacy,
+		ParentTeamID: from.ParentTeamID,
+	}
+}
+
+// helper function returns the team repository permission of
+// the repository permission level. Other values, such as
+// triage or maintain, are passed through.
+func convertTeamPermission(from string) string {
+	switch from {
+	case scm.ReadPermission:
+		return "pull"
+	case scm.WritePermission:
+		return "push"
+	default:
+		return from
+	}
+}
@@ -382,3 +382,341 @@ func TestListMemberships(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestOrganizationHookList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/orgs/github/hooks").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/hooks.json")
+
+	client := NewDefault()
+	got, res, err := client.Organizations.ListHooks(context.Background(), "github", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Hook{}
+	raw, _ := ioutil.ReadFile("testdata/hooks.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestOrganizationHookCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/orgs/github/hooks").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	in := &scm.HookInput{
+		Target:     "https://example.com",
+		Secret:     "topsecret",
+		SkipVerify: true,
+		Events:     scm.HookEvents{Push: true, PullRequest: true},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Organizations.CreateHook(context.Background(), "github", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestOrganizationHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/orgs/github/hooks/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	client := NewDefault()
+	got, _, err := client.Organizations.UpdateHook(context.Background(), "github", &scm.HookInput{ID: "1"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/orgs/github/hooks/1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Organizations.DeleteHook(context.Background(), "github", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestTeamCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/orgs/myorg/teams").
+		JSON(map[string]interface{}{"name": "Justice League", "description": "A great team.", "privacy": "closed"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/team.json")
+
+	in := &scm.Team{
+		Name:        "Justice League",
+		Description: "A great team.",
+		Privacy:     "closed",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Organizations.CreateTeam(context.Background(), "myorg", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/team.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestTeamUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/orgs/myorg/teams/justice-league").
+		JSON(map[string]interface{}{"description": "A great team."}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/team.json")
+
+	client := NewDefault()
+	got, _, err := client.Organizations.UpdateTeam(context.Background(), "myorg", "justice-league", &scm.Team{Description: "A great team."})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/team.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestTeamDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/orgs/myorg/teams/justice-league").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Organizations.DeleteTeam(context.Background(), "myorg", "justice-league")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestTeamAddMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/orgs/myorg/teams/justice-league/memberships/octocat").
+		JSON(map[string]string{"role": "maintainer"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.AddTeamMember(context.Background(), "myorg", "justice-league", "octocat", scm.MaintainerRole)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTeamRemoveMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/orgs/myorg/teams/justice-league/memberships/octocat").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.RemoveTeamMember(context.Background(), "myorg", "justice-league", "octocat")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTeamAddRepository(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/orgs/myorg/teams/justice-league/repos/myorg/hello-world").
+		JSON(map[string]string{"permission": "push"}).
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.AddTeamRepository(context.Background(), "myorg", "justice-league", "myorg/hello-world", scm.WritePermission)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTeamRemoveRepository(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/orgs/myorg/teams/justice-league/repos/myorg/hello-world").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.RemoveTeamRepository(context.Background(), "myorg", "justice-league", "myorg/hello-world")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInviteMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/orgs/myorg/memberships/octocat").
+		JSON(map[string]string{"role": "admin"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.InviteMember(context.Background(), "myorg", "octocat", scm.AdminRole)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRemoveMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/orgs/myorg/memberships/octocat").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.RemoveMember(context.Background(), "myorg", "octocat")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOutsideCollaborators(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/orgs/myorg/outside_collaborators").
+		MatchParam("per_page", "30").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/team_members.json")
+
+	client := NewDefault()
+	got, res, err := client.Organizations.ListOutsideCollaborators(context.Background(), "myorg", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.TeamMember{}
+	raw, _ := ioutil.ReadFile("testdata/team_members.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
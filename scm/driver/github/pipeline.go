@@ -0,0 +1,193 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type pipelineService struct {
+	client *wrapper
+}
+
+type workflowRunList struct {
+	TotalCount   int            `json:"total_count"`
+	WorkflowRuns []*workflowRun `json:"workflow_runs"`
+}
+
+type workflowJobList struct {
+	TotalCount int            `json:"total_count"`
+	Jobs       []*workflowJob `json:"jobs"`
+}
+
+type workflowDispatch struct {
+	Ref    string            `json:"ref"`
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
+
+func (s *pipelineService) List(ctx context.Context, repo string, opts scm.PipelineListOptions) ([]*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/runs?%s", repo, encodePipelineListOptions(opts))
+	out := new(workflowRunList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertWorkflowRunList(out.WorkflowRuns), res, err
+}
+
+func (s *pipelineService) Find(ctx context.Context, repo string, id int) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/runs/%d", repo, id)
+	out := new(workflowRun)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertWorkflowRun(out), res, err
+}
+
+// Trigger dispatches the workflow named by the input. The
+// dispatch api does not return the workflow run.
+func (s *pipelineService) Trigger(ctx context.Context, repo string, input *scm.PipelineInput) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/workflows/%s/dispatches", repo, input.Workflow)
+	in := &workflowDispatch{
+		Ref:    input.Ref,
+		Inputs: input.Variables,
+	}
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	return nil, res, err
+}
+
+func (s *pipelineService) Cancel(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/cancel", repo, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// Retry re-runs the workflow run, which keeps its id and
+// increments the run attempt.
+func (s *pipelineService) Retry(ctx context.Context, repo string, id int) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/rerun", repo, id)
+	res, err := s.client.do(ctx, "POST", path, nil, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.Find(ctx, repo, id)
+}
+
+func (s *pipelineService) ListJobs(ctx context.Context, repo string, id int, opts scm.ListOptions) ([]*scm.PipelineJob, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/jobs?%s", repo, id, encodeListOptions(opts))
+	out := new(workflowJobList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertWorkflowJobList(out.Jobs), res, err
+}
+
+func (s *pipelineService) JobLog(ctx context.Context, repo string, _, job int) ([]byte, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/jobs/%d/logs", repo, job)
+	buf := new(bytes.Buffer)
+	res, err := s.client.do(ctx, "GET", path, nil, buf)
+	return buf.Bytes(), res, err
+}
+
+func encodePipelineListOptions(opts scm.PipelineListOptions) string {
+	params := url.Values{}
+	if opts.Ref != "" {
+		params.Set("branch", opts.Ref)
+	}
+	if opts.Sha != "" {
+		params.Set("head_sha", opts.Sha)
+	}
+	if opts.Status != "" {
+		params.Set("status", opts.Status)
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
+func convertWorkflowRunList(from []*workflowRun) []*scm.Pipeline {
+	to := []*scm.Pipeline{}
+	for _, v := range from {
+		to = append(to, convertWorkflowRun(v))
+	}
+	return to
+}
+
+func convertWorkflowRun(from *workflowRun) *scm.Pipeline {
+	to := &scm.Pipeline{
+		ID:             int(from.ID),
+		Number:         from.RunNumber,
+		Status:         convertWorkflowState(from.Status, from.Conclusion),
+		DetailedStatus: from.Status,
+		Ref:            from.HeadBranch,
+		Sha:            from.HeadSha,
+		Source:         from.Event,
+		Link:           from.HTMLURL,
+		Created:        from.CreatedAt,
+	}
+	if from.Status == "completed" {
+		to.DetailedStatus = from.Conclusion
+		to.Finished = from.UpdatedAt
+		to.Duration = from.UpdatedAt.Sub(from.RunStartedAt)
+	}
+	return to
+}
+
+func convertWorkflowJobList(from []*workflowJob) []*scm.PipelineJob {
+	to := []*scm.PipelineJob{}
+	for _, v := range from {
+		to = append(to, convertWorkflowJob(v))
+	}
+	return to
+}
+
+func convertWorkflowJob(from *workflowJob) *scm.PipelineJob {
+	to := &scm.PipelineJob{
+		ID:             int(from.ID),
+		PipelineID:     int(from.RunID),
+		Name:           from.Name,
+		Stage:          from.WorkflowName,
+		Status:         convertWorkflowState(from.Status, from.Conclusion),
+		DetailedStatus: from.Status,
+		Ref:            from.HeadBranch,
+		Sha:            from.HeadSha,
+		Link:           from.HTMLURL,
+		Runner:         from.RunnerName,
+		Created:        from.CreatedAt,
+		Started:        from.StartedAt,
+		Finished:       from.CompletedAt,
+	}
+	if !from.StartedAt.IsZero() && !from.CreatedAt.IsZero() {
+		to.QueuedDuration = from.StartedAt.Sub(from.CreatedAt)
+	}
+	if from.Status == "completed" {
+		to.DetailedStatus = from.Conclusion
+		to.Duration = from.CompletedAt.Sub(from.StartedAt)
+	}
+	return to
+}
+
+// helper function returns the pipeline state of a workflow
+// run or job status and conclusion.
+func convertWorkflowState(status, conclusion string) scm.State {
+	switch status {
+	case "queued", "requested", "waiting", "pending":
+		return scm.StatePending
+	case "in_progress":
+		return scm.StateRunning
+	}
+	switch conclusion {
+	case "success", "neutral", "skipped":
+		return scm.StateSuccess
+	case "cancelled":
+		return scm.StateCanceled
+	case "failure", "timed_out", "action_required", "startup_failure":
+		return scm.StateFailure
+	default:
+		return scm.StateUnknown
+	}
+}
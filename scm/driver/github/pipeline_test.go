@@ -0,0 +1,222 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestPipelineFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octo-org/octo-repo/actions/runs/30433642").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/workflow_run.json")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.Find(context.Background(), "octo-org/octo-repo", 30433642)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Pipeline)
+	raw, _ := ioutil.ReadFile("testdata/workflow_run.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octo-org/octo-repo/actions/runs").
+		MatchParam("branch", "master").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/workflow_runs.json")
+
+	client := NewDefault()
+	opts := scm.PipelineListOptions{Ref: "master", Page: 1, Size: 30}
+	got, res, err := client.Pipelines.List(context.Background(), "octo-org/octo-repo", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Pipeline{}
+	raw, _ := ioutil.ReadFile("testdata/workflow_runs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestPipelineTrigger(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octo-org/octo-repo/actions/workflows/release.yml/dispatches").
+		JSON(map[string]interface{}{
+			"ref": "master",
+			"inputs": map[string]string{
+				"version": "1.0.0",
+			},
+		}).
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	input := &scm.PipelineInput{
+		Ref:       "master",
+		Workflow:  "release.yml",
+		Variables: map[string]string{"version": "1.0.0"},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.Trigger(context.Background(), "octo-org/octo-repo", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got != nil {
+		t.Errorf("Want nil pipeline for workflow dispatch")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineCancel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octo-org/octo-repo/actions/runs/30433642/cancel").
+		Reply(202).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Pipelines.Cancel(context.Background(), "octo-org/octo-repo", 30433642)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineRetry(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octo-org/octo-repo/actions/runs/30433642/rerun").
+		Reply(201).
+		SetHeaders(mockHeaders)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octo-org/octo-repo/actions/runs/30433642").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/workflow_run.json")
+
+	client := NewDefault()
+	got, _, err := client.Pipelines.Retry(context.Background(), "octo-org/octo-repo", 30433642)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Pipeline)
+	raw, _ := ioutil.ReadFile("testdata/workflow_run.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineListJobs(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octo-org/octo-repo/actions/runs/30433642/jobs").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/workflow_jobs.json")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.ListJobs(context.Background(), "octo-org/octo-repo", 30433642, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PipelineJob{}
+	raw, _ := ioutil.ReadFile("testdata/workflow_jobs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineJobLog(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octo-org/octo-repo/actions/jobs/399444496/logs").
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		BodyString("2020-01-20T17:42:40Z Set up job\n")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.JobLog(context.Background(), "octo-org/octo-repo", 30433642, 399444496)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if want := "2020-01-20T17:42:40Z Set up job\n"; string(got) != want {
+		t.Errorf("Want log %q, got %q", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
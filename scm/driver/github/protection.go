@@ -0,0 +1,153 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type branchProtectionService struct {
+	client *wrapper
+}
+
+type branchProtection struct {
+	RequiredStatusChecks *struct {
+		Strict   bool     `json:"strict"`
+		Contexts []string `json:"contexts"`
+	} `json:"required_status_checks"`
+	RequiredPullRequestReviews *struct {
+		DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+		RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+		RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+	} `json:"required_pull_request_reviews"`
+	Restrictions *struct {
+		Users []struct {
+			Login string `json:"login"`
+		} `json:"users"`
+		Teams []struct {
+			Slug string `json:"slug"`
+		} `json:"teams"`
+	} `json:"restrictions"`
+	RequiredLinearHistory protectionSetting `json:"required_linear_history"`
+	AllowForcePushes      protectionSetting `json:"allow_force_pushes"`
+	AllowDeletions        protectionSetting `json:"allow_deletions"`
+}
+
+type protectionSetting struct {
+	Enabled bool `json:"enabled"`
+}
+
+type branchProtectionInput struct {
+	RequiredStatusChecks       *statusChecksInput `json:"required_status_checks"`
+	EnforceAdmins              bool               `json:"enforce_admins"`
+	RequiredPullRequestReviews *pullReviewsInput  `json:"required_pull_request_reviews"`
+	Restrictions               *restrictionsInput `json:"restrictions"`
+	RequiredLinearHistory      bool               `json:"required_linear_history"`
+	AllowForcePushes           bool               `json:"allow_force_pushes"`
+	AllowDeletions             bool               `json:"allow_deletions"`
+}
+
+type statusChecksInput struct {
+	Strict   bool     `json:"strict"`
+	Contexts []string `json:"contexts"`
+}
+
+type pullReviewsInput struct {
+	DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+	RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+	RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+}
+
+type restrictionsInput struct {
+	Users []string `json:"users"`
+	Teams []string `json:"teams"`
+}
+
+func (s *branchProtectionService) Find(ctx context.Context, repo, branch string) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/branches/%s/protection", repo, branch)
+	out := new(branchProtection)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertBranchProtection(branch, out), res, nil
+}
+
+// Set replaces the protection of the branch. Github restricts
+// pushes and merges with the same list, so merge restrictions
+// are not supported.
+func (s *branchProtectionService) Set(ctx context.Context, repo string, input *scm.BranchProtection) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/branches/%s/protection", repo, input.Branch)
+	out := new(branchProtection)
+	res, err := s.client.do(ctx, "PUT", path, convertBranchProtectionInput(input), out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertBranchProtection(input.Branch, out), res, scm.CheckUnsupportedFields(input, "Merge")
+}
+
+func (s *branchProtectionService) Delete(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/branches/%s/protection", repo, branch)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func convertBranchProtectionInput(from *scm.BranchProtection) *branchProtectionInput {
+	to := &branchProtectionInput{
+		RequiredLinearHistory: from.RequireLinearHistory,
+		AllowForcePushes:      from.AllowForcePush,
+		AllowDeletions:        from.AllowDeletion,
+	}
+	if len(from.RequiredStatusChecks) > 0 || from.StrictStatusChecks {
+		to.RequiredStatusChecks = &statusChecksInput{
+			Strict:   from.StrictStatusChecks,
+			Contexts: append([]string{}, from.RequiredStatusChecks...),
+		}
+	}
+	if from.RequiredApprovals > 0 || from.DismissStaleReviews || from.RequireCodeOwnerReview {
+		to.RequiredPullRequestReviews = &pullReviewsInput{
+			DismissStaleReviews:          from.DismissStaleReviews,
+			RequireCodeOwnerReviews:      from.RequireCodeOwnerReview,
+			RequiredApprovingReviewCount: from.RequiredApprovals,
+		}
+	}
+	if from.Push != nil {
+		to.Restrictions = &restrictionsInput{
+			Users: append([]string{}, from.Push.Users...),
+			Teams: append([]string{}, from.Push.Teams...),
+		}
+	}
+	return to
+}
+
+func convertBranchProtection(branch string, from *branchProtection) *scm.BranchProtection {
+	to := &scm.BranchProtection{
+		Branch:               branch,
+		AllowForcePush:       from.AllowForcePushes.Enabled,
+		AllowDeletion:        from.AllowDeletions.Enabled,
+		RequireLinearHistory: from.RequiredLinearHistory.Enabled,
+	}
+	if v := from.RequiredStatusChecks; v != nil {
+		to.RequiredStatusChecks = v.Contexts
+		to.StrictStatusChecks = v.Strict
+	}
+	if v := from.RequiredPullRequestReviews; v != nil {
+		to.RequiredApprovals = v.RequiredApprovingReviewCount
+		to.DismissStaleReviews = v.DismissStaleReviews
+		to.RequireCodeOwnerReview = v.RequireCodeOwnerReviews
+	}
+	if v := from.Restrictions; v != nil {
+		to.Push = &scm.BranchRestrictions{}
+		for _, u := range v.Users {
+			to.Push.Users = append(to.Push.Users, u.Login)
+		}
+		for _, t := range v.Teams {
+			to.Push.Teams = append(to.Push.Teams, t.Slug)
+		}
+	}
+	return to
+}
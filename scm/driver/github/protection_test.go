@@ -0,0 +1,159 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestBranchProtectionFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master/protection").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch_protection.json")
+
+	client := NewDefault()
+	got, res, err := client.BranchProtections.Find(context.Background(), "octocat/hello-world", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestBranchProtectionSet(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/branches/master/protection").
+		JSON(map[string]interface{}{
+			"required_status_checks": map[string]interface{}{
+				"strict":   true,
+				"contexts": []string{"continuous-integration/travis-ci"},
+			},
+			"enforce_admins": false,
+			"required_pull_request_reviews": map[string]interface{}{
+				"dismiss_stale_reviews":           true,
+				"require_code_owner_reviews":      true,
+				"required_approving_review_count": 2,
+			},
+			"restrictions": map[string]interface{}{
+				"users": []string{"octocat"},
+				"teams": []string{"justice-league"},
+			},
+			"required_linear_history": true,
+			"allow_force_pushes":      false,
+			"allow_deletions":         false,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch_protection.json")
+
+	input := &scm.BranchProtection{
+		Branch:                 "master",
+		RequiredStatusChecks:   []string{"continuous-integration/travis-ci"},
+		StrictStatusChecks:     true,
+		RequiredApprovals:      2,
+		DismissStaleReviews:    true,
+		RequireCodeOwnerReview: true,
+		Push: &scm.BranchRestrictions{
+			Users: []string{"octocat"},
+			Teams: []string{"justice-league"},
+		},
+		RequireLinearHistory: true,
+	}
+
+	client := NewDefault()
+	got, res, err := client.BranchProtections.Set(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestBranchProtectionSet_Unsupported(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/branches/master/protection").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch_protection.json")
+
+	input := &scm.BranchProtection{
+		Branch: "master",
+		Merge:  &scm.BranchRestrictions{Users: []string{"octocat"}},
+	}
+
+	client := NewDefault()
+	got, _, err := client.BranchProtections.Set(context.Background(), "octocat/hello-world", input)
+	if got == nil {
+		t.Errorf("Expect supported fields applied")
+	}
+	unsupported, ok := err.(*scm.UnsupportedFieldsError)
+	if !ok {
+		t.Errorf("Expect UnsupportedFieldsError, got %v", err)
+		return
+	}
+	if diff := cmp.Diff(unsupported.Fields, []string{"Merge"}); diff != "" {
+		t.Errorf("Unexpected unsupported fields")
+		t.Log(diff)
+	}
+}
+
+func TestBranchProtectionDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/branches/master/protection").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.BranchProtections.Delete(context.Background(), "octocat/hello-world", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
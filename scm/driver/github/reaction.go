@@ -0,0 +1,122 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type reaction struct {
+	ID        int       `json:"id"`
+	Content   string    `json:"content"`
+	User      user      `json:"user"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type reactionInput struct {
+	Content string `json:"content"`
+}
+
+func (s *issueService) ListReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/reactions", repo, number)
+	return s.listReactions(ctx, path, opts)
+}
+
+func (s *issueService) CreateReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/reactions", repo, number)
+	return s.createReaction(ctx, path, content)
+}
+
+func (s *issueService) DeleteReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/reactions", repo, number)
+	return s.deleteReaction(ctx, path, content)
+}
+
+func (s *issueService) ListCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/comments/%d/reactions", repo, id)
+	return s.listReactions(ctx, path, opts)
+}
+
+func (s *issueService) CreateCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/comments/%d/reactions", repo, id)
+	return s.createReaction(ctx, path, content)
+}
+
+func (s *issueService) DeleteCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/comments/%d/reactions", repo, id)
+	return s.deleteReaction(ctx, path, content)
+}
+
+func (s *pullService) ListCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/comments/%d/reactions", repo, id)
+	return s.listReactions(ctx, path, opts)
+}
+
+func (s *pullService) CreateCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/comments/%d/reactions", repo, id)
+	return s.createReaction(ctx, path, content)
+}
+
+func (s *pullService) DeleteCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/comments/%d/reactions", repo, id)
+	return s.deleteReaction(ctx, path, content)
+}
+
+func (s *issueService) listReactions(ctx context.Context, path string, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	path = fmt.Sprintf("%s?%s", path, encodeListOptions(opts))
+	out := []*reaction{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReactionList(out), res, err
+}
+
+func (s *issueService) createReaction(ctx context.Context, path string, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	in := &reactionInput{Content: content.String()}
+	out := new(reaction)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReaction(out), res, err
+}
+
+// helper function deletes the reaction of the authenticated
+// actor. Github deletes reactions by id, and creating a
+// reaction returns the existing one when the actor already
+// reacted, so the id is found without resolving the actor,
+// which GET /user cannot do for app installation tokens. If
+// the actor had not reacted, the reaction created to find
+// the id is deleted again and ErrNotFound is returned.
+func (s *issueService) deleteReaction(ctx context.Context, path string, content scm.ReactionContent) (*scm.Response, error) {
+	in := &reactionInput{Content: content.String()}
+	out := new(reaction)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return res, err
+	}
+	created := res.Status == 201
+	res, err = s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", path, out.ID), nil, nil)
+	if err == nil && created {
+		return res, scm.ErrNotFound
+	}
+	return res, err
+}
+
+func convertReactionList(from []*reaction) []*scm.Reaction {
+	to := []*scm.Reaction{}
+	for _, v := range from {
+		to = append(to, convertReaction(v))
+	}
+	return to
+}
+
+func convertReaction(from *reaction) *scm.Reaction {
+	return &scm.Reaction{
+		ID:      from.ID,
+		Content: scm.ToReactionContent(from.Content),
+		Author:  *convertUser(&from.User),
+		Created: from.CreatedAt,
+	}
+}
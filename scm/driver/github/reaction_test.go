@@ -0,0 +1,168 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestIssueListReactions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/1347/reactions").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/reactions.json")
+
+	client := NewDefault()
+	got, res, err := client.Issues.ListReactions(context.Background(), "octocat/hello-world", 1347, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reaction{}
+	raw, _ := ioutil.ReadFile("testdata/reactions.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestIssueCreateCommentReaction(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues/comments/1/reactions").
+		JSON(map[string]string{"content": "heart"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/reaction.json")
+
+	client := NewDefault()
+	got, res, err := client.Issues.CreateCommentReaction(context.Background(), "octocat/hello-world", 1347, 1, scm.ReactionHeart)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reaction)
+	raw, _ := ioutil.ReadFile("testdata/reaction.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullCreateCommentReaction(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/comments/1/reactions").
+		JSON(map[string]string{"content": "heart"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/reaction.json")
+
+	client := NewDefault()
+	got, _, err := client.PullRequests.CreateCommentReaction(context.Background(), "octocat/hello-world", 1347, 1, scm.ReactionHeart)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reaction)
+	raw, _ := ioutil.ReadFile("testdata/reaction.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueDeleteReaction(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues/1347/reactions").
+		JSON(map[string]string{"content": "heart"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/reaction.json")
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/issues/1347/reactions/1").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Issues.DeleteReaction(context.Background(), "octocat/hello-world", 1347, scm.ReactionHeart)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests made")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+// TestIssueDeleteReaction_NotFound checks the reaction created
+// to find the id is deleted again when the actor had not
+// reacted.
+func TestIssueDeleteReaction_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues/1347/reactions").
+		JSON(map[string]string{"content": "heart"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/reaction.json")
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/issues/1347/reactions/1").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Issues.DeleteReaction(context.Background(), "octocat/hello-world", 1347, scm.ReactionHeart)
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect ErrNotFound, got %v", err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests made")
+	}
+}
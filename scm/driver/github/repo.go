@@ -8,7 +8,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -46,6 +48,29 @@ type repositoryInput struct {
 	Private     bool   `json:"private"`
 }
 
+type repositorySettings struct {
+	Name                string `json:"name,omitempty"`
+	Description         string `json:"description,omitempty"`
+	Homepage            string `json:"homepage,omitempty"`
+	Visibility          string `json:"visibility,omitempty"`
+	DefaultBranch       string `json:"default_branch,omitempty"`
+	Archived            *bool  `json:"archived,omitempty"`
+	AllowMergeCommit    *bool  `json:"allow_merge_commit,omitempty"`
+	AllowSquashMerge    *bool  `json:"allow_squash_merge,omitempty"`
+	AllowRebaseMerge    *bool  `json:"allow_rebase_merge,omitempty"`
+	DeleteBranchOnMerge *bool  `json:"delete_branch_on_merge,omitempty"`
+	HasIssues           *bool  `json:"has_issues,omitempty"`
+	HasWiki             *bool  `json:"has_wiki,omitempty"`
+}
+
+type topicsInput struct {
+	Names []string `json:"names"`
+}
+
+type transferInput struct {
+	NewOwner string `json:"new_owner"`
+}
+
 type hook struct {
 	ID     int      `json:"id,omitempty"`
 	Name   string   `json:"name"`
@@ -252,6 +277,44 @@ func (s *repositoryService) ListLabels(ctx context.Context, repo string, opts sc
 	return convertLabelObjects(out), res, err
 }
 
+type labelInput struct {
+	Name        string `json:"name,omitempty"`
+	NewName     string `json:"new_name,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func (s *repositoryService) CreateLabel(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels", repo)
+	in := &labelInput{
+		Name:        input.Name,
+		Color:       strings.TrimPrefix(input.Color, "#"),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	to := convertLabel(*out)
+	return &to, res, err
+}
+
+func (s *repositoryService) UpdateLabel(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	in := &labelInput{
+		NewName:     input.Name,
+		Color:       strings.TrimPrefix(input.Color, "#"),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	to := convertLabel(*out)
+	return &to, res, err
+}
+
+func (s *repositoryService) DeleteLabel(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // Create creates a new repository
 func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
 	path := "user/repos"
@@ -289,28 +352,26 @@ func (s *repositoryService) Fork(ctx context.Context, input *scm.RepositoryInput
 // CreateHook creates a new repository webhook.
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/hooks", repo)
-	in := new(hook)
-	in.Active = true
-	in.Name = "web"
-	in.Config.Secret = input.Secret
-	in.Config.ContentType = "json"
-	in.Config.URL = input.Target
-	if input.SkipVerify {
-		in.Config.InsecureSSL = "1"
-	} else {
-		in.Config.InsecureSSL = "0"
-	}
-	in.Events = append(
-		input.NativeEvents,
-		convertHookEvents(input.Events)...,
-	)
+	in := convertFromHookInput(input)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertHook(out), res, err
 }
 
+// UpdateHook updates a repository webhook.
 func (s *repositoryService) UpdateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("repos/%s/hooks/%s", repo, input.ID)
+	in := convertFromHookInput(input)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertHook(out), res, err
+}
+
+// EnsureHook makes sure exactly one repository webhook
+// targets the input url with the input events.
+func (s *repositoryService) EnsureHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.EnsureHookResult, *scm.Response, error) {
+	events := append(input.NativeEvents, convertHookEvents(input.Events)...)
+	return scm.EnsureHook(ctx, s, repo, input, events)
 }
 
 // CreateStatus creates a new commit status.
@@ -338,6 +399,57 @@ func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Respo
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// Update updates the repository settings. Topics are
+// replaced once the other settings are updated.
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositorySettings) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	in := &repositorySettings{
+		Description:         input.Description,
+		Homepage:            input.Homepage,
+		Visibility:          input.Visibility,
+		DefaultBranch:       input.DefaultBranch,
+		Archived:            input.Archived,
+		AllowMergeCommit:    input.AllowMergeCommit,
+		AllowSquashMerge:    input.AllowSquashMerge,
+		AllowRebaseMerge:    input.AllowRebaseMerge,
+		DeleteBranchOnMerge: input.DeleteBranchOnMerge,
+		HasIssues:           input.HasIssues,
+		HasWiki:             input.HasWiki,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	if err != nil || input.Topics == nil {
+		return convertRepository(out), res, err
+	}
+	req := &scm.Request{
+		Method: http.MethodPut,
+		Path:   fmt.Sprintf("repos/%s/topics", repo),
+		Header: map[string][]string{
+			// This accept header enables the topics preview.
+			"Accept": {"application/vnd.github.mercy-preview+json"},
+		},
+	}
+	res, err = s.client.doRequest(ctx, req, &topicsInput{Names: input.Topics}, nil)
+	return convertRepository(out), res, err
+}
+
+// Rename renames the repository.
+func (s *repositoryService) Rename(ctx context.Context, repo, name string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	out := new(repository)
+	res, err := s.client.do(ctx, "PATCH", path, &repositorySettings{Name: name}, out)
+	return convertRepository(out), res, err
+}
+
+// Transfer transfers the repository to a user or organization.
+// The transfer completes asynchronously.
+func (s *repositoryService) Transfer(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/transfer", repo)
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, &transferInput{NewOwner: namespace}, out)
+	return convertRepository(out), res, err
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -398,6 +510,25 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertFromHookInput(from *scm.HookInput) *hook {
+	to := new(hook)
+	to.Active = true
+	to.Name = "web"
+	to.Config.Secret = from.Secret
+	to.Config.ContentType = "json"
+	to.Config.URL = from.Target
+	if from.SkipVerify {
+		to.Config.InsecureSSL = "1"
+	} else {
+		to.Config.InsecureSSL = "0"
+	}
+	to.Events = append(
+		from.NativeEvents,
+		convertHookEvents(from.Events)...,
+	)
+	return to
+}
+
 func convertHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push {
@@ -431,6 +562,15 @@ func convertHookEvents(from scm.HookEvents) []string {
 	if from.Release {
 		events = append(events, "release")
 	}
+	if from.Fork {
+		events = append(events, "fork")
+	}
+	if from.Repository {
+		events = append(events, "repository")
+	}
+	if from.Status {
+		events = append(events, "status")
+	}
 	return events
 }
 
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -392,6 +393,138 @@ func TestRepositoryHookCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/hooks/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	in := &scm.HookInput{
+		ID:         "1",
+		Target:     "https://example.com",
+		Secret:     "topsecret",
+		SkipVerify: true,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.UpdateHook(context.Background(), "octocat/hello-world", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookEnsure(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hooks.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/hooks/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	client := NewDefault()
+
+	in := &scm.HookInput{
+		Target: "http://example.com/webhook",
+		Events: scm.HookEvents{Push: true, PullRequest: true},
+	}
+	got, _, err := client.Repositories.EnsureHook(context.Background(), "octocat/hello-world", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Changed() {
+		t.Errorf("Want unchanged hook, got %+v", got)
+	}
+	if got, want := got.Hook.ID, "1"; got != want {
+		t.Errorf("Want hook ID %q, got %q", want, got)
+	}
+
+	in.Events.Issue = true
+	got, _, err = client.Repositories.EnsureHook(context.Background(), "octocat/hello-world", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got.Updated || got.Created || len(got.Deleted) != 0 {
+		t.Errorf("Want updated hook, got %+v", got)
+	}
+}
+
+func TestRepositoryHookEnsureSecret(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hooks.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/hooks/1").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			in := new(hook)
+			if err := json.NewDecoder(req.Body).Decode(in); err != nil {
+				return false, err
+			}
+			return in.Config.Secret == "rotated", nil
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	client := NewDefault()
+
+	in := &scm.HookInput{
+		Target: "http://example.com/webhook",
+		Secret: "rotated",
+		Events: scm.HookEvents{Push: true, PullRequest: true},
+	}
+	got, _, err := client.Repositories.EnsureHook(context.Background(), "octocat/hello-world", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got.Updated || got.Created || len(got.Deleted) != 0 {
+		t.Errorf("Want updated hook, got %+v", got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestRepositoryCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -710,3 +843,204 @@ func TestRepositoryService_IsCollaborator_True(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestRepositoryCreateLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/labels").
+		JSON(map[string]string{
+			"name":        "kind/bug",
+			"color":       "d73a4a",
+			"description": "Something isn't working",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name:        "kind/bug",
+		Color:       "#d73a4a",
+		Description: "Something isn't working",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.CreateLabel(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryUpdateLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/labels/bug").
+		JSON(map[string]string{
+			"new_name": "kind/bug",
+			"color":    "d73a4a",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name:  "kind/bug",
+		Color: "d73a4a",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.UpdateLabel(context.Background(), "octocat/hello-world", "bug", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryDeleteLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/labels/kind/bug").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.DeleteLabel(context.Background(), "octocat/hello-world", "kind/bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world").
+		JSON(map[string]interface{}{"description": "My first repository", "default_branch": "master", "archived": false, "allow_squash_merge": false}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/topics").
+		MatchHeader("Accept", "application/vnd.github.mercy-preview\\+json").
+		JSON(map[string][]string{"names": {"octocat", "api"}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	archived, squash := false, false
+	in := &scm.RepositorySettings{
+		Description:      "My first repository",
+		DefaultBranch:    "master",
+		Archived:         &archived,
+		AllowSquashMerge: &squash,
+		Topics:           []string{"octocat", "api"},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Update(context.Background(), "octocat/hello-world", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryRename(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello").
+		JSON(map[string]string{"name": "Hello-World"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, _, err := client.Repositories.Rename(context.Background(), "octocat/hello", "Hello-World")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryTransfer(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/hubot/Hello-World/transfer").
+		JSON(map[string]string{"new_owner": "octocat"}).
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Transfer(context.Background(), "hubot/Hello-World", "octocat")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 202; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+	if got, want := got.FullName, "octocat/Hello-World"; got != want {
+		t.Errorf("Want repository %s, got %s", want, got)
+	}
+}
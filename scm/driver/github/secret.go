@@ -0,0 +1,223 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// secretService manages github actions secrets. Secret values
+// are encrypted with the public key of the repository,
+// environment or organization before they are sent.
+type secretService struct {
+	client *wrapper
+}
+
+type secretList struct {
+	TotalCount int       `json:"total_count"`
+	Secrets    []*secret `json:"secrets"`
+}
+
+type secret struct {
+	Name       string    `json:"name"`
+	Visibility string    `json:"visibility"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type secretRepositoryList struct {
+	TotalCount   int `json:"total_count"`
+	Repositories []struct {
+		ID int64 `json:"id"`
+	} `json:"repositories"`
+}
+
+type secretPublicKey struct {
+	KeyID string `json:"key_id"`
+	Key   string `json:"key"`
+}
+
+type secretInput struct {
+	EncryptedValue        string  `json:"encrypted_value"`
+	KeyID                 string  `json:"key_id"`
+	Visibility            string  `json:"visibility,omitempty"`
+	SelectedRepositoryIDs []int64 `json:"selected_repository_ids,omitempty"`
+}
+
+func (s *secretService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return s.list(ctx, fmt.Sprintf("repos/%s/actions/secrets", repo), "", opts)
+}
+
+func (s *secretService) Set(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	return s.set(ctx, fmt.Sprintf("repos/%s/actions/secrets", repo), new(secretInput), input, "Visibility", "SelectedRepositoryIDs")
+}
+
+func (s *secretService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/secrets/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *secretService) ListEnvironment(ctx context.Context, repo, env string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return s.list(ctx, fmt.Sprintf("repos/%s/environments/%s/secrets", repo, url.PathEscape(env)), env, opts)
+}
+
+func (s *secretService) SetEnvironment(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	return s.set(ctx, fmt.Sprintf("repos/%s/environments/%s/secrets", repo, url.PathEscape(env)), new(secretInput), input, "Visibility", "SelectedRepositoryIDs")
+}
+
+func (s *secretService) DeleteEnvironment(ctx context.Context, repo, env, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/environments/%s/secrets/%s", repo, url.PathEscape(env), name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *secretService) ListOrganization(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return s.list(ctx, fmt.Sprintf("orgs/%s/actions/secrets", org), "", opts)
+}
+
+// SetOrganization creates or updates an organization secret.
+// Unless the input sets the visibility, existing secrets keep
+// their visibility and selected repositories, and new secrets
+// are only visible to the private and internal repositories of
+// the organization.
+func (s *secretService) SetOrganization(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/actions/secrets", org)
+	in, res, err := s.visibility(ctx, path, input)
+	if err != nil {
+		return res, err
+	}
+	return s.set(ctx, path, in, input)
+}
+
+func (s *secretService) DeleteOrganization(ctx context.Context, org, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/actions/secrets/%s", org, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *secretService) list(ctx context.Context, path, env string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	out := new(secretList)
+	res, err := s.client.do(ctx, "GET", fmt.Sprintf("%s?%s", path, encodeListOptions(opts)), nil, out)
+	return convertSecretList(out.Secrets, env), res, err
+}
+
+// helper function returns the visibility of the organization
+// secret at path. The input visibility is used if set,
+// otherwise the visibility of the existing secret, or private
+// if the secret does not exist.
+func (s *secretService) visibility(ctx context.Context, path string, input *scm.SecretInput) (*secretInput, *scm.Response, error) {
+	in := new(secretInput)
+	for _, id := range input.SelectedRepositoryIDs {
+		v, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid repository id %q: %w", id, err)
+		}
+		in.SelectedRepositoryIDs = append(in.SelectedRepositoryIDs, v)
+	}
+	if input.Visibility != "" {
+		in.Visibility = input.Visibility
+		return in, nil, nil
+	}
+	existing := new(secret)
+	res, err := s.client.do(ctx, "GET", fmt.Sprintf("%s/%s", path, input.Name), nil, existing)
+	if err == scm.ErrNotFound {
+		in.Visibility = "private"
+		return in, res, nil
+	}
+	if err != nil {
+		return nil, res, err
+	}
+	in.Visibility = existing.Visibility
+	if in.Visibility == "selected" && len(in.SelectedRepositoryIDs) == 0 {
+		in.SelectedRepositoryIDs, res, err = s.selected(ctx, path, input.Name)
+	}
+	return in, res, err
+}
+
+// helper function returns the ids of the repositories
+// selected for the organization secret at path.
+func (s *secretService) selected(ctx context.Context, path, name string) ([]int64, *scm.Response, error) {
+	var ids []int64
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		out := new(secretRepositoryList)
+		res, err := s.client.do(ctx, "GET", fmt.Sprintf("%s/%s/repositories?%s", path, name, encodeListOptions(opts)), nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, repo := range out.Repositories {
+			ids = append(ids, repo.ID)
+		}
+		if res.Page.Next <= opts.Page {
+			return ids, res, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// helper function encrypts the secret value with the public
+// key of the secrets at path, and creates or updates the
+// secret. Github secrets are always masked and cannot be
+// protected. Fields the secret does not support are reported
+// after the secret is set.
+func (s *secretService) set(ctx context.Context, path string, in *secretInput, input *scm.SecretInput, unsupported ...string) (*scm.Response, error) {
+	key := new(secretPublicKey)
+	res, err := s.client.do(ctx, "GET", path+"/public-key", nil, key)
+	if err != nil {
+		return res, err
+	}
+	value, err := encryptSecret(key.Key, input.Value)
+	if err != nil {
+		return res, err
+	}
+	in.EncryptedValue = value
+	in.KeyID = key.KeyID
+	res, err = s.client.do(ctx, "PUT", fmt.Sprintf("%s/%s", path, input.Name), in, nil)
+	if err != nil {
+		return res, err
+	}
+	return res, scm.CheckUnsupportedFields(input, append([]string{"Protected"}, unsupported...)...)
+}
+
+// helper function encrypts the value with a libsodium sealed
+// box for the base64 encoded public key, and returns the
+// base64 encoded ciphertext.
+func encryptSecret(publicKey, value string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode secret public key: %w", err)
+	}
+	if len(raw) != 32 {
+		return "", fmt.Errorf("invalid secret public key length %d", len(raw))
+	}
+	var recipient [32]byte
+	copy(recipient[:], raw)
+	out, err := box.SealAnonymous(nil, []byte(value), &recipient, rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(out), nil
+}
+
+func convertSecretList(from []*secret, env string) []*scm.Secret {
+	to := []*scm.Secret{}
+	for _, v := range from {
+		to = append(to, &scm.Secret{
+			Name:        v.Name,
+			Environment: env,
+			Masked:      true,
+			Created:     v.CreatedAt,
+			Updated:     v.UpdatedAt,
+		})
+	}
+	return to
+}
@@ -0,0 +1,329 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"golang.org/x/crypto/nacl/box"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/secrets").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/secrets.json")
+
+	client := NewDefault()
+	got, res, err := client.Secrets.List(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/secrets.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestSecretSet(t *testing.T) {
+	defer gock.Off()
+
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/environments/production/secrets/public-key").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		JSON(map[string]string{
+			"key_id": "012345678912345678",
+			"key":    base64.StdEncoding.EncodeToString(publicKey[:]),
+		})
+
+	var decrypted string
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/environments/production/secrets/GH_TOKEN").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			body, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				return false, err
+			}
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+			in := new(secretInput)
+			if err := json.Unmarshal(body, in); err != nil {
+				return false, err
+			}
+			sealed, err := base64.StdEncoding.DecodeString(in.EncryptedValue)
+			if err != nil {
+				return false, err
+			}
+			out, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+			decrypted = string(out)
+			return ok && in.KeyID == "012345678912345678", nil
+		}).
+		Reply(201).
+		SetHeaders(mockHeaders)
+
+	input := &scm.SecretInput{
+		Name:  "GH_TOKEN",
+		Value: "s3cr3t",
+	}
+
+	client := NewDefault()
+	res, err := client.Secrets.SetEnvironment(context.Background(), "octocat/hello-world", "production", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := decrypted, "s3cr3t"; got != want {
+		t.Errorf("Want decrypted secret %q, got %q", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestSecretSet_Protected(t *testing.T) {
+	defer gock.Off()
+
+	publicKey, _, _ := box.GenerateKey(rand.Reader)
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/actions/secrets/public-key").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		JSON(map[string]string{
+			"key_id": "012345678912345678",
+			"key":    base64.StdEncoding.EncodeToString(publicKey[:]),
+		})
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/actions/secrets/GH_TOKEN").
+		Reply(404).
+		SetHeaders(mockHeaders)
+
+	gock.New("https://api.github.com").
+		Put("/orgs/octocat/actions/secrets/GH_TOKEN").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	input := &scm.SecretInput{
+		Name:      "GH_TOKEN",
+		Value:     "s3cr3t",
+		Protected: true,
+	}
+
+	client := NewDefault()
+	_, err := client.Secrets.SetOrganization(context.Background(), "octocat", input)
+	if _, ok := err.(*scm.UnsupportedFieldsError); !ok {
+		t.Errorf("Want unsupported fields error, got %v", err)
+	}
+}
+
+func TestSecretSetOrganization(t *testing.T) {
+	defer gock.Off()
+
+	publicKey, _, _ := box.GenerateKey(rand.Reader)
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/actions/secrets/public-key").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		JSON(map[string]string{
+			"key_id": "012345678912345678",
+			"key":    base64.StdEncoding.EncodeToString(publicKey[:]),
+		})
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/actions/secrets/GH_TOKEN").
+		Reply(404).
+		SetHeaders(mockHeaders)
+
+	gock.New("https://api.github.com").
+		Put("/orgs/octocat/actions/secrets/GH_TOKEN").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			in := new(secretInput)
+			if err := json.NewDecoder(req.Body).Decode(in); err != nil {
+				return false, err
+			}
+			return in.Visibility == "private", nil
+		}).
+		Reply(201).
+		SetHeaders(mockHeaders)
+
+	input := &scm.SecretInput{
+		Name:  "GH_TOKEN",
+		Value: "s3cr3t",
+	}
+
+	client := NewDefault()
+	_, err := client.Secrets.SetOrganization(context.Background(), "octocat", input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestSecretSetOrganizationKeepsVisibility(t *testing.T) {
+	defer gock.Off()
+
+	publicKey, _, _ := box.GenerateKey(rand.Reader)
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/actions/secrets/public-key").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		JSON(map[string]string{
+			"key_id": "012345678912345678",
+			"key":    base64.StdEncoding.EncodeToString(publicKey[:]),
+		})
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/actions/secrets/GH_TOKEN/repositories").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		JSON(map[string]interface{}{
+			"total_count": 2,
+			"repositories": []map[string]interface{}{
+				{"id": 1296269, "full_name": "octocat/Hello-World"},
+				{"id": 1296270, "full_name": "octocat/Spoon-Knife"},
+			},
+		})
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/actions/secrets/GH_TOKEN").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		JSON(map[string]string{
+			"name":       "GH_TOKEN",
+			"visibility": "selected",
+		})
+
+	gock.New("https://api.github.com").
+		Put("/orgs/octocat/actions/secrets/GH_TOKEN").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			in := new(secretInput)
+			if err := json.NewDecoder(req.Body).Decode(in); err != nil {
+				return false, err
+			}
+			want := []int64{1296269, 1296270}
+			return in.Visibility == "selected" && reflect.DeepEqual(in.SelectedRepositoryIDs, want), nil
+		}).
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	input := &scm.SecretInput{
+		Name:  "GH_TOKEN",
+		Value: "s3cr3t",
+	}
+
+	client := NewDefault()
+	_, err := client.Secrets.SetOrganization(context.Background(), "octocat", input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestSecretSetOrganizationVisibility(t *testing.T) {
+	defer gock.Off()
+
+	publicKey, _, _ := box.GenerateKey(rand.Reader)
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octocat/actions/secrets/public-key").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		JSON(map[string]string{
+			"key_id": "012345678912345678",
+			"key":    base64.StdEncoding.EncodeToString(publicKey[:]),
+		})
+
+	gock.New("https://api.github.com").
+		Put("/orgs/octocat/actions/secrets/GH_TOKEN").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			in := new(secretInput)
+			if err := json.NewDecoder(req.Body).Decode(in); err != nil {
+				return false, err
+			}
+			return in.Visibility == "selected" && reflect.DeepEqual(in.SelectedRepositoryIDs, []int64{1296269}), nil
+		}).
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	input := &scm.SecretInput{
+		Name:                  "GH_TOKEN",
+		Value:                 "s3cr3t",
+		Visibility:            "selected",
+		SelectedRepositoryIDs: []string{"1296269"},
+	}
+
+	client := NewDefault()
+	_, err := client.Secrets.SetOrganization(context.Background(), "octocat", input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestSecretDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/actions/secrets/GH_TOKEN").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Secrets.Delete(context.Background(), "octocat/hello-world", "GH_TOKEN")
+	if err != nil {
+		t.Error(err)
+	}
+}
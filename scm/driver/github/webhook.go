@@ -74,10 +74,22 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parseInstallationRepositoryHook(data)
 	case "label":
 		hook, err = s.parseLabelHook(data)
+	case "member":
+		hook, err = s.parseMemberHook(data)
+	case "membership":
+		hook, err = s.parseMembershipHook(data)
+	case "merge_group":
+		hook, err = s.parseMergeGroupHook(data)
+	case "milestone":
+		hook, err = s.parseMilestoneHook(data)
+	case "organization":
+		hook, err = s.parseOrganizationHook(data)
 	case "ping":
 		hook, err = s.parsePingHook(data, guid)
 	case "push":
 		hook, err = s.parsePushHook(data, guid)
+	case "public":
+		hook, err = s.parsePublicHook(data)
 	case "pull_request":
 		hook, err = s.parsePullRequestHook(data, guid)
 	case "pull_request_review":
@@ -88,10 +100,18 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parseReleaseHook(data)
 	case "repository":
 		hook, err = s.parseRepositoryHook(data)
+	case "repository_dispatch":
+		hook, err = s.parseRepositoryDispatchHook(data)
 	case "status":
 		hook, err = s.parseStatusHook(data)
+	case "team":
+		hook, err = s.parseTeamHook(data)
 	case "watch":
 		hook, err = s.parseWatchHook(data)
+	case "workflow_job":
+		hook, err = s.parseWorkflowJobHook(data)
+	case "workflow_run":
+		hook, err = s.parseWorkflowRunHook(data)
 	default:
 		log.WithField("Event", event).Warnf("unknown webhook")
 		return nil, scm.UnknownWebhook{Event: event}
@@ -284,6 +304,106 @@ func (s *webhookService) parseStatusHook(data []byte) (scm.Webhook, error) {
 	return to, err
 }
 
+func (s *webhookService) parseMemberHook(data []byte) (scm.Webhook, error) {
+	src := new(memberHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	to := convertMemberHook(src)
+	return to, err
+}
+
+func (s *webhookService) parseMembershipHook(data []byte) (scm.Webhook, error) {
+	src := new(membershipHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	to := convertMembershipHook(src)
+	return to, err
+}
+
+func (s *webhookService) parseMergeGroupHook(data []byte) (scm.Webhook, error) {
+	src := new(mergeGroupHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	to := convertMergeGroupHook(src)
+	return to, err
+}
+
+func (s *webhookService) parseMilestoneHook(data []byte) (scm.Webhook, error) {
+	src := new(milestoneHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	to := convertMilestoneHook(src)
+	return to, err
+}
+
+func (s *webhookService) parseOrganizationHook(data []byte) (scm.Webhook, error) {
+	src := new(organizationHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	to := convertOrganizationHook(src)
+	return to, err
+}
+
+func (s *webhookService) parsePublicHook(data []byte) (scm.Webhook, error) {
+	src := new(publicHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	to := convertPublicHook(src)
+	return to, err
+}
+
+func (s *webhookService) parseRepositoryDispatchHook(data []byte) (scm.Webhook, error) {
+	src := new(repositoryDispatchHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	to := convertRepositoryDispatchHook(src)
+	return to, err
+}
+
+func (s *webhookService) parseTeamHook(data []byte) (scm.Webhook, error) {
+	src := new(teamHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	to := convertTeamHook(src)
+	return to, err
+}
+
+func (s *webhookService) parseWorkflowJobHook(data []byte) (scm.Webhook, error) {
+	src := new(workflowJobHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	to := convertWorkflowJobHook(src)
+	return to, err
+}
+
+func (s *webhookService) parseWorkflowRunHook(data []byte) (scm.Webhook, error) {
+	src := new(workflowRunHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	to := convertWorkflowRunHook(src)
+	return to, err
+}
+
 func (s *webhookService) parseDeploymentHook(data []byte) (scm.Webhook, error) {
 	src := new(deploymentHook)
 	err := json.Unmarshal(data, src)
@@ -408,6 +528,7 @@ type (
 	// github ping payload
 	pingHook struct {
 		Repository   repository       `json:"repository"`
+		Organization *organization    `json:"organization"`
 		Sender       user             `json:"sender"`
 		Installation *installationRef `json:"installation"`
 	}
@@ -423,6 +544,7 @@ type (
 	// github check_run payload
 	checkRunHook struct {
 		Action       string           `json:"action"`
+		CheckRun     checkRun         `json:"check_run"`
 		Repository   repository       `json:"repository"`
 		Sender       user             `json:"sender"`
 		Label        label            `json:"label"`
@@ -440,6 +562,7 @@ type (
 	// github check_suite payload
 	checkSuiteHook struct {
 		Action       string           `json:"action"`
+		CheckSuite   checkSuite       `json:"check_suite"`
 		Repository   repository       `json:"repository"`
 		Sender       user             `json:"sender"`
 		Label        label            `json:"label"`
@@ -501,8 +624,235 @@ type (
 		Installation *installationRef `json:"installation"`
 	}
 
+	checkRun struct {
+		ID          int64       `json:"id"`
+		Name        string      `json:"name"`
+		HeadSha     string      `json:"head_sha"`
+		ExternalID  string      `json:"external_id"`
+		HTMLURL     string      `json:"html_url"`
+		DetailsURL  string      `json:"details_url"`
+		Status      string      `json:"status"`
+		Conclusion  string      `json:"conclusion"`
+		StartedAt   time.Time   `json:"started_at"`
+		CompletedAt time.Time   `json:"completed_at"`
+		Output      checkOutput `json:"output"`
+		CheckSuite  *checkSuite `json:"check_suite"`
+		App         checkApp    `json:"app"`
+		// PullRequests only carry the number, head and
+		// base references.
+		PullRequests []*checkPullRequest `json:"pull_requests"`
+	}
+
+	checkOutput struct {
+		Title            string `json:"title"`
+		Summary          string `json:"summary"`
+		Text             string `json:"text"`
+		AnnotationsCount int    `json:"annotations_count"`
+		AnnotationsURL   string `json:"annotations_url"`
+	}
+
+	checkSuite struct {
+		ID           int64               `json:"id"`
+		HeadBranch   string              `json:"head_branch"`
+		HeadSha      string              `json:"head_sha"`
+		Status       string              `json:"status"`
+		Conclusion   string              `json:"conclusion"`
+		Before       string              `json:"before"`
+		After        string              `json:"after"`
+		HeadCommit   *pushCommit         `json:"head_commit"`
+		App          checkApp            `json:"app"`
+		PullRequests []*checkPullRequest `json:"pull_requests"`
+		CreatedAt    time.Time           `json:"created_at"`
+		UpdatedAt    time.Time           `json:"updated_at"`
+	}
+
+	checkApp struct {
+		ID          int64  `json:"id"`
+		Slug        string `json:"slug"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		HTMLURL     string `json:"html_url"`
+		Owner       user   `json:"owner"`
+	}
+
+	checkPullRequest struct {
+		ID     int    `json:"id"`
+		Number int    `json:"number"`
+		URL    string `json:"url"`
+		Head   struct {
+			Ref string `json:"ref"`
+			Sha string `json:"sha"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+			Sha string `json:"sha"`
+		} `json:"base"`
+	}
+
+	// github workflow_run payload
+	workflowRunHook struct {
+		Action       string           `json:"action"`
+		WorkflowRun  workflowRun      `json:"workflow_run"`
+		Repository   repository       `json:"repository"`
+		Sender       user             `json:"sender"`
+		Installation *installationRef `json:"installation"`
+	}
+
+	workflowRun struct {
+		ID           int64               `json:"id"`
+		WorkflowID   int64               `json:"workflow_id"`
+		Name         string              `json:"name"`
+		DisplayTitle string              `json:"display_title"`
+		Path         string              `json:"path"`
+		Event        string              `json:"event"`
+		Status       string              `json:"status"`
+		Conclusion   string              `json:"conclusion"`
+		RunNumber    int                 `json:"run_number"`
+		RunAttempt   int                 `json:"run_attempt"`
+		HeadBranch   string              `json:"head_branch"`
+		HeadSha      string              `json:"head_sha"`
+		HTMLURL      string              `json:"html_url"`
+		Actor        user                `json:"actor"`
+		PullRequests []*checkPullRequest `json:"pull_requests"`
+		CreatedAt    time.Time           `json:"created_at"`
+		UpdatedAt    time.Time           `json:"updated_at"`
+		RunStartedAt time.Time           `json:"run_started_at"`
+	}
+
+	// github workflow_job payload
+	workflowJobHook struct {
+		Action       string           `json:"action"`
+		WorkflowJob  workflowJob      `json:"workflow_job"`
+		Repository   repository       `json:"repository"`
+		Sender       user             `json:"sender"`
+		Installation *installationRef `json:"installation"`
+	}
+
+	workflowJob struct {
+		ID           int64           `json:"id"`
+		RunID        int64           `json:"run_id"`
+		RunAttempt   int             `json:"run_attempt"`
+		Name         string          `json:"name"`
+		WorkflowName string          `json:"workflow_name"`
+		HeadBranch   string          `json:"head_branch"`
+		HeadSha      string          `json:"head_sha"`
+		Status       string          `json:"status"`
+		Conclusion   string          `json:"conclusion"`
+		Labels       []string        `json:"labels"`
+		RunnerName   string          `json:"runner_name"`
+		HTMLURL      string          `json:"html_url"`
+		Steps        []*workflowStep `json:"steps"`
+		CreatedAt    time.Time       `json:"created_at"`
+		StartedAt    time.Time       `json:"started_at"`
+		CompletedAt  time.Time       `json:"completed_at"`
+	}
+
+	workflowStep struct {
+		Number      int       `json:"number"`
+		Name        string    `json:"name"`
+		Status      string    `json:"status"`
+		Conclusion  string    `json:"conclusion"`
+		StartedAt   time.Time `json:"started_at"`
+		CompletedAt time.Time `json:"completed_at"`
+	}
+
+	// github merge_group payload
+	mergeGroupHook struct {
+		Action     string `json:"action"`
+		Reason     string `json:"reason"`
+		MergeGroup struct {
+			HeadSha    string     `json:"head_sha"`
+			HeadRef    string     `json:"head_ref"`
+			BaseSha    string     `json:"base_sha"`
+			BaseRef    string     `json:"base_ref"`
+			HeadCommit pushCommit `json:"head_commit"`
+		} `json:"merge_group"`
+		Repository   repository       `json:"repository"`
+		Sender       user             `json:"sender"`
+		Installation *installationRef `json:"installation"`
+	}
+
+	// github member payload
+	memberHook struct {
+		Action  string `json:"action"`
+		Member  user   `json:"member"`
+		Changes struct {
+			Permission struct {
+				From string `json:"from"`
+				To   string `json:"to"`
+			} `json:"permission"`
+		} `json:"changes"`
+		Repository   repository       `json:"repository"`
+		Sender       user             `json:"sender"`
+		Installation *installationRef `json:"installation"`
+	}
+
+	// github membership payload
+	membershipHook struct {
+		Action       string           `json:"action"`
+		Scope        string           `json:"scope"`
+		Member       user             `json:"member"`
+		Team         team             `json:"team"`
+		Organization organization     `json:"organization"`
+		Sender       user             `json:"sender"`
+		Installation *installationRef `json:"installation"`
+	}
+
+	// github team payload
+	teamHook struct {
+		Action       string           `json:"action"`
+		Team         team             `json:"team"`
+		Organization organization     `json:"organization"`
+		Repository   *repository      `json:"repository"`
+		Sender       user             `json:"sender"`
+		Installation *installationRef `json:"installation"`
+	}
+
+	// github organization payload
+	organizationHook struct {
+		Action       string           `json:"action"`
+		Membership   *membership      `json:"membership"`
+		Organization organization     `json:"organization"`
+		Sender       user             `json:"sender"`
+		Installation *installationRef `json:"installation"`
+	}
+
+	// github milestone payload
+	milestoneHook struct {
+		Action       string           `json:"action"`
+		Milestone    milestone        `json:"milestone"`
+		Repository   repository       `json:"repository"`
+		Sender       user             `json:"sender"`
+		Installation *installationRef `json:"installation"`
+	}
+
+	// github public payload
+	publicHook struct {
+		Repository   repository       `json:"repository"`
+		Sender       user             `json:"sender"`
+		Installation *installationRef `json:"installation"`
+	}
+
+	// github repository_dispatch payload
+	repositoryDispatchHook struct {
+		Action        string                 `json:"action"`
+		Branch        string                 `json:"branch"`
+		ClientPayload map[string]interface{} `json:"client_payload"`
+		Repository    repository             `json:"repository"`
+		Sender        user                   `json:"sender"`
+		Installation  *installationRef       `json:"installation"`
+	}
+
 	// github status payload
 	statusHook struct {
+		ID           int64            `json:"id"`
+		Sha          string           `json:"sha"`
+		State        string           `json:"state"`
+		Context      string           `json:"context"`
+		Description  string           `json:"description"`
+		TargetURL    string           `json:"target_url"`
+		Commit       commit           `json:"commit"`
+		Branches     []*branch        `json:"branches"`
 		Repository   repository       `json:"repository"`
 		Sender       user             `json:"sender"`
 		Label        label            `json:"label"`
@@ -633,6 +983,7 @@ type (
 	issueHook struct {
 		Action       string           `json:"action"`
 		Issue        issue            `json:"issue"`
+		Label        label            `json:"label"`
 		Changes      *editChange      `json:"changes"`
 		Repository   repository       `json:"repository"`
 		Sender       user             `json:"sender"`
@@ -803,11 +1154,15 @@ func convertInstallationRef(dst *installationRef) *scm.InstallationRef {
 	}
 }
 func convertPingHook(dst *pingHook) *scm.PingHook {
-	return &scm.PingHook{
+	to := &scm.PingHook{
 		Repo:         *convertRepository(&dst.Repository),
 		Sender:       *convertUser(&dst.Sender),
 		Installation: convertInstallationRef(dst.Installation),
 	}
+	if dst.Organization != nil {
+		to.Organization = *convertOrganization(dst.Organization)
+	}
+	return to
 }
 
 func convertWatchHook(dst *watchHook) *scm.WatchHook {
@@ -823,6 +1178,7 @@ func convertCheckRunHook(dst *checkRunHook) *scm.CheckRunHook {
 	return &scm.CheckRunHook{
 		Action:       convertAction(dst.Action),
 		Repo:         *convertRepository(&dst.Repository),
+		CheckRun:     convertCheckRun(&dst.CheckRun),
 		Sender:       *convertUser(&dst.Sender),
 		Label:        convertLabel(dst.Label),
 		Installation: convertInstallationRef(dst.Installation),
@@ -842,6 +1198,7 @@ func convertCheckSuiteHook(dst *checkSuiteHook) *scm.CheckSuiteHook {
 	return &scm.CheckSuiteHook{
 		Action:       convertAction(dst.Action),
 		Repo:         *convertRepository(&dst.Repository),
+		CheckSuite:   *convertCheckSuite(&dst.CheckSuite),
 		Sender:       *convertUser(&dst.Sender),
 		Label:        convertLabel(dst.Label),
 		Installation: convertInstallationRef(dst.Installation),
@@ -921,14 +1278,323 @@ func convertRepositoryHook(dst *repositoryHook) *scm.RepositoryHook {
 }
 
 func convertStatusHook(dst *statusHook) *scm.StatusHook {
+	commit := convertCommit(&dst.Commit)
+	if commit.Sha == "" {
+		commit.Sha = dst.Sha
+	}
+	var branches []scm.Reference
+	for _, b := range dst.Branches {
+		branches = append(branches, *convertBranch(b))
+	}
 	return &scm.StatusHook{
-		Repo:         *convertRepository(&dst.Repository),
+		Action: scm.ActionCreate,
+		Repo:   *convertRepository(&dst.Repository),
+		Status: scm.Status{
+			State:  convertState(dst.State),
+			Label:  dst.Context,
+			Desc:   dst.Description,
+			Target: dst.TargetURL,
+		},
+		Commit:       *commit,
+		Branches:     branches,
 		Sender:       *convertUser(&dst.Sender),
 		Label:        convertLabel(dst.Label),
 		Installation: convertInstallationRef(dst.Installation),
 	}
 }
 
+func convertCheckRun(src *checkRun) scm.CheckRun {
+	dst := scm.CheckRun{
+		ID:         src.ID,
+		Name:       src.Name,
+		HeadSha:    src.HeadSha,
+		ExternalID: src.ExternalID,
+		Status:     src.Status,
+		Conclusion: src.Conclusion,
+		Link:       src.HTMLURL,
+		DetailsURL: src.DetailsURL,
+		Output: scm.CheckRunOutput{
+			Title:            src.Output.Title,
+			Summary:          src.Output.Summary,
+			Text:             src.Output.Text,
+			AnnotationsCount: src.Output.AnnotationsCount,
+			AnnotationsURL:   src.Output.AnnotationsURL,
+		},
+		App:          convertCheckApp(&src.App),
+		PullRequests: convertCheckPullRequests(src.PullRequests),
+		Started:      src.StartedAt,
+		Completed:    src.CompletedAt,
+	}
+	if src.CheckSuite != nil {
+		dst.CheckSuite = convertCheckSuite(src.CheckSuite)
+	}
+	return dst
+}
+
+func convertCheckSuite(src *checkSuite) *scm.CheckSuite {
+	dst := &scm.CheckSuite{
+		ID:           src.ID,
+		HeadBranch:   src.HeadBranch,
+		HeadSha:      src.HeadSha,
+		Status:       src.Status,
+		Conclusion:   src.Conclusion,
+		Before:       src.Before,
+		After:        src.After,
+		App:          convertCheckApp(&src.App),
+		PullRequests: convertCheckPullRequests(src.PullRequests),
+		Created:      src.CreatedAt,
+		Updated:      src.UpdatedAt,
+	}
+	if src.HeadCommit != nil {
+		dst.HeadCommit = &scm.Commit{
+			Sha:     src.HeadCommit.ID,
+			Message: src.HeadCommit.Message,
+			Link:    src.HeadCommit.URL,
+			Author: scm.Signature{
+				Name:  src.HeadCommit.Author.Name,
+				Email: src.HeadCommit.Author.Email,
+				Login: src.HeadCommit.Author.Username,
+			},
+			Committer: scm.Signature{
+				Name:  src.HeadCommit.Committer.Name,
+				Email: src.HeadCommit.Committer.Email,
+				Login: src.HeadCommit.Committer.Username,
+			},
+		}
+		dst.HeadCommit.Tree.Sha = src.HeadCommit.TreeID
+		dst.HeadCommit.Author.Date, _ = time.Parse(time.RFC3339, src.HeadCommit.Timestamp)
+		dst.HeadCommit.Committer.Date = dst.HeadCommit.Author.Date
+	}
+	return dst
+}
+
+func convertCheckApp(src *checkApp) scm.App {
+	dst := scm.App{
+		ID:          src.ID,
+		Slug:        src.Slug,
+		Name:        src.Name,
+		Description: src.Description,
+		Link:        src.HTMLURL,
+	}
+	if owner := convertUser(&src.Owner); owner != nil {
+		dst.Owner = *owner
+	}
+	return dst
+}
+
+func convertCheckPullRequests(src []*checkPullRequest) []*scm.PullRequest {
+	var dst []*scm.PullRequest
+	for _, pr := range src {
+		dst = append(dst, &scm.PullRequest{
+			Number: pr.Number,
+			Sha:    pr.Head.Sha,
+			Ref:    fmt.Sprintf("refs/pull/%d/head", pr.Number),
+			Source: pr.Head.Ref,
+			Target: pr.Base.Ref,
+			Base: scm.PullRequestBranch{
+				Ref: pr.Base.Ref,
+				Sha: pr.Base.Sha,
+			},
+			Head: scm.PullRequestBranch{
+				Ref: pr.Head.Ref,
+				Sha: pr.Head.Sha,
+			},
+		})
+	}
+	return dst
+}
+
+func convertWorkflowRunHook(src *workflowRunHook) *scm.WorkflowRunHook {
+	run := src.WorkflowRun
+	dst := &scm.WorkflowRunHook{
+		Action: convertAction(src.Action),
+		Repo:   *convertRepository(&src.Repository),
+		WorkflowRun: scm.WorkflowRun{
+			ID:           run.ID,
+			WorkflowID:   run.WorkflowID,
+			Name:         run.Name,
+			DisplayTitle: run.DisplayTitle,
+			Path:         run.Path,
+			Event:        run.Event,
+			Status:       run.Status,
+			Conclusion:   run.Conclusion,
+			RunNumber:    run.RunNumber,
+			RunAttempt:   run.RunAttempt,
+			HeadBranch:   run.HeadBranch,
+			HeadSha:      run.HeadSha,
+			Link:         run.HTMLURL,
+			Actor:        *convertUser(&run.Actor),
+			Created:      run.CreatedAt,
+			Updated:      run.UpdatedAt,
+			Started:      run.RunStartedAt,
+		},
+		Sender:       *convertUser(&src.Sender),
+		Installation: convertInstallationRef(src.Installation),
+	}
+	for _, pr := range run.PullRequests {
+		dst.WorkflowRun.PullRequests = append(dst.WorkflowRun.PullRequests, pr.Number)
+	}
+	return dst
+}
+
+func convertWorkflowJobHook(src *workflowJobHook) *scm.WorkflowJobHook {
+	job := src.WorkflowJob
+	dst := &scm.WorkflowJobHook{
+		Action: convertAction(src.Action),
+		Repo:   *convertRepository(&src.Repository),
+		WorkflowJob: scm.WorkflowJob{
+			ID:           job.ID,
+			RunID:        job.RunID,
+			RunAttempt:   job.RunAttempt,
+			Name:         job.Name,
+			WorkflowName: job.WorkflowName,
+			HeadBranch:   job.HeadBranch,
+			HeadSha:      job.HeadSha,
+			Status:       job.Status,
+			Conclusion:   job.Conclusion,
+			Labels:       job.Labels,
+			RunnerName:   job.RunnerName,
+			Link:         job.HTMLURL,
+			Started:      job.StartedAt,
+			Completed:    job.CompletedAt,
+		},
+		Sender:       *convertUser(&src.Sender),
+		Installation: convertInstallationRef(src.Installation),
+	}
+	for _, step := range job.Steps {
+		dst.WorkflowJob.Steps = append(dst.WorkflowJob.Steps, scm.WorkflowStep{
+			Number:     step.Number,
+			Name:       step.Name,
+			Status:     step.Status,
+			Conclusion: step.Conclusion,
+			Started:    step.StartedAt,
+			Completed:  step.CompletedAt,
+		})
+	}
+	return dst
+}
+
+func convertMergeGroupHook(src *mergeGroupHook) *scm.MergeGroupHook {
+	group := src.MergeGroup
+	commit := group.HeadCommit
+	dst := &scm.MergeGroupHook{
+		Action: convertAction(src.Action),
+		Repo:   *convertRepository(&src.Repository),
+		MergeGroup: scm.MergeGroup{
+			HeadSha: group.HeadSha,
+			HeadRef: group.HeadRef,
+			BaseSha: group.BaseSha,
+			BaseRef: group.BaseRef,
+			HeadCommit: scm.Commit{
+				Sha:     commit.ID,
+				Message: commit.Message,
+				Link:    commit.URL,
+				Author: scm.Signature{
+					Name:  commit.Author.Name,
+					Email: commit.Author.Email,
+					Login: commit.Author.Username,
+				},
+				Committer: scm.Signature{
+					Name:  commit.Committer.Name,
+					Email: commit.Committer.Email,
+					Login: commit.Committer.Username,
+				},
+			},
+		},
+		Reason:       src.Reason,
+		Sender:       *convertUser(&src.Sender),
+		Installation: convertInstallationRef(src.Installation),
+	}
+	dst.MergeGroup.HeadCommit.Tree.Sha = commit.TreeID
+	dst.MergeGroup.HeadCommit.Author.Date, _ = time.Parse(time.RFC3339, commit.Timestamp)
+	dst.MergeGroup.HeadCommit.Committer.Date = dst.MergeGroup.HeadCommit.Author.Date
+	return dst
+}
+
+func convertMemberHook(src *memberHook) *scm.MemberHook {
+	return &scm.MemberHook{
+		Action:       convertAction(src.Action),
+		Repo:         *convertRepository(&src.Repository),
+		Member:       *convertUser(&src.Member),
+		Permission:   src.Changes.Permission.To,
+		Sender:       *convertUser(&src.Sender),
+		Installation: convertInstallationRef(src.Installation),
+	}
+}
+
+func convertMembershipHook(src *membershipHook) *scm.MembershipHook {
+	return &scm.MembershipHook{
+		Action:       convertAction(src.Action),
+		Scope:        src.Scope,
+		Member:       *convertUser(&src.Member),
+		Team:         *convertTeam(&src.Team),
+		Organization: *convertOrganization(&src.Organization),
+		Sender:       *convertUser(&src.Sender),
+		Installation: convertInstallationRef(src.Installation),
+	}
+}
+
+func convertTeamHook(src *teamHook) *scm.TeamHook {
+	dst := &scm.TeamHook{
+		Action:       convertAction(src.Action),
+		Team:         *convertTeam(&src.Team),
+		Organization: *convertOrganization(&src.Organization),
+		Sender:       *convertUser(&src.Sender),
+		Installation: convertInstallationRef(src.Installation),
+	}
+	if src.Repository != nil {
+		dst.Repo = *convertRepository(src.Repository)
+	}
+	return dst
+}
+
+func convertOrganizationHook(src *organizationHook) *scm.OrganizationHook {
+	dst := &scm.OrganizationHook{
+		Action:       convertAction(src.Action),
+		Organization: *convertOrganization(&src.Organization),
+		Sender:       *convertUser(&src.Sender),
+		Installation: convertInstallationRef(src.Installation),
+	}
+	if src.Membership != nil {
+		dst.Member = *convertUser(&src.Membership.User)
+		dst.Membership = scm.Membership{
+			State:            src.Membership.State,
+			Role:             src.Membership.Role,
+			OrganizationName: src.Organization.Login,
+		}
+	}
+	return dst
+}
+
+func convertMilestoneHook(src *milestoneHook) *scm.MilestoneHook {
+	return &scm.MilestoneHook{
+		Action:       convertAction(src.Action),
+		Repo:         *convertRepository(&src.Repository),
+		Milestone:    *convertMilestone(&src.Milestone),
+		Sender:       *convertUser(&src.Sender),
+		Installation: convertInstallationRef(src.Installation),
+	}
+}
+
+func convertPublicHook(src *publicHook) *scm.PublicHook {
+	return &scm.PublicHook{
+		Repo:         *convertRepository(&src.Repository),
+		Sender:       *convertUser(&src.Sender),
+		Installation: convertInstallationRef(src.Installation),
+	}
+}
+
+func convertRepositoryDispatchHook(src *repositoryDispatchHook) *scm.RepositoryDispatchHook {
+	return &scm.RepositoryDispatchHook{
+		EventType:     src.Action,
+		Branch:        src.Branch,
+		ClientPayload: src.ClientPayload,
+		Repo:          *convertRepository(&src.Repository),
+		Sender:        *convertUser(&src.Sender),
+		Installation:  convertInstallationRef(src.Installation),
+	}
+}
+
 func convertPushHook(src *pushHook) *scm.PushHook {
 	dst := &scm.PushHook{
 		Ref:     src.Ref,
@@ -991,12 +1657,28 @@ func convertPushCommits(src []pushCommit) []scm.PushCommit {
 }
 
 func convertPushCommit(src *pushCommit) *scm.PushCommit {
+	timestamp, _ := time.Parse(time.RFC3339, src.Timestamp)
 	return &scm.PushCommit{
-		ID:       src.URL,
-		Message:  src.Message,
-		Added:    src.Added,
-		Removed:  src.Removed,
-		Modified: src.Modified,
+		ID:      src.ID,
+		Message: src.Message,
+		Author: scm.Signature{
+			Login: src.Author.Username,
+			Email: src.Author.Email,
+			Name:  src.Author.Name,
+			Date:  timestamp,
+		},
+		Committer: scm.Signature{
+			Login: src.Committer.Username,
+			Email: src.Committer.Email,
+			Name:  src.Committer.Name,
+			Date:  timestamp,
+		},
+		Timestamp: timestamp,
+		Link:      src.URL,
+		Distinct:  src.Distinct,
+		Added:     src.Added,
+		Removed:   src.Removed,
+		Modified:  src.Modified,
 	}
 }
 
@@ -1115,6 +1797,7 @@ func convertIssueHook(dst *issueHook) *scm.IssueHook {
 	return &scm.IssueHook{
 		Action:       convertAction(dst.Action),
 		Issue:        *convertIssue(&dst.Issue),
+		Label:        convertLabel(dst.Label),
 		Repo:         *convertRepository(&dst.Repository),
 		Sender:       *convertUser(&dst.Sender),
 		Installation: convertInstallationRef(dst.Installation),
@@ -1183,6 +1866,24 @@ func convertAction(src string) (action scm.Action) {
 		return scm.ActionSync
 	case "complete", "completed":
 		return scm.ActionCompleted
+	case "requested":
+		return scm.ActionRequested
+	case "queued":
+		return scm.ActionQueued
+	case "in_progress":
+		return scm.ActionInProgress
+	case "waiting":
+		return scm.ActionWaiting
+	case "checks_requested":
+		return scm.ActionChecksRequested
+	case "destroyed":
+		return scm.ActionDestroyed
+	case "added", "added_to_repository", "member_added":
+		return scm.ActionAdded
+	case "removed", "removed_from_repository", "member_removed":
+		return scm.ActionRemoved
+	case "member_invited":
+		return scm.ActionInvited
 	default:
 		return
 	}
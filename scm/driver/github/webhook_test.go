@@ -111,6 +111,13 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/ping.json.golden",
 			obj:    new(scm.PingHook),
 		},
+		{
+			name:   "org ping",
+			event:  "ping",
+			before: "testdata/webhooks/org_ping.json",
+			after:  "testdata/webhooks/org_ping.json.golden",
+			obj:    new(scm.PingHook),
+		},
 
 		// push hooks
 		{
@@ -120,6 +127,14 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/push.json.golden",
 			obj:    new(scm.PushHook),
 		},
+		// push hooks with commits
+		{
+			name:   "push_commits",
+			event:  "push",
+			before: "testdata/webhooks/push_commits.json",
+			after:  "testdata/webhooks/push_commits.json.golden",
+			obj:    new(scm.PushHook),
+		},
 		// push tag create hooks
 		{
 			name:   "push_tag_create",
@@ -320,6 +335,86 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/installation_delete.json.golden",
 			obj:    new(scm.InstallationHook),
 		},
+		// workflow run
+		{
+			name:   "workflow_run",
+			event:  "workflow_run",
+			before: "testdata/webhooks/workflow_run.json",
+			after:  "testdata/webhooks/workflow_run.json.golden",
+			obj:    new(scm.WorkflowRunHook),
+		},
+		// workflow job
+		{
+			name:   "workflow_job",
+			event:  "workflow_job",
+			before: "testdata/webhooks/workflow_job.json",
+			after:  "testdata/webhooks/workflow_job.json.golden",
+			obj:    new(scm.WorkflowJobHook),
+		},
+		// merge queue group
+		{
+			name:   "merge_group",
+			event:  "merge_group",
+			before: "testdata/webhooks/merge_group.json",
+			after:  "testdata/webhooks/merge_group.json.golden",
+			obj:    new(scm.MergeGroupHook),
+		},
+		// repository collaborator
+		{
+			name:   "member",
+			event:  "member",
+			before: "testdata/webhooks/member.json",
+			after:  "testdata/webhooks/member.json.golden",
+			obj:    new(scm.MemberHook),
+		},
+		// team membership
+		{
+			name:   "membership",
+			event:  "membership",
+			before: "testdata/webhooks/membership.json",
+			after:  "testdata/webhooks/membership.json.golden",
+			obj:    new(scm.MembershipHook),
+		},
+		// team added to repository
+		{
+			name:   "team",
+			event:  "team",
+			before: "testdata/webhooks/team.json",
+			after:  "testdata/webhooks/team.json.golden",
+			obj:    new(scm.TeamHook),
+		},
+		// organization member
+		{
+			name:   "organization",
+			event:  "organization",
+			before: "testdata/webhooks/organization.json",
+			after:  "testdata/webhooks/organization.json.golden",
+			obj:    new(scm.OrganizationHook),
+		},
+		// milestone
+		{
+			name:   "milestone",
+			event:  "milestone",
+			before: "testdata/webhooks/milestone.json",
+			after:  "testdata/webhooks/milestone.json.golden",
+			obj:    new(scm.MilestoneHook),
+		},
+		// repository made public
+		{
+			name:   "public",
+			event:  "public",
+			before: "testdata/webhooks/public.json",
+			after:  "testdata/webhooks/public.json.golden",
+			obj:    new(scm.PublicHook),
+		},
+		// repository dispatch
+		{
+			name:   "repository_dispatch",
+			event:  "repository_dispatch",
+			before: "testdata/webhooks/repository_dispatch.json",
+			after:  "testdata/webhooks/repository_dispatch.json.golden",
+			obj:    new(scm.RepositoryDispatchHook),
+		},
 	}
 
 	for _, test := range tests {
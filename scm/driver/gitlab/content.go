@@ -77,6 +77,62 @@ func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*s
 	return nil, scm.ErrNotSupported
 }
 
+// CommitFiles creates the commit with the commits API, which
+// applies the file actions atomically. Executable bits are set
+// with chmod actions following the file changes.
+func (s *contentService) CommitFiles(ctx context.Context, repo string, params *scm.CommitFilesParams) (*scm.Commit, *scm.Response, error) {
+	body := &createCommitBody{
+		Branch:      params.Branch,
+		ID:          encode(repo),
+		Message:     params.Message,
+		AuthorName:  params.Author.Name,
+		AuthorEmail: params.Author.Email,
+	}
+	if body.Branch == "" {
+		body.Branch = params.Base
+	} else if body.Branch != params.Base {
+		path := fmt.Sprintf("api/v4/projects/%s/repository/branches/%s", encode(repo), url.PathEscape(body.Branch))
+		res, err := s.client.do(ctx, "GET", path, nil, nil)
+		if res != nil && res.Status == 404 {
+			body.StartBranch = params.Base
+		} else if err != nil {
+			return nil, res, err
+		}
+	}
+	for _, c := range params.Changes {
+		action := createCommitAction{
+			Action:   c.Action,
+			Path:     c.Path,
+			Content:  c.Data,
+			Encoding: "base64",
+		}
+		switch c.Action {
+		case scm.FileCreate, scm.FileUpdate:
+			if action.Content == nil {
+				action.Content = []byte{}
+			}
+		case scm.FileMove:
+			action.PreviousPath = c.PreviousPath
+		case scm.FileChmod:
+			executable := c.Executable != nil && *c.Executable
+			action.ExecuteFilemode = &executable
+		}
+		body.Actions = append(body.Actions, action)
+		if c.Executable != nil && c.Action != scm.FileChmod && c.Action != scm.FileDelete {
+			body.Actions = append(body.Actions, createCommitAction{
+				Action:          scm.FileChmod,
+				Path:            c.Path,
+				Encoding:        "base64",
+				ExecuteFilemode: c.Executable,
+			})
+		}
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits", encode(repo))
+	out := new(commit)
+	res, err := s.client.do(ctx, "POST", path, body, out)
+	return convertCommit(out), res, err
+}
+
 type content struct {
 	FileName     string `json:"file_name"`
 	FilePath     string `json:"file_path"`
@@ -90,17 +146,22 @@ type content struct {
 }
 
 type createCommitAction struct {
-	Action   string `json:"action"`
-	Path     string `json:"file_path"`
-	Content  []byte `json:"content"`
-	Encoding string `json:"encoding"`
+	Action          string `json:"action"`
+	Path            string `json:"file_path"`
+	PreviousPath    string `json:"previous_path,omitempty"`
+	Content         []byte `json:"content"`
+	Encoding        string `json:"encoding"`
+	ExecuteFilemode *bool  `json:"execute_filemode,omitempty"`
 }
 
 type createCommitBody struct {
-	Branch  string               `json:"branch"`
-	ID      string               `json:"id"`
-	Message string               `json:"commit_message"`
-	Actions []createCommitAction `json:"actions"`
+	Branch      string               `json:"branch"`
+	StartBranch string               `json:"start_branch,omitempty"`
+	ID          string               `json:"id"`
+	Message     string               `json:"commit_message"`
+	AuthorName  string               `json:"author_name,omitempty"`
+	AuthorEmail string               `json:"author_email,omitempty"`
+	Actions     []createCommitAction `json:"actions"`
 }
 
 type updateContentBody struct {
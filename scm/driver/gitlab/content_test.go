@@ -220,3 +220,89 @@ class Key < ActiveRecord::Base
   def key=(value)
     value&.delete!("\n\r")
     value.strip! unless value.blank`)
+
+func TestContentCommitFiles(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("api/v4/projects/octocat/hello-world/repository/branches/feature").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message": "404 Branch Not Found"}`)
+
+	gock.New("https://gitlab.com").
+		Post("api/v4/projects/octocat/hello-world/repository/commits").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"branch":         "feature",
+			"start_branch":   "master",
+			"id":             "octocat%2Fhello-world",
+			"commit_message": "add build script",
+			"author_name":    "Dmitriy",
+			"author_email":   "dmitriy.zaporozhets@gmail.com",
+			"actions": []interface{}{
+				map[string]interface{}{
+					"action":    "create",
+					"file_path": "build.sh",
+					"content":   base64.StdEncoding.EncodeToString([]byte("make")),
+					"encoding":  "base64",
+				},
+				map[string]interface{}{
+					"action":           "chmod",
+					"file_path":        "build.sh",
+					"content":          nil,
+					"encoding":         "base64",
+					"execute_filemode": true,
+				},
+				map[string]interface{}{
+					"action":        "move",
+					"file_path":     "docs/README",
+					"previous_path": "README",
+					"content":       nil,
+					"encoding":      "base64",
+				},
+				map[string]interface{}{
+					"action":    "delete",
+					"file_path": "Makefile.old",
+					"content":   nil,
+					"encoding":  "base64",
+				},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.json")
+
+	executable := true
+	params := &scm.CommitFilesParams{
+		Base:    "master",
+		Branch:  "feature",
+		Message: "add build script",
+		Author:  scm.Signature{Name: "Dmitriy", Email: "dmitriy.zaporozhets@gmail.com"},
+		Changes: []scm.FileChange{
+			{Action: scm.FileCreate, Path: "build.sh", Data: []byte("make"), Executable: &executable},
+			{Action: scm.FileMove, Path: "docs/README", PreviousPath: "README"},
+			{Action: scm.FileDelete, Path: "Makefile.old"},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Contents.CommitFiles(context.Background(), "octocat/hello-world", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
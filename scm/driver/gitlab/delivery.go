@@ -0,0 +1,97 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type deliveryService struct {
+	client *wrapper
+}
+
+type hookEvent struct {
+	ID                int               `json:"id"`
+	URL               string            `json:"url"`
+	Trigger           string            `json:"trigger"`
+	RequestHeaders    map[string]string `json:"request_headers"`
+	RequestData       json.RawMessage   `json:"request_data"`
+	ResponseHeaders   map[string]string `json:"response_headers"`
+	ResponseBody      string            `json:"response_body"`
+	ExecutionDuration float64           `json:"execution_duration"`
+	ResponseStatus    string            `json:"response_status"`
+}
+
+func (s *deliveryService) List(ctx context.Context, repo, hook string, opts scm.ListOptions) ([]*scm.WebhookDelivery, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s/events?%s", encode(repo), hook, encodeListOptions(opts))
+	out := []*hookEvent{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookEventList(out), res, err
+}
+
+// Find is not supported since gitlab has no endpoint to get a
+// single hook event; List returns the full request and response.
+func (s *deliveryService) Find(ctx context.Context, repo, hook, id string) (*scm.WebhookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deliveryService) Redeliver(ctx context.Context, repo, hook, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s/events/%s/resend", encode(repo), hook, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *deliveryService) Ping(ctx context.Context, repo, hook string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s/test/push_events", encode(repo), hook)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func convertHookEventList(from []*hookEvent) []*scm.WebhookDelivery {
+	to := []*scm.WebhookDelivery{}
+	for _, v := range from {
+		to = append(to, convertHookEvent(v))
+	}
+	return to
+}
+
+func convertHookEvent(from *hookEvent) *scm.WebhookDelivery {
+	header := convertDeliveryHeader(from.RequestHeaders)
+	event := header.Get("X-Gitlab-Event")
+	if event == "" {
+		event = from.Trigger
+	}
+	code, _ := strconv.Atoi(from.ResponseStatus)
+	return &scm.WebhookDelivery{
+		ID:         strconv.Itoa(from.ID),
+		GUID:       header.Get("X-Gitlab-Event-UUID"),
+		Event:      event,
+		Status:     from.ResponseStatus,
+		StatusCode: code,
+		Duration:   time.Duration(from.ExecutionDuration * float64(time.Second)),
+		Request: &scm.WebhookDeliveryRequest{
+			URL:    from.URL,
+			Header: header,
+			Body:   string(from.RequestData),
+		},
+		Response: &scm.WebhookDeliveryResponse{
+			Header: convertDeliveryHeader(from.ResponseHeaders),
+			Body:   from.ResponseBody,
+		},
+	}
+}
+
+func convertDeliveryHeader(from map[string]string) http.Header {
+	to := http.Header{}
+	for k, v := range from {
+		to.Set(k, v)
+	}
+	return to
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestDeliveryList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/hooks/1/events").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook_events.json")
+
+	client := NewDefault()
+	got, res, err := client.Deliveries.List(context.Background(), "diaspora/diaspora", "1", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.WebhookDelivery{}
+	raw, _ := ioutil.ReadFile("testdata/hook_events.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+
+	// the recorded request must be accepted by the webhook
+	// parser.
+	req, err := got[0].Request.HTTPRequest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hook, err := client.Webhooks.Parse(req, func(scm.Webhook) (string, error) {
+		return "", nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := hook.(*scm.PushHook); !ok {
+		t.Errorf("Expected push hook, got %T", hook)
+	}
+}
+
+func TestDeliveryRedeliver(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/hooks/1/events/2/resend").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Deliveries.Redeliver(context.Background(), "diaspora/diaspora", "1", "2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeliveryPing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/hooks/1/test/push_events").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Deliveries.Ping(context.Background(), "diaspora/diaspora", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
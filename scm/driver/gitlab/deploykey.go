@@ -0,0 +1,90 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type deployKeyService struct {
+	client *wrapper
+}
+
+type deployKey struct {
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	Key       string    `json:"key"`
+	CanPush   bool      `json:"can_push"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type deployKeyInput struct {
+	Title   string `json:"title"`
+	Key     string `json:"key"`
+	CanPush bool   `json:"can_push"`
+}
+
+func (s *deployKeyService) Find(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys/%s", encode(repo), id)
+	out := new(deployKey)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDeployKey(out), res, err
+}
+
+func (s *deployKeyService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys?%s", encode(repo), encodeListOptions(opts))
+	out := []*deployKey{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeployKeyList(out), res, err
+}
+
+func (s *deployKeyService) Create(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys", encode(repo))
+	in := &deployKeyInput{
+		Title:   input.Title,
+		Key:     input.Key,
+		CanPush: !input.ReadOnly,
+	}
+	out := new(deployKey)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployKey(out), res, err
+}
+
+func (s *deployKeyService) Delete(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys/%s", encode(repo), id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Enable enables a deploy key of another project on the
+// project. The enabled key is read-only.
+func (s *deployKeyService) Enable(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys/%s/enable", encode(repo), id)
+	out := new(deployKey)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertDeployKey(out), res, err
+}
+
+func convertDeployKeyList(from []*deployKey) []*scm.DeployKey {
+	to := []*scm.DeployKey{}
+	for _, v := range from {
+		to = append(to, convertDeployKey(v))
+	}
+	return to
+}
+
+func convertDeployKey(from *deployKey) *scm.DeployKey {
+	return &scm.DeployKey{
+		ID:       strconv.Itoa(from.ID),
+		Title:    from.Title,
+		Key:      from.Key,
+		ReadOnly: !from.CanPush,
+		Created:  from.CreatedAt,
+	}
+}
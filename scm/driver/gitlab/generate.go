@@ -0,0 +1,191 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// Generate renders the webhook as the request gitlab would
+// send, with the secret as the webhook token.
+func (s *webhookService) Generate(hook scm.Webhook, secret string) (*http.Request, error) {
+	var event string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event = "Push Hook"
+		if scm.IsTag(v.Ref) {
+			event = "Tag Push Hook"
+		}
+		payload = convertFromPushHook(v)
+	case *scm.BranchHook:
+		event, payload = "Push Hook", convertFromRefHook("refs/heads/", v.Ref, v.Action, &v.Repo, &v.Sender)
+	case *scm.TagHook:
+		event, payload = "Tag Push Hook", convertFromRefHook("refs/tags/", v.Ref, v.Action, &v.Repo, &v.Sender)
+	case *scm.PullRequestHook:
+		event, payload = "Merge Request Hook", convertFromPullRequestHook(v)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	header := http.Header{}
+	header.Set("X-Gitlab-Event", event)
+	header.Set("X-Gitlab-Event-UUID", scm.NewDeliveryID())
+	if secret != "" {
+		header.Set("X-Gitlab-Token", secret)
+	}
+	return scm.NewWebhookRequest("/", data, header)
+}
+
+func convertFromPushHook(from *scm.PushHook) *pushHook {
+	to := &pushHook{
+		ObjectKind:   "push",
+		EventName:    "push",
+		Before:       from.Before,
+		After:        from.After,
+		Ref:          from.Ref,
+		CheckoutSha:  from.Commit.Sha,
+		UserName:     from.Sender.Name,
+		UserUsername: from.Sender.Login,
+		UserEmail:    from.Sender.Email,
+		UserAvatar:   from.Sender.Avatar,
+		Project:      convertFromRepositoryHook(&from.Repo),
+	}
+	if scm.IsTag(from.Ref) {
+		to.ObjectKind = "tag_push"
+		to.EventName = "tag_push"
+	}
+	to.ProjectID = to.Project.ID
+	for _, c := range from.Commits {
+		commit := pushCommit{
+			ID:       c.ID,
+			Message:  c.Message,
+			URL:      c.Link,
+			Added:    c.Added,
+			Removed:  c.Removed,
+			Modified: c.Modified,
+		}
+		if !c.Timestamp.IsZero() {
+			commit.Timestamp = c.Timestamp.Format(time.RFC3339)
+		}
+		commit.Author.Name = c.Author.Name
+		commit.Author.Email = c.Author.Email
+		to.Commits = append(to.Commits, commit)
+	}
+	if len(to.Commits) == 0 && (from.Commit.Message != "" || from.Commit.Link != "") {
+		to.Commits = make([]pushCommit, 1)
+		to.Commits[0].ID = from.Commit.Sha
+		to.Commits[0].Message = from.Commit.Message
+		to.Commits[0].URL = from.Commit.Link
+		to.Commits[0].Author.Name = from.Commit.Author.Name
+		to.Commits[0].Author.Email = from.Commit.Author.Email
+	}
+	to.TotalCommitsCount = len(to.Commits)
+	return to
+}
+
+// helper function renders a branch or tag hook as the push
+// hook gitlab sends when a reference is created or deleted.
+func convertFromRefHook(prefix string, ref scm.Reference, action scm.Action, repo *scm.Repository, sender *scm.User) *pushHook {
+	to := &pushHook{
+		ObjectKind:   "push",
+		EventName:    "push",
+		Ref:          prefix + ref.Name,
+		Before:       scm.EmptyCommit,
+		After:        ref.Sha,
+		UserName:     sender.Name,
+		UserUsername: sender.Login,
+		UserEmail:    sender.Email,
+		UserAvatar:   sender.Avatar,
+		Project:      convertFromRepositoryHook(repo),
+	}
+	if prefix == "refs/tags/" {
+		to.ObjectKind = "tag_push"
+		to.EventName = "tag_push"
+	}
+	if action == scm.ActionDelete {
+		to.Before, to.After = ref.Sha, scm.EmptyCommit
+	}
+	to.ProjectID = to.Project.ID
+	return to
+}
+
+func convertFromPullRequestHook(from *scm.PullRequestHook) *pullRequestHook {
+	to := &pullRequestHook{
+		ObjectKind: "merge_request",
+		Project:    convertFromRepositoryHook(&from.Repo),
+	}
+	to.User.Name = from.Sender.Name
+	to.User.Username = from.Sender.Login
+	to.User.AvatarURL = from.Sender.Avatar
+
+	pr := from.PullRequest
+	attrs := &to.ObjectAttributes
+	attrs.Iid = pr.Number
+	attrs.Title = pr.Title
+	attrs.Description = pr.Body
+	attrs.SourceBranch = pr.Source
+	attrs.TargetBranch = pr.Target
+	attrs.URL = pr.Link
+	attrs.MergeCommitSha = pr.MergeSha
+	attrs.LastCommit.ID = pr.Sha
+	attrs.OldRev = from.Changes.Base.Sha.From
+	switch {
+	case !pr.Closed:
+		attrs.State = "opened"
+	case pr.Merged:
+		attrs.State = "merged"
+	default:
+		attrs.State = "closed"
+	}
+	switch from.Action {
+	case scm.ActionOpen:
+		attrs.Action = "open"
+	case scm.ActionClose:
+		attrs.Action = "close"
+	case scm.ActionReopen:
+		attrs.Action = "reopen"
+	case scm.ActionMerge:
+		attrs.Action = "merge"
+	default:
+		attrs.Action = "update"
+	}
+
+	// the fork is derived from the source project namespace
+	// and name, the pull request repositories from the paths.
+	source := convertFromRepositoryHook(&pr.Head.Repo)
+	source.Namespace, source.Name = scm.Split(pr.Fork)
+	target := convertFromRepositoryHook(&pr.Base.Repo)
+	attrs.Source = &source
+	attrs.Target = &target
+	attrs.SourceProjectID = source.ID
+	attrs.TargetProjectID = target.ID
+	return to
+}
+
+func convertFromRepositoryHook(from *scm.Repository) project {
+	id, _ := strconv.Atoi(from.ID)
+	return project{
+		ID:                id,
+		Name:              from.Name,
+		Namespace:         from.Namespace,
+		WebURL:            from.Link,
+		GitSSHURL:         from.CloneSSH,
+		GitHTTPURL:        from.Clone,
+		PathWithNamespace: from.FullName,
+		DefaultBranch:     from.Branch,
+		URL:               from.CloneSSH,
+		SSHURL:            from.CloneSSH,
+		HTTPURL:           from.Clone,
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm/driver/internal/webhooktest"
+)
+
+func TestWebhookGenerate(t *testing.T) {
+	tests := []webhooktest.Test{
+		{Event: "Push Hook", File: "push.json"},
+		{Event: "Push Hook", File: "push2.json"},
+		{Event: "Push Hook", File: "branch_create.json"},
+		{Event: "Push Hook", File: "branch_delete.json"},
+		{Event: "Tag Push Hook", File: "tag_create.json"},
+		{Event: "Tag Push Hook", File: "tag_delete.json"},
+		{Event: "Merge Request Hook", File: "pull_request_create.json"},
+		{Event: "Merge Request Hook", File: "pull_request_edited.json"},
+		{Event: "Merge Request Hook", File: "pull_request_close.json"},
+		{Event: "Merge Request Hook", File: "pull_request_reopen.json"},
+		{Event: "Merge Request Hook", File: "pull_request_merge.json"},
+	}
+	webhooktest.RoundTrip(t, new(webhookService), generateHeader, tests)
+}
+
+func TestWebhookGenerateNotSupported(t *testing.T) {
+	webhooktest.NotSupported(t, new(webhookService))
+}
+
+// generateHeader returns the headers GitLab sends with the event.
+func generateHeader(event string) http.Header {
+	header := http.Header{}
+	header.Set("X-Gitlab-Event", event)
+	return header
+}
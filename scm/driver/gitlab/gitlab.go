@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -39,15 +40,22 @@ func New(uri string) (*scm.Client, error) {
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverGitlab
+	client.BranchProtections = &branchProtectionService{client}
+	client.Checks = scm.NewStatusChecksService(client.Client)
 	client.Contents = &contentService{client}
+	client.DeployKeys = &deployKeyService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
 	client.Releases = &releaseService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
+	client.SystemHooks = &systemHookService{client}
+	client.Deliveries = &deliveryService{client}
 	client.Commits = &commitService{client}
 
 	//add the user service to the webhook service so it can be used for fetching users
@@ -181,6 +189,13 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 		return res, nil
 	}
 
+	// if raw output is expected, copy to the provided
+	// buffer and exit.
+	if w, ok := out.(io.Writer); ok {
+		_, err := io.Copy(w, res.Body)
+		return res, err
+	}
+
 	// if a json response is expected, parse and return
 	// the json response.
 	return res, json.NewDecoder(res.Body).Decode(out)
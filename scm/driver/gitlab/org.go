@@ -108,6 +108,199 @@ func (s *organizationService) ListMemberships(ctx context.Context, opts scm.List
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *organizationService) ListHooks(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/hooks?%s", encode(org), encodeListOptions(opts))
+	out := []*hook{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookList(out), res, err
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	params := encodeHookInput(input)
+	path := fmt.Sprintf("api/v4/groups/%s/hooks?%s", encode(org), params.Encode())
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertHook(out), res, checkHookEvents(input)
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	params := encodeHookInput(input)
+	path := fmt.Sprintf("api/v4/groups/%s/hooks/%s?%s", encode(org), input.ID, params.Encode())
+	out := new(hook)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertHook(out), res, checkHookEvents(input)
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, org string, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/hooks/%s", encode(org), id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// CreateTeam creates a subgroup of the organization group.
+func (s *organizationService) CreateTeam(ctx context.Context, org string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	parent := input.ParentTeamID
+	if parent == 0 {
+		group, res, err := s.Find(ctx, org)
+		if err != nil {
+			return nil, res, err
+		}
+		parent = group.ID
+	}
+	in := &groupInput{
+		Name:        input.Name,
+		Path:        input.Slug,
+		Description: input.Description,
+		ParentID:    parent,
+	}
+	if in.Path == "" {
+		in.Path = input.Name
+	}
+	out := new(group)
+	res, err := s.client.do(ctx, "POST", "api/v4/groups", in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertGroupTeam(out), res, scm.CheckUnsupportedFields(input, "Privacy")
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, org, team string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s", encode(org+"/"+team))
+	in := &groupInput{
+		Name:        input.Name,
+		Path:        input.Slug,
+		Description: input.Description,
+		ParentID:    input.ParentTeamID,
+	}
+	out := new(group)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertGroupTeam(out), res, scm.CheckUnsupportedFields(input, "Privacy")
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, org, team string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s", encode(org+"/"+team))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, org, team, user, role string) (*scm.Response, error) {
+	return s.addGroupMember(ctx, org+"/"+team, user, roleToAccessLevel(role))
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, org, team, user string) (*scm.Response, error) {
+	return s.removeGroupMember(ctx, org+"/"+team, user)
+}
+
+// AddTeamRepository shares the project with the team subgroup.
+func (s *organizationService) AddTeamRepository(ctx context.Context, org, team, repo, permission string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s", encode(org+"/"+team))
+	out := new(group)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	path = fmt.Sprintf("api/v4/projects/%s/share", encode(repo))
+	in := &shareInput{
+		GroupID:     out.ID,
+		GroupAccess: permissionToShareAccessLevel(permission),
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *organizationService) RemoveTeamRepository(ctx context.Context, org, team, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s", encode(org+"/"+team))
+	out := new(group)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	path = fmt.Sprintf("api/v4/projects/%s/share/%d", encode(repo), out.ID)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// InviteMember adds the user to the organization group, since
+// group membership does not need to be accepted.
+func (s *organizationService) InviteMember(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	return s.addGroupMember(ctx, org, user, roleToAccessLevel(role))
+}
+
+func (s *organizationService) RemoveMember(ctx context.Context, org, user string) (*scm.Response, error) {
+	return s.removeGroupMember(ctx, org, user)
+}
+
+func (s *organizationService) SetMemberRole(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	userData, res, err := s.client.Users.FindLogin(ctx, user)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v4/groups/%s/members/%d", encode(org), userData.ID)
+	in := &memberPermissions{
+		UserID:      userData.ID,
+		AccessLevel: roleToAccessLevel(role),
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *organizationService) ListOutsideCollaborators(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.TeamMember, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// helper function adds the user to the group, or updates the
+// access level if the user is already a member.
+func (s *organizationService) addGroupMember(ctx context.Context, group, user string, level int) (*scm.Response, error) {
+	userData, res, err := s.client.Users.FindLogin(ctx, user)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v4/groups/%s/members", encode(group))
+	in := &memberPermissions{
+		UserID:      userData.ID,
+		AccessLevel: level,
+	}
+	res, err = s.client.do(ctx, "POST", path, in, nil)
+	if res != nil && res.Status == 409 {
+		path = fmt.Sprintf("api/v4/groups/%s/members/%d", encode(group), userData.ID)
+		return s.client.do(ctx, "PUT", path, in, nil)
+	}
+	return res, err
+}
+
+func (s *organizationService) removeGroupMember(ctx context.Context, group, user string) (*scm.Response, error) {
+	userData, res, err := s.client.Users.FindLogin(ctx, user)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v4/groups/%s/members/%d", encode(group), userData.ID)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type group struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	Description string `json:"description"`
+	ParentID    int    `json:"parent_id"`
+}
+
+type groupInput struct {
+	Name        string `json:"name,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Description string `json:"description,omitempty"`
+	ParentID    int    `json:"parent_id,omitempty"`
+}
+
+type shareInput struct {
+	GroupID     int `json:"group_id"`
+	GroupAccess int `json:"group_access"`
+}
+
 type organization struct {
 	ID     int         `json:"id"`
 	Name   string      `json:"name"`
@@ -130,3 +323,39 @@ func convertOrganization(from *organization) *scm.Organization {
 		Avatar: from.Avatar.String,
 	}
 }
+
+func convertGroupTeam(from *group) *scm.Team {
+	return &scm.Team{
+		ID:           from.ID,
+		Name:         from.Name,
+		Slug:         from.Path,
+		Description:  from.Description,
+		ParentTeamID: from.ParentID,
+	}
+}
+
+// helper function returns the group access level of the
+// organization or team role.
+func roleToAccessLevel(role string) int {
+	switch role {
+	case scm.AdminRole:
+		return ownerPermissions
+	case scm.MaintainerRole:
+		return maintainerPermissions
+	default:
+		return developerPermissions
+	}
+}
+
+// helper function returns the access level a project grants a
+// shared group. Groups are granted reporter access to read.
+func permissionToShareAccessLevel(perm string) int {
+	switch perm {
+	case scm.AdminPermission:
+		return maintainerPermissions
+	case scm.WritePermission:
+		return developerPermissions
+	default:
+		return reporterPermissions
+	}
+}
@@ -79,3 +79,412 @@ func TestOrganizationList(t *testing.T) {
 	t.Run("Rate", testRate(res))
 	t.Run("Page", testPage(res))
 }
+
+func TestOrganizationHookList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/Twitter/hooks").
+		MatchParam("per_page", "30").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/hooks.json")
+
+	client := NewDefault()
+	got, res, err := client.Organizations.ListHooks(context.Background(), "Twitter", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Hook{}
+	raw, _ := ioutil.ReadFile("testdata/hooks.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestOrganizationHookCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/groups/Twitter/hooks").
+		MatchParam("url", "https://ci.example.com/hook").
+		MatchParam("token", "topsecret").
+		MatchParam("push_events", "true").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	in := &scm.HookInput{
+		Target: "https://ci.example.com/hook",
+		Secret: "topsecret",
+		Events: scm.HookEvents{Push: true},
+	}
+
+	client := NewDefault()
+	got, _, err := client.Organizations.CreateHook(context.Background(), "Twitter", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/groups/Twitter/hooks/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	client := NewDefault()
+	got, _, err := client.Organizations.UpdateHook(context.Background(), "Twitter", &scm.HookInput{ID: "1"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/groups/Twitter/hooks/1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Organizations.DeleteHook(context.Background(), "Twitter", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestTeamCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/twitter").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/group.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/groups").
+		JSON(map[string]interface{}{"name": "Frontend", "path": "frontend", "description": "The frontend team.", "parent_id": 4}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/subgroup.json")
+
+	in := &scm.Team{
+		Name:        "Frontend",
+		Slug:        "frontend",
+		Description: "The frontend team.",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Organizations.CreateTeam(context.Background(), "twitter", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/subgroup.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestTeamUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/groups/twitter/frontend").
+		JSON(map[string]interface{}{"description": "The frontend team."}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/subgroup.json")
+
+	client := NewDefault()
+	got, _, err := client.Organizations.UpdateTeam(context.Background(), "twitter", "frontend", &scm.Team{Description: "The frontend team."})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/subgroup.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestTeamUpdate_Privacy(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/groups/twitter/frontend").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/subgroup.json")
+
+	client := NewDefault()
+	_, _, err := client.Organizations.UpdateTeam(context.Background(), "twitter", "frontend", &scm.Team{Privacy: "closed"})
+	if _, ok := err.(*scm.UnsupportedFieldsError); !ok {
+		t.Errorf("Want unsupported fields error, got %v", err)
+	}
+}
+
+func TestTeamDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/groups/twitter/frontend").
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Organizations.DeleteTeam(context.Background(), "twitter", "frontend")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 202; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestTeamAddMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("search", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_search.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/groups/twitter/frontend/members").
+		JSON(map[string]int{"user_id": 1, "access_level": 40}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.AddTeamMember(context.Background(), "twitter", "frontend", "john_smith", scm.MaintainerRole)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTeamAddMemberExisting(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("search", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_search.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/groups/twitter/frontend/members").
+		Reply(409).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message": "Member already exists"}`)
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/groups/twitter/frontend/members/1").
+		JSON(map[string]int{"user_id": 1, "access_level": 30}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.AddTeamMember(context.Background(), "twitter", "frontend", "john_smith", scm.MemberRole)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestTeamRemoveMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("search", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_search.json")
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/groups/twitter/frontend/members/1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.RemoveTeamMember(context.Background(), "twitter", "frontend", "john_smith")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTeamAddRepository(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/twitter/frontend").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/subgroup.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/twitter/flight/share").
+		JSON(map[string]int{"group_id": 12, "group_access": 20}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.AddTeamRepository(context.Background(), "twitter", "frontend", "twitter/flight", scm.ReadPermission)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTeamRemoveRepository(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/twitter/frontend").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/subgroup.json")
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/twitter/flight/share/12").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.RemoveTeamRepository(context.Background(), "twitter", "frontend", "twitter/flight")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInviteMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("search", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_search.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/groups/twitter/members").
+		JSON(map[string]int{"user_id": 1, "access_level": 30}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.InviteMember(context.Background(), "twitter", "john_smith", scm.MemberRole)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMemberRole(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("search", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_search.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/groups/twitter/members/1").
+		JSON(map[string]int{"user_id": 1, "access_level": 50}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Organizations.SetMemberRole(context.Background(), "twitter", "john_smith", scm.AdminRole)
+	if err != nil {
+		t.Error(err)
+	}
+}
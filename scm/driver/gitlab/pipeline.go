@@ -0,0 +1,220 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type pipelineService struct {
+	client *wrapper
+}
+
+type pipeline struct {
+	ID             int       `json:"id"`
+	Iid            int       `json:"iid"`
+	Status         string    `json:"status"`
+	Ref            string    `json:"ref"`
+	Tag            bool      `json:"tag"`
+	Sha            string    `json:"sha"`
+	BeforeSha      string    `json:"before_sha"`
+	Source         string    `json:"source"`
+	WebURL         string    `json:"web_url"`
+	CreatedAt      time.Time `json:"created_at"`
+	FinishedAt     time.Time `json:"finished_at"`
+	Duration       float64   `json:"duration"`
+	QueuedDuration float64   `json:"queued_duration"`
+	DetailedStatus struct {
+		Text string `json:"text"`
+	} `json:"detailed_status"`
+}
+
+type pipelineJob struct {
+	ID             int       `json:"id"`
+	Name           string    `json:"name"`
+	Stage          string    `json:"stage"`
+	Status         string    `json:"status"`
+	Ref            string    `json:"ref"`
+	Tag            bool      `json:"tag"`
+	WebURL         string    `json:"web_url"`
+	AllowFailure   bool      `json:"allow_failure"`
+	FailureReason  string    `json:"failure_reason"`
+	CreatedAt      time.Time `json:"created_at"`
+	StartedAt      time.Time `json:"started_at"`
+	FinishedAt     time.Time `json:"finished_at"`
+	Duration       float64   `json:"duration"`
+	QueuedDuration float64   `json:"queued_duration"`
+	Pipeline       struct {
+		ID  int    `json:"id"`
+		Sha string `json:"sha"`
+	} `json:"pipeline"`
+	Runner *struct {
+		Description string `json:"description"`
+	} `json:"runner"`
+}
+
+type pipelineInput struct {
+	Ref       string              `json:"ref"`
+	Variables []*pipelineVariable `json:"variables,omitempty"`
+}
+
+type pipelineVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func (s *pipelineService) List(ctx context.Context, repo string, opts scm.PipelineListOptions) ([]*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipelines?%s", encode(repo), encodePipelineListOptions(opts))
+	out := []*pipeline{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertPipelineList(out), res, err
+}
+
+func (s *pipelineService) Find(ctx context.Context, repo string, id int) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipelines/%d", encode(repo), id)
+	out := new(pipeline)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertPipeline(out), res, err
+}
+
+func (s *pipelineService) Trigger(ctx context.Context, repo string, input *scm.PipelineInput) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipeline", encode(repo))
+	in := &pipelineInput{Ref: input.Ref}
+	for _, k := range sortedKeys(input.Variables) {
+		in.Variables = append(in.Variables, &pipelineVariable{
+			Key:   k,
+			Value: input.Variables[k],
+		})
+	}
+	out := new(pipeline)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertPipeline(out), res, err
+}
+
+func (s *pipelineService) Cancel(ctx context.Context, repo string, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipelines/%d/cancel", encode(repo), id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *pipelineService) Retry(ctx context.Context, repo string, id int) (*scm.Pipeline, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipelines/%d/retry", encode(repo), id)
+	out := new(pipeline)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertPipeline(out), res, err
+}
+
+func (s *pipelineService) ListJobs(ctx context.Context, repo string, id int, opts scm.ListOptions) ([]*scm.PipelineJob, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipelines/%d/jobs?%s", encode(repo), id, encodeListOptions(opts))
+	out := []*pipelineJob{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertPipelineJobList(out), res, err
+}
+
+func (s *pipelineService) JobLog(ctx context.Context, repo string, _, job int) ([]byte, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/jobs/%d/trace", encode(repo), job)
+	buf := new(bytes.Buffer)
+	res, err := s.client.do(ctx, "GET", path, nil, buf)
+	return buf.Bytes(), res, err
+}
+
+func encodePipelineListOptions(opts scm.PipelineListOptions) string {
+	params := url.Values{}
+	if opts.Ref != "" {
+		params.Set("ref", opts.Ref)
+	}
+	if opts.Sha != "" {
+		params.Set("sha", opts.Sha)
+	}
+	if opts.Status != "" {
+		params.Set("status", opts.Status)
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
+// helper function returns the map keys in sorted order
+// so that the request body is deterministic.
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func convertPipelineList(from []*pipeline) []*scm.Pipeline {
+	to := []*scm.Pipeline{}
+	for _, v := range from {
+		to = append(to, convertPipeline(v))
+	}
+	return to
+}
+
+func convertPipeline(from *pipeline) *scm.Pipeline {
+	return &scm.Pipeline{
+		ID:             from.ID,
+		Number:         from.Iid,
+		Status:         convertPipelineState(from.Status),
+		DetailedStatus: from.DetailedStatus.Text,
+		Ref:            from.Ref,
+		Tag:            from.Tag,
+		Sha:            from.Sha,
+		BeforeSha:      from.BeforeSha,
+		Source:         from.Source,
+		Link:           from.WebURL,
+		Created:        from.CreatedAt,
+		Finished:       from.FinishedAt,
+		Duration:       convertHookDuration(from.Duration),
+		QueuedDuration: convertHookDuration(from.QueuedDuration),
+	}
+}
+
+func convertPipelineJobList(from []*pipelineJob) []*scm.PipelineJob {
+	to := []*scm.PipelineJob{}
+	for _, v := range from {
+		to = append(to, convertPipelineJob(v))
+	}
+	return to
+}
+
+func convertPipelineJob(from *pipelineJob) *scm.PipelineJob {
+	to := &scm.PipelineJob{
+		ID:             from.ID,
+		PipelineID:     from.Pipeline.ID,
+		Name:           from.Name,
+		Stage:          from.Stage,
+		Status:         convertPipelineState(from.Status),
+		DetailedStatus: from.Status,
+		Ref:            from.Ref,
+		Tag:            from.Tag,
+		Sha:            from.Pipeline.Sha,
+		Link:           from.WebURL,
+		AllowFailure:   from.AllowFailure,
+		FailureReason:  from.FailureReason,
+		Created:        from.CreatedAt,
+		Started:        from.StartedAt,
+		Finished:       from.FinishedAt,
+		Duration:       convertHookDuration(from.Duration),
+		QueuedDuration: convertHookDuration(from.QueuedDuration),
+	}
+	if from.Runner != nil {
+		to.Runner = from.Runner.Description
+	}
+	return to
+}
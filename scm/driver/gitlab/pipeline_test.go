@@ -0,0 +1,233 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestPipelineFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/pipelines/46").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipeline.json")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.Find(context.Background(), "diaspora/diaspora", 46)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Pipeline)
+	raw, _ := ioutil.ReadFile("testdata/pipeline.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/pipelines").
+		MatchParam("ref", "new-pipeline").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/pipelines.json")
+
+	client := NewDefault()
+	opts := scm.PipelineListOptions{Ref: "new-pipeline", Page: 1, Size: 30}
+	got, res, err := client.Pipelines.List(context.Background(), "diaspora/diaspora", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Pipeline{}
+	raw, _ := ioutil.ReadFile("testdata/pipelines.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestPipelineTrigger(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/pipeline").
+		JSON(map[string]interface{}{
+			"ref": "main",
+			"variables": []map[string]string{
+				{"key": "DEPLOY", "value": "true"},
+				{"key": "VERSION", "value": "1.0.0"},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipeline.json")
+
+	input := &scm.PipelineInput{
+		Ref: "main",
+		Variables: map[string]string{
+			"VERSION": "1.0.0",
+			"DEPLOY":  "true",
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.Trigger(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Pipeline)
+	raw, _ := ioutil.ReadFile("testdata/pipeline.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineCancel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/pipelines/46/cancel").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipeline.json")
+
+	client := NewDefault()
+	res, err := client.Pipelines.Cancel(context.Background(), "diaspora/diaspora", 46)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineRetry(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/pipelines/46/retry").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipeline.json")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.Retry(context.Background(), "diaspora/diaspora", 46)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Pipeline)
+	raw, _ := ioutil.ReadFile("testdata/pipeline.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineListJobs(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/pipelines/46/jobs").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipeline_jobs.json")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.ListJobs(context.Background(), "diaspora/diaspora", 46, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PipelineJob{}
+	raw, _ := ioutil.ReadFile("testdata/pipeline_jobs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineJobLog(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/jobs/6/trace").
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		BodyString("Running with gitlab-runner\nJob succeeded\n")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.JobLog(context.Background(), "diaspora/diaspora", 46, 6)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if want := "Running with gitlab-runner\nJob succeeded\n"; string(got) != want {
+		t.Errorf("Want log %q, got %q", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
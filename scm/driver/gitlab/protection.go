@@ -0,0 +1,331 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// gitlab access levels used by protected branches.
+const (
+	noAccess        = 0
+	developerAccess = 30
+)
+
+type branchProtectionService struct {
+	client *wrapper
+}
+
+type protectedBranch struct {
+	ID                        int             `json:"id"`
+	Name                      string          `json:"name"`
+	PushAccessLevels          []*branchAccess `json:"push_access_levels"`
+	MergeAccessLevels         []*branchAccess `json:"merge_access_levels"`
+	AllowForcePush            bool            `json:"allow_force_push"`
+	CodeOwnerApprovalRequired bool            `json:"code_owner_approval_required"`
+}
+
+type branchAccess struct {
+	ID          int `json:"id"`
+	AccessLevel int `json:"access_level"`
+	UserID      int `json:"user_id"`
+	GroupID     int `json:"group_id"`
+}
+
+type protectedBranchInput struct {
+	Name                      string               `json:"name"`
+	PushAccessLevel           int                  `json:"push_access_level"`
+	MergeAccessLevel          int                  `json:"merge_access_level"`
+	AllowForcePush            bool                 `json:"allow_force_push"`
+	CodeOwnerApprovalRequired bool                 `json:"code_owner_approval_required"`
+	AllowedToPush             []*branchAccessInput `json:"allowed_to_push,omitempty"`
+	AllowedToMerge            []*branchAccessInput `json:"allowed_to_merge,omitempty"`
+}
+
+type branchAccessInput struct {
+	ID          int  `json:"id,omitempty"`
+	AccessLevel *int `json:"access_level,omitempty"`
+	UserID      int  `json:"user_id,omitempty"`
+	GroupID     int  `json:"group_id,omitempty"`
+	Destroy     bool `json:"_destroy,omitempty"`
+}
+
+type protectedBranchPatch struct {
+	AllowForcePush            bool                 `json:"allow_force_push"`
+	CodeOwnerApprovalRequired bool                 `json:"code_owner_approval_required"`
+	AllowedToPush             []*branchAccessInput `json:"allowed_to_push,omitempty"`
+	AllowedToMerge            []*branchAccessInput `json:"allowed_to_merge,omitempty"`
+}
+
+type approvalRule struct {
+	ID                int                `json:"id"`
+	Name              string             `json:"name"`
+	ApprovalsRequired int                `json:"approvals_required"`
+	ProtectedBranches []*protectedBranch `json:"protected_branches"`
+}
+
+type approvalRuleInput struct {
+	Name               string `json:"name"`
+	ApprovalsRequired  int    `json:"approvals_required"`
+	ProtectedBranchIDs []int  `json:"protected_branch_ids"`
+}
+
+type groupRef struct {
+	ID       int    `json:"id"`
+	FullPath string `json:"full_path"`
+}
+
+func (s *branchProtectionService) Find(ctx context.Context, repo, branch string) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/protected_branches/%s", encode(repo), url.PathEscape(branch))
+	out := new(protectedBranch)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := &scm.BranchProtection{
+		Branch:                 out.Name,
+		RequireCodeOwnerReview: out.CodeOwnerApprovalRequired,
+		AllowForcePush:         out.AllowForcePush,
+	}
+	if to.Push, err = s.convertAccess(ctx, out.PushAccessLevels); err != nil {
+		return nil, res, err
+	}
+	if to.Merge, err = s.convertAccess(ctx, out.MergeAccessLevels); err != nil {
+		return nil, res, err
+	}
+	rule, _, res, err := s.findRule(ctx, repo, branch)
+	if err != nil {
+		return nil, res, err
+	}
+	if rule != nil {
+		to.RequiredApprovals = rule.ApprovalsRequired
+	}
+	return to, res, nil
+}
+
+// Set protects the branch, or updates the existing protection
+// in place, and replaces the approval rule of the branch. The
+// approval rule is named after the branch. Approval rules are
+// not available on GitLab Free, where RequiredApprovals is
+// reported as unsupported.
+func (s *branchProtectionService) Set(ctx context.Context, repo string, input *scm.BranchProtection) (*scm.BranchProtection, *scm.Response, error) {
+	rule, approvals, res, err := s.findRule(ctx, repo, input.Branch)
+	if err != nil {
+		return nil, res, err
+	}
+	in := &protectedBranchInput{
+		Name:                      input.Branch,
+		PushAccessLevel:           developerAccess,
+		MergeAccessLevel:          developerAccess,
+		AllowForcePush:            input.AllowForcePush,
+		CodeOwnerApprovalRequired: input.RequireCodeOwnerReview,
+	}
+	if input.Push != nil {
+		in.PushAccessLevel = noAccess
+		if in.AllowedToPush, err = s.resolveAccess(ctx, input.Push); err != nil {
+			return nil, nil, err
+		}
+	}
+	if input.Merge != nil {
+		in.MergeAccessLevel = noAccess
+		if in.AllowedToMerge, err = s.resolveAccess(ctx, input.Merge); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	path := fmt.Sprintf("api/v4/projects/%s/protected_branches/%s", encode(repo), url.PathEscape(input.Branch))
+	out := new(protectedBranch)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	switch {
+	case res != nil && res.Status == 404:
+		path = fmt.Sprintf("api/v4/projects/%s/protected_branches", encode(repo))
+		res, err = s.client.do(ctx, "POST", path, in, out)
+	case err == nil:
+		patch := &protectedBranchPatch{
+			AllowForcePush:            in.AllowForcePush,
+			CodeOwnerApprovalRequired: in.CodeOwnerApprovalRequired,
+			AllowedToPush:             replaceAccess(out.PushAccessLevels, in.PushAccessLevel, in.AllowedToPush),
+			AllowedToMerge:            replaceAccess(out.MergeAccessLevels, in.MergeAccessLevel, in.AllowedToMerge),
+		}
+		out = new(protectedBranch)
+		res, err = s.client.do(ctx, "PATCH", path, patch, out)
+	}
+	if err != nil {
+		return nil, res, err
+	}
+
+	unsupported := []string{
+		"RequiredStatusChecks",
+		"StrictStatusChecks",
+		"DismissStaleReviews",
+		"AllowDeletion",
+		"RequireLinearHistory",
+	}
+	if !approvals {
+		unsupported = append(unsupported, "RequiredApprovals")
+	}
+
+	ruleIn := &approvalRuleInput{
+		Name:               input.Branch,
+		ApprovalsRequired:  input.RequiredApprovals,
+		ProtectedBranchIDs: []int{out.ID},
+	}
+	switch {
+	case !approvals:
+	case rule != nil && input.RequiredApprovals == 0:
+		path = fmt.Sprintf("api/v4/projects/%s/approval_rules/%d", encode(repo), rule.ID)
+		res, err = s.client.do(ctx, "DELETE", path, nil, nil)
+	case rule != nil:
+		ruleIn.Name = rule.Name
+		path = fmt.Sprintf("api/v4/projects/%s/approval_rules/%d", encode(repo), rule.ID)
+		res, err = s.client.do(ctx, "PUT", path, ruleIn, nil)
+	case input.RequiredApprovals > 0:
+		path = fmt.Sprintf("api/v4/projects/%s/approval_rules", encode(repo))
+		res, err = s.client.do(ctx, "POST", path, ruleIn, nil)
+	}
+	if err != nil {
+		return nil, res, err
+	}
+
+	to := &scm.BranchProtection{
+		Branch:                 out.Name,
+		RequireCodeOwnerReview: out.CodeOwnerApprovalRequired,
+		Push:                   input.Push,
+		Merge:                  input.Merge,
+		AllowForcePush:         out.AllowForcePush,
+	}
+	if approvals {
+		to.RequiredApprovals = input.RequiredApprovals
+	}
+	return to, res, scm.CheckUnsupportedFields(input, unsupported...)
+}
+
+func (s *branchProtectionService) Delete(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	rule, _, res, err := s.findRule(ctx, repo, branch)
+	if err != nil {
+		return res, err
+	}
+	if rule != nil {
+		path := fmt.Sprintf("api/v4/projects/%s/approval_rules/%d", encode(repo), rule.ID)
+		if res, err := s.client.do(ctx, "DELETE", path, nil, nil); err != nil {
+			return res, err
+		}
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/protected_branches/%s", encode(repo), url.PathEscape(branch))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function returns the approval rule that applies to
+// the protected branch, or nil if there is none. Approval rules
+// are a premium feature, and are reported as not available if
+// the api is forbidden or not found.
+func (s *branchProtectionService) findRule(ctx context.Context, repo, branch string) (*approvalRule, bool, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/approval_rules", encode(repo))
+	out := []*approvalRule{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if res != nil && (res.Status == 403 || res.Status == 404) {
+		return nil, false, res, nil
+	}
+	if err != nil {
+		return nil, false, res, err
+	}
+	for _, rule := range out {
+		for _, b := range rule.ProtectedBranches {
+			if b.Name == branch {
+				return rule, true, res, nil
+			}
+		}
+	}
+	return nil, true, res, nil
+}
+
+// helper function returns the access changes that replace the
+// existing access levels of a protected branch with the access
+// level and the users and groups, as they are created.
+func replaceAccess(from []*branchAccess, level int, access []*branchAccessInput) []*branchAccessInput {
+	want := append([]*branchAccessInput{{AccessLevel: &level}}, access...)
+	var to []*branchAccessInput
+	for _, existing := range from {
+		keep := false
+		for _, w := range want {
+			keep = keep || sameAccess(w, existing)
+		}
+		if !keep {
+			to = append(to, &branchAccessInput{ID: existing.ID, Destroy: true})
+		}
+	}
+	for _, w := range want {
+		found := false
+		for _, existing := range from {
+			found = found || sameAccess(w, existing)
+		}
+		if !found {
+			to = append(to, w)
+		}
+	}
+	return to
+}
+
+func sameAccess(a *branchAccessInput, existing *branchAccess) bool {
+	if a.UserID != 0 || a.GroupID != 0 {
+		return a.UserID == existing.UserID && a.GroupID == existing.GroupID
+	}
+	return existing.UserID == 0 && existing.GroupID == 0 && *a.AccessLevel == existing.AccessLevel
+}
+
+// helper function resolves the user and group ids of the
+// branch restrictions.
+func (s *branchProtectionService) resolveAccess(ctx context.Context, from *scm.BranchRestrictions) ([]*branchAccessInput, error) {
+	users := &userService{s.client}
+	var to []*branchAccessInput
+	for _, login := range from.Users {
+		user, _, err := users.FindLogin(ctx, login)
+		if err != nil {
+			return nil, err
+		}
+		to = append(to, &branchAccessInput{UserID: user.ID})
+	}
+	for _, team := range from.Teams {
+		group := new(groupRef)
+		path := fmt.Sprintf("api/v4/groups/%s", encode(team))
+		if _, err := s.client.do(ctx, "GET", path, nil, group); err != nil {
+			return nil, err
+		}
+		to = append(to, &branchAccessInput{GroupID: group.ID})
+	}
+	return to, nil
+}
+
+// helper function converts the branch access levels to branch
+// restrictions. Access granted to developers is treated as
+// unrestricted.
+func (s *branchProtectionService) convertAccess(ctx context.Context, from []*branchAccess) (*scm.BranchRestrictions, error) {
+	users := &userService{s.client}
+	to := &scm.BranchRestrictions{}
+	for _, access := range from {
+		switch {
+		case access.UserID != 0:
+			user, err := users.FindLoginByID(ctx, access.UserID)
+			if err != nil {
+				return nil, err
+			}
+			to.Users = append(to.Users, user.Login)
+		case access.GroupID != 0:
+			group := new(groupRef)
+			path := fmt.Sprintf("api/v4/groups/%d", access.GroupID)
+			if _, err := s.client.do(ctx, "GET", path, nil, group); err != nil {
+				return nil, err
+			}
+			to.Teams = append(to.Teams, group.FullPath)
+		case access.AccessLevel != noAccess && access.AccessLevel <= developerAccess:
+			return nil, nil
+		}
+	}
+	return to, nil
+}
@@ -0,0 +1,311 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestBranchProtectionFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/4").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/group.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/approval_rules").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approval_rules.json")
+
+	client := NewDefault()
+	got, res, err := client.BranchProtections.Find(context.Background(), "diaspora/diaspora", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/protected_branch.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestBranchProtectionSet(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/approval_rules").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approval_rules.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/twitter").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/group.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message": "404 Not found"}`)
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/protected_branches").
+		JSON(map[string]interface{}{
+			"name":                         "master",
+			"push_access_level":            0,
+			"merge_access_level":           30,
+			"allow_force_push":             false,
+			"code_owner_approval_required": true,
+			"allowed_to_push": []map[string]int{
+				{"group_id": 4},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/approval_rules/2").
+		JSON(map[string]interface{}{
+			"name":                 "master",
+			"approvals_required":   1,
+			"protected_branch_ids": []int{1},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.BranchProtection{
+		Branch:                 "master",
+		RequiredStatusChecks:   []string{"ci/build"},
+		RequiredApprovals:      1,
+		RequireCodeOwnerReview: true,
+		Push:                   &scm.BranchRestrictions{Teams: []string{"twitter"}},
+		RequireLinearHistory:   true,
+	}
+
+	client := NewDefault()
+	got, _, err := client.BranchProtections.Set(context.Background(), "diaspora/diaspora", input)
+	unsupported, ok := err.(*scm.UnsupportedFieldsError)
+	if !ok {
+		t.Errorf("Expect UnsupportedFieldsError, got %v", err)
+		return
+	}
+	if diff := cmp.Diff(unsupported.Fields, []string{"RequiredStatusChecks", "RequireLinearHistory"}); diff != "" {
+		t.Errorf("Unexpected unsupported fields")
+		t.Log(diff)
+	}
+	if got == nil || got.RequiredApprovals != 1 {
+		t.Errorf("Expect supported fields applied")
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestBranchProtectionSetExisting(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/approval_rules").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approval_rules.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/twitter").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/group.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	gock.New("https://gitlab.com").
+		Patch("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		JSON(map[string]interface{}{
+			"allow_force_push":             false,
+			"code_owner_approval_required": true,
+			"allowed_to_push": []map[string]interface{}{
+				{"id": 1, "_destroy": true},
+				{"access_level": 0},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/approval_rules/2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.BranchProtection{
+		Branch:                 "master",
+		RequiredApprovals:      1,
+		RequireCodeOwnerReview: true,
+		Push:                   &scm.BranchRestrictions{Teams: []string{"twitter"}},
+	}
+
+	client := NewDefault()
+	_, _, err := client.BranchProtections.Set(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestBranchProtectionSetWithoutApprovals(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/approval_rules").
+		Reply(403).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message": "403 Forbidden"}`)
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message": "404 Not found"}`)
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/protected_branches").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	input := &scm.BranchProtection{
+		Branch:            "master",
+		RequiredApprovals: 2,
+	}
+
+	client := NewDefault()
+	got, _, err := client.BranchProtections.Set(context.Background(), "diaspora/diaspora", input)
+	unsupported, ok := err.(*scm.UnsupportedFieldsError)
+	if !ok {
+		t.Fatalf("Expect UnsupportedFieldsError, got %v", err)
+	}
+	if diff := cmp.Diff(unsupported.Fields, []string{"RequiredApprovals"}); diff != "" {
+		t.Errorf("Unexpected unsupported fields")
+		t.Log(diff)
+	}
+	if got == nil || got.RequiredApprovals != 0 {
+		t.Errorf("Expect no required approvals")
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestBranchProtectionDeleteWithoutApprovals(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/approval_rules").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message": "404 Not found"}`)
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	if _, err := client.BranchProtections.Delete(context.Background(), "diaspora/diaspora", "master"); err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestBranchProtectionDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/approval_rules").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approval_rules.json")
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/approval_rules/2").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.BranchProtections.Delete(context.Background(), "diaspora/diaspora", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
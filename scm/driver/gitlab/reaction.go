@@ -0,0 +1,194 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type awardEmoji struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	User      user      `json:"user"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (s *issueService) ListReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d/award_emoji", encode(repo), number)
+	return listAwardEmoji(ctx, s.client, path, opts)
+}
+
+func (s *issueService) CreateReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d/award_emoji", encode(repo), number)
+	return createAwardEmoji(ctx, s.client, path, content)
+}
+
+func (s *issueService) DeleteReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d/award_emoji", encode(repo), number)
+	return deleteAwardEmoji(ctx, s.client, path, content)
+}
+
+func (s *issueService) ListCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d/notes/%d/award_emoji", encode(repo), number, id)
+	return listAwardEmoji(ctx, s.client, path, opts)
+}
+
+func (s *issueService) CreateCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d/notes/%d/award_emoji", encode(repo), number, id)
+	return createAwardEmoji(ctx, s.client, path, content)
+}
+
+func (s *issueService) DeleteCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d/notes/%d/award_emoji", encode(repo), number, id)
+	return deleteAwardEmoji(ctx, s.client, path, content)
+}
+
+func (s *pullService) ListReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/award_emoji", encode(repo), number)
+	return listAwardEmoji(ctx, s.client, path, opts)
+}
+
+func (s *pullService) CreateReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/award_emoji", encode(repo), number)
+	return createAwardEmoji(ctx, s.client, path, content)
+}
+
+func (s *pullService) DeleteReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/award_emoji", encode(repo), number)
+	return deleteAwardEmoji(ctx, s.client, path, content)
+}
+
+func (s *pullService) ListCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d/award_emoji", encode(repo), number, id)
+	return listAwardEmoji(ctx, s.client, path, opts)
+}
+
+func (s *pullService) CreateCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d/award_emoji", encode(repo), number, id)
+	return createAwardEmoji(ctx, s.client, path, content)
+}
+
+func (s *pullService) DeleteCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d/award_emoji", encode(repo), number, id)
+	return deleteAwardEmoji(ctx, s.client, path, content)
+}
+
+func listAwardEmoji(ctx context.Context, client *wrapper, path string, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	path = fmt.Sprintf("%s?%s", path, encodeListOptions(opts))
+	out := []*awardEmoji{}
+	res, err := client.do(ctx, "GET", path, nil, &out)
+	return convertAwardEmojiList(out), res, err
+}
+
+func createAwardEmoji(ctx context.Context, client *wrapper, path string, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	in := url.Values{}
+	in.Set("name", convertReactionContent(content))
+	path = fmt.Sprintf("%s?%s", path, in.Encode())
+	out := new(awardEmoji)
+	res, err := client.do(ctx, "POST", path, nil, out)
+	return convertAwardEmoji(out), res, err
+}
+
+// helper function deletes the award emoji of the authenticated
+// user. Gitlab deletes award emoji by id, so the award emoji
+// are listed to find it.
+func deleteAwardEmoji(ctx context.Context, client *wrapper, path string, content scm.ReactionContent) (*scm.Response, error) {
+	self := new(user)
+	res, err := client.do(ctx, "GET", "api/v4/user", nil, self)
+	if err != nil {
+		return res, err
+	}
+	name := convertReactionContent(content)
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		out := []*awardEmoji{}
+		res, err = client.do(ctx, "GET", fmt.Sprintf("%s?%s", path, encodeListOptions(opts)), nil, &out)
+		if err != nil {
+			return res, err
+		}
+		for _, award := range out {
+			if award.Name == name && award.User.ID == self.ID {
+				return client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", path, award.ID), nil, nil)
+			}
+		}
+		if res.Page.Next == 0 {
+			return res, scm.ErrNotFound
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+func convertAwardEmojiList(from []*awardEmoji) []*scm.Reaction {
+	to := []*scm.Reaction{}
+	for _, v := range from {
+		to = append(to, convertAwardEmoji(v))
+	}
+	return to
+}
+
+func convertAwardEmoji(from *awardEmoji) *scm.Reaction {
+	return &scm.Reaction{
+		ID:      from.ID,
+		Content: convertAwardEmojiName(from.Name),
+		Author:  *convertUser(&from.User),
+		Created: from.CreatedAt,
+	}
+}
+
+// helper function returns the award emoji name of the
+// reaction content.
+func convertReactionContent(from scm.ReactionContent) string {
+	switch from {
+	case scm.ReactionPlusOne:
+		return "thumbsup"
+	case scm.ReactionMinusOne:
+		return "thumbsdown"
+	case scm.ReactionLaugh:
+		return "laughing"
+	case scm.ReactionConfused:
+		return "confused"
+	case scm.ReactionHeart:
+		return "heart"
+	case scm.ReactionHooray:
+		return "tada"
+	case scm.ReactionRocket:
+		return "rocket"
+	case scm.ReactionEyes:
+		return "eyes"
+	default:
+		return ""
+	}
+}
+
+// helper function returns the reaction content of the award
+// emoji name. Award emoji without a matching reaction content
+// are unknown.
+func convertAwardEmojiName(from string) scm.ReactionContent {
+	switch from {
+	case "thumbsup":
+		return scm.ReactionPlusOne
+	case "thumbsdown":
+		return scm.ReactionMinusOne
+	case "laughing":
+		return scm.ReactionLaugh
+	case "confused":
+		return scm.ReactionConfused
+	case "heart":
+		return scm.ReactionHeart
+	case "tada":
+		return scm.ReactionHooray
+	case "rocket":
+		return scm.ReactionRocket
+	case "eyes":
+		return scm.ReactionEyes
+	default:
+		return scm.ReactionUnknown
+	}
+}
@@ -36,6 +36,7 @@ type repository struct {
 	PathNamespace string      `json:"path_with_namespace"`
 	DefaultBranch string      `json:"default_branch"`
 	Visibility    string      `json:"visibility"`
+	Archived      bool        `json:"archived"`
 	WebURL        string      `json:"web_url"`
 	SSHURL        string      `json:"ssh_url_to_repo"`
 	HTTPURL       string      `json:"http_url_to_repo"`
@@ -100,6 +101,7 @@ type hook struct {
 	MergeRequestsEvents   bool      `json:"merge_requests_events"`
 	TagPushEvents         bool      `json:"tag_push_events"`
 	NoteEvents            bool      `json:"note_events"`
+	ReleasesEvents        bool      `json:"releases_events"`
 	JobEvents             bool      `json:"job_events"`
 	PipelineEvents        bool      `json:"pipeline_events"`
 	WikiPageEvents        bool      `json:"wiki_page_events"`
@@ -114,6 +116,13 @@ type label struct {
 	Description string `json:"description"`
 }
 
+type labelInput struct {
+	Name        string `json:"name,omitempty"`
+	NewName     string `json:"new_name,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
 type member struct {
 	ID          int    `json:"id"`
 	Username    string `json:"username"`
@@ -132,6 +141,24 @@ type repositoryService struct {
 	client *wrapper
 }
 
+type projectSettings struct {
+	Name                         string    `json:"name,omitempty"`
+	Path                         string    `json:"path,omitempty"`
+	Description                  string    `json:"description,omitempty"`
+	Visibility                   string    `json:"visibility,omitempty"`
+	DefaultBranch                string    `json:"default_branch,omitempty"`
+	MergeMethod                  string    `json:"merge_method,omitempty"`
+	SquashOption                 string    `json:"squash_option,omitempty"`
+	RemoveSourceBranchAfterMerge *bool     `json:"remove_source_branch_after_merge,omitempty"`
+	IssuesEnabled                *bool     `json:"issues_enabled,omitempty"`
+	WikiEnabled                  *bool     `json:"wiki_enabled,omitempty"`
+	Topics                       *[]string `json:"topics,omitempty"`
+}
+
+type transferInput struct {
+	Namespace string `json:"namespace"`
+}
+
 type repositoryInput struct {
 	Name        string `json:"name"`
 	NamespaceID int    `json:"namespace_id"`
@@ -299,6 +326,35 @@ func (s *repositoryService) ListLabels(ctx context.Context, repo string, opts sc
 	return convertLabelObjects(out), res, err
 }
 
+func (s *repositoryService) CreateLabel(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels", encode(repo))
+	in := &labelInput{
+		Name:        input.Name,
+		Color:       convertLabelColor(input.Color),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *repositoryService) UpdateLabel(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels/%s", encode(repo), url.PathEscape(name))
+	in := &labelInput{
+		NewName:     input.Name,
+		Color:       convertLabelColor(input.Color),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *repositoryService) DeleteLabel(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels/%s", encode(repo), url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Repository, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
 	out := new(repository)
@@ -350,99 +406,36 @@ func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, op
 }
 
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	params := url.Values{}
-	params.Set("url", input.Target)
-	if input.Secret != "" {
-		params.Set("token", input.Secret)
-	}
-	if input.SkipVerify {
-		params.Set("enable_ssl_verification", "true")
-	}
-	hasStarEvents := false
-	for _, event := range input.NativeEvents {
-		if event == "*" {
-			hasStarEvents = true
-		}
-	}
-	if input.Events.Branch {
-		// no-op
-	}
-	if input.Events.Issue || hasStarEvents {
-		params.Set("issues_events", "true")
-	}
-	if input.Events.IssueComment ||
-		input.Events.PullRequestComment || hasStarEvents {
-		params.Set("note_events", "true")
-	}
-	if input.Events.PullRequest || hasStarEvents {
-		params.Set("merge_requests_events", "true")
-	}
-	if input.Events.Push || input.Events.Branch || hasStarEvents {
-		params.Set("push_events", "true")
-	}
-	if input.Events.Tag || hasStarEvents {
-		params.Set("tag_push_events", "true")
-	}
-	if input.Events.Release || hasStarEvents {
-		params.Set("releases_events", "true")
-	}
-
+	params := encodeHookInput(input)
 	path := fmt.Sprintf("api/v4/projects/%s/hooks?%s", encode(repo), params.Encode())
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, nil, out)
-	return convertHook(out), res, err
+	if err != nil {
+		return nil, res, err
+	}
+	return convertHook(out), res, checkHookEvents(input)
 }
 
 func (s *repositoryService) UpdateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	params := url.Values{}
-	hookID := input.Name
-	params.Set("url", input.Target)
-	if input.Secret != "" {
-		params.Set("token", input.Secret)
+	// the hook name was historically used to identify the
+	// hook, so fallback to the name if no id is provided.
+	hookID := input.ID
+	if hookID == "" {
+		hookID = input.Name
 	}
-	if input.SkipVerify {
-		params.Set("enable_ssl_verification", "true")
-	}
-	hasStarEvents := false
-	for _, event := range input.NativeEvents {
-		if event == "*" {
-			hasStarEvents = true
-		}
-	}
-	if input.Events.Branch {
-		// no-op
-	}
-	if input.Events.Issue || hasStarEvents {
-		params.Set("issues_events", "true")
-	} else {
-		params.Set("issues_events", "false")
-	}
-	if input.Events.IssueComment ||
-		input.Events.PullRequestComment || hasStarEvents {
-		params.Set("note_events", "true")
-	} else {
-		params.Set("note_events", "false")
-	}
-	if input.Events.PullRequest || hasStarEvents {
-		params.Set("merge_requests_events", "true")
-	} else {
-		params.Set("merge_requests_events", "false")
-	}
-	if input.Events.Push || input.Events.Branch || hasStarEvents {
-		params.Set("push_events", "true")
-	} else {
-		params.Set("push_events", "false")
-	}
-	if input.Events.Tag || hasStarEvents {
-		params.Set("tag_push_events", "true")
-	} else {
-		params.Set("tag_push_events", "false")
-	}
-
+	params := encodeHookInput(input)
 	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s?%s", encode(repo), hookID, params.Encode())
 	out := new(hook)
 	res, err := s.client.do(ctx, "PUT", path, nil, out)
-	return convertHook(out), res, err
+	if err != nil {
+		return nil, res, err
+	}
+	return convertHook(out), res, checkHookEvents(input)
+}
+
+func (s *repositoryService) EnsureHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.EnsureHookResult, *scm.Response, error) {
+	events := convertEvents(convertFromHookInput(input))
+	return scm.EnsureHook(ctx, s, repo, input, events)
 }
 
 func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
@@ -469,8 +462,82 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
-func (s *repositoryService) Delete(context.Context, string) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Update updates the project settings. Projects are unarchived
+// before, and archived after, the other settings are updated.
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositorySettings) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	if input.Archived != nil && !*input.Archived {
+		res, err := s.client.do(ctx, "POST", path+"/unarchive", nil, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	in := &projectSettings{
+		Description:                  input.Description,
+		Visibility:                   input.Visibility,
+		DefaultBranch:                input.DefaultBranch,
+		RemoveSourceBranchAfterMerge: input.DeleteBranchOnMerge,
+		IssuesEnabled:                input.HasIssues,
+		WikiEnabled:                  input.HasWiki,
+	}
+	if input.AllowMergeCommit != nil {
+		in.MergeMethod = "ff"
+		if *input.AllowMergeCommit {
+			in.MergeMethod = "merge"
+		}
+	}
+	if input.AllowSquashMerge != nil {
+		in.SquashOption = "never"
+		if *input.AllowSquashMerge {
+			in.SquashOption = "default_off"
+		}
+	}
+	if input.Topics != nil {
+		in.Topics = &input.Topics
+	}
+	out := new(repository)
+	var res *scm.Response
+	var err error
+	if *in == (projectSettings{}) {
+		res, err = s.client.do(ctx, "GET", path, nil, out)
+	} else {
+		res, err = s.client.do(ctx, "PUT", path, in, out)
+	}
+	if err != nil {
+		return nil, res, err
+	}
+	if input.Archived != nil && *input.Archived {
+		res, err = s.client.do(ctx, "POST", path+"/archive", nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	return convertRepository(out), res, scm.CheckUnsupportedFields(input, "Homepage", "AllowRebaseMerge")
+}
+
+// Rename changes both the name and the path of the project.
+func (s *repositoryService) Rename(ctx context.Context, repo, name string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	in := &projectSettings{
+		Name: name,
+		Path: name,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Transfer(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/transfer", encode(repo))
+	in := &transferInput{Namespace: namespace}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertRepository(out), res, err
 }
 
 // helper function to convert from the gogs repository list to
@@ -500,6 +567,7 @@ func convertRepository(from *repository) *scm.Repository {
 		FullName:  from.PathNamespace,
 		Branch:    from.DefaultBranch,
 		Private:   convertPrivate(from.Visibility),
+		Archived:  from.Archived,
 		Clone:     from.HTTPURL,
 		CloneSSH:  from.SSHURL,
 		Link:      from.WebURL,
@@ -564,6 +632,52 @@ func convertStatus(from *status) *scm.Status {
 	}
 }
 
+// helper function to convert the common hook input to the
+// gitlab hook structure.
+func convertFromHookInput(from *scm.HookInput) *hook {
+	hasStarEvents := false
+	for _, event := range from.NativeEvents {
+		if event == "*" {
+			hasStarEvents = true
+		}
+	}
+	return &hook{
+		URL:                   from.Target,
+		IssuesEvents:          from.Events.Issue || hasStarEvents,
+		NoteEvents:            from.Events.IssueComment || from.Events.PullRequestComment || hasStarEvents,
+		MergeRequestsEvents:   from.Events.PullRequest || hasStarEvents,
+		PushEvents:            from.Events.Push || from.Events.Branch || hasStarEvents,
+		TagPushEvents:         from.Events.Tag || hasStarEvents,
+		ReleasesEvents:        from.Events.Release || hasStarEvents,
+		EnableSslVerification: !from.SkipVerify,
+	}
+}
+
+// helper function reports the hook events that gitlab project
+// hooks do not send.
+func checkHookEvents(input *scm.HookInput) error {
+	return scm.CheckUnsupportedFields(&input.Events, "Fork", "Repository", "Status")
+}
+
+// helper function to encode the common hook input as gitlab
+// hook query parameters.
+func encodeHookInput(from *scm.HookInput) url.Values {
+	in := convertFromHookInput(from)
+	params := url.Values{}
+	params.Set("url", in.URL)
+	if from.Secret != "" {
+		params.Set("token", from.Secret)
+	}
+	params.Set("enable_ssl_verification", strconv.FormatBool(in.EnableSslVerification))
+	params.Set("issues_events", strconv.FormatBool(in.IssuesEvents))
+	params.Set("note_events", strconv.FormatBool(in.NoteEvents))
+	params.Set("merge_requests_events", strconv.FormatBool(in.MergeRequestsEvents))
+	params.Set("push_events", strconv.FormatBool(in.PushEvents))
+	params.Set("tag_push_events", strconv.FormatBool(in.TagPushEvents))
+	params.Set("releases_events", strconv.FormatBool(in.ReleasesEvents))
+	return params
+}
+
 func convertEvents(from *hook) []string {
 	var events []string
 	if from.IssuesEvents {
@@ -581,6 +695,9 @@ func convertEvents(from *hook) []string {
 	if from.MergeRequestsEvents {
 		events = append(events, "merge")
 	}
+	if from.ReleasesEvents {
+		events = append(events, "releases")
+	}
 	return events
 }
 
@@ -642,6 +759,16 @@ func convertLabel(from *label) *scm.Label {
 	}
 }
 
+// helper function returns the label color with the leading #
+// required by gitlab for hex codes. Color names are returned
+// unchanged.
+func convertLabelColor(from string) string {
+	if _, err := strconv.ParseUint(from, 16, 32); err != nil {
+		return from
+	}
+	return "#" + from
+}
+
 func canPush(proj *repository) bool {
 	switch {
 	case proj.Permissions.ProjectAccess.AccessLevel >= 30:
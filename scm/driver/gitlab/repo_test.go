@@ -514,7 +514,7 @@ func TestRepositoryHookCreate(t *testing.T) {
 
 	gock.New("https://gitlab.com").
 		Post("/api/v4/projects/diaspora/diaspora/hooks").
-		MatchParam("enable_ssl_verification", "true").
+		MatchParam("enable_ssl_verification", "false").
 		MatchParam("token", "topsecret").
 		MatchParam("url", "https://ci.example.com/hook").
 		Reply(201).
@@ -549,6 +549,34 @@ func TestRepositoryHookCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryHookCreateVerify(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/hooks").
+		MatchParam("enable_ssl_verification", "true").
+		MatchParam("url", "https://ci.example.com/hook").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	in := &scm.HookInput{
+		Name:       "drone",
+		Target:     "https://ci.example.com/hook",
+		SkipVerify: false,
+	}
+
+	client := NewDefault()
+	_, _, err := client.Repositories.CreateHook(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestRepositoryHookUpdate(t *testing.T) {
 	defer gock.Off()
 
@@ -585,6 +613,104 @@ func TestRepositoryHookUpdate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryHookEnsure(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/hooks").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hooks.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/hooks/1").
+		MatchParam("releases_events", "true").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	in := &scm.HookInput{
+		Target: "http://example.com/hook",
+		Events: scm.HookEvents{
+			Issue:        true,
+			IssueComment: true,
+			PullRequest:  true,
+			Push:         true,
+			Tag:          true,
+		},
+	}
+
+	client := NewDefault()
+	got, _, err := client.Repositories.EnsureHook(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Changed() {
+		t.Errorf("Want unchanged hook, got %+v", got)
+	}
+
+	in.Events.Release = true
+	got, _, err = client.Repositories.EnsureHook(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got.Updated || got.Created || len(got.Deleted) != 0 {
+		t.Errorf("Want updated hook, got %+v", got)
+	}
+}
+
+func TestRepositoryHookEnsureUnsupportedEvents(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/hooks").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hooks.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/hooks/1").
+		MatchParam("releases_events", "true").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	in := &scm.HookInput{
+		Target: "http://example.com/hook",
+		Events: scm.HookEvents{
+			Fork:         true,
+			Issue:        true,
+			IssueComment: true,
+			PullRequest:  true,
+			Push:         true,
+			Release:      true,
+			Status:       true,
+			Tag:          true,
+		},
+	}
+
+	client := NewDefault()
+	got, _, err := client.Repositories.EnsureHook(context.Background(), "diaspora/diaspora", in)
+	fields, ok := err.(*scm.UnsupportedFieldsError)
+	if !ok {
+		t.Fatalf("Expect UnsupportedFieldsError, got %v", err)
+	}
+	if diff := cmp.Diff([]string{"Fork", "Status"}, fields.Fields); diff != "" {
+		t.Errorf("Unexpected unsupported fields")
+		t.Log(diff)
+	}
+	if got == nil || !got.Updated {
+		t.Errorf("Want updated hook, got %+v", got)
+	}
+}
+
 func TestRepositoryFindUserPermission(t *testing.T) {
 	defer gock.Off()
 
@@ -857,3 +983,247 @@ func TestCanAdmin(t *testing.T) {
 		}
 	}
 }
+
+func TestRepositoryCreateLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/labels").
+		JSON(map[string]string{
+			"name":        "kind/bug",
+			"color":       "#d73a4a",
+			"description": "Something isn't working",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name:        "kind/bug",
+		Color:       "d73a4a",
+		Description: "Something isn't working",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.CreateLabel(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryUpdateLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/labels/bug").
+		JSON(map[string]string{
+			"new_name": "kind/bug",
+			"color":    "#d73a4a",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name:  "kind/bug",
+		Color: "#d73a4a",
+	}
+
+	client := NewDefault()
+	got, _, err := client.Repositories.UpdateLabel(context.Background(), "diaspora/diaspora", "bug", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryDeleteLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/labels/bug").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Repositories.DeleteLabel(context.Background(), "diaspora/diaspora", "bug")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora").
+		JSON(map[string]interface{}{"visibility": "internal", "merge_method": "ff", "squash_option": "default_off", "topics": []string{}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/archive").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	archived, mergeCommit, squash := true, false, true
+	in := &scm.RepositorySettings{
+		Visibility:       scm.VisibilityInternal,
+		Archived:         &archived,
+		AllowMergeCommit: &mergeCommit,
+		AllowSquashMerge: &squash,
+		Topics:           []string{},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Update(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryUpdate_Unsupported(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/unarchive").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	archived := false
+	in := &scm.RepositorySettings{
+		Homepage: "https://example.com",
+		Archived: &archived,
+	}
+
+	client := NewDefault()
+	got, _, err := client.Repositories.Update(context.Background(), "diaspora/diaspora", in)
+	unsupported, ok := err.(*scm.UnsupportedFieldsError)
+	if !ok {
+		t.Errorf("Want unsupported fields error, got %v", err)
+		return
+	}
+	if diff := cmp.Diff(unsupported.Fields, []string{"Homepage"}); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if got == nil {
+		t.Errorf("Want repository, got nil")
+	}
+}
+
+func TestRepositoryRename(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/client").
+		JSON(map[string]string{"name": "diaspora", "path": "diaspora"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, _, err := client.Repositories.Rename(context.Background(), "diaspora/client", "diaspora")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.FullName, "diaspora/diaspora"; got != want {
+		t.Errorf("Want repository %s, got %s", want, got)
+	}
+}
+
+func TestRepositoryTransfer(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/brightbox/diaspora/transfer").
+		JSON(map[string]string{"namespace": "diaspora"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, _, err := client.Repositories.Transfer(context.Background(), "brightbox/diaspora", "diaspora")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.FullName, "diaspora/diaspora"; got != want {
+		t.Errorf("Want repository %s, got %s", want, got)
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora").
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.Delete(context.Background(), "diaspora/diaspora")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 202; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// secretService manages gitlab ci/cd variables. Repository
+// variables are the project variables available to all
+// environments, and environment variables are the project
+// variables scoped to the environment.
+type secretService struct {
+	client *wrapper
+}
+
+type variable struct {
+	Key              string `json:"key"`
+	Masked           bool   `json:"masked"`
+	Protected        bool   `json:"protected"`
+	EnvironmentScope string `json:"environment_scope"`
+}
+
+type variableInput struct {
+	Key              string `json:"key,omitempty"`
+	Value            string `json:"value"`
+	Masked           bool   `json:"masked"`
+	Protected        bool   `json:"protected"`
+	EnvironmentScope string `json:"environment_scope,omitempty"`
+}
+
+func (s *secretService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return s.listProject(ctx, repo, "*", opts)
+}
+
+func (s *secretService) Set(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	return s.setProject(ctx, repo, "*", input)
+}
+
+func (s *secretService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	return s.deleteProject(ctx, repo, "*", name)
+}
+
+func (s *secretService) ListEnvironment(ctx context.Context, repo, env string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return s.listProject(ctx, repo, env, opts)
+}
+
+func (s *secretService) SetEnvironment(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	return s.setProject(ctx, repo, env, input)
+}
+
+func (s *secretService) DeleteEnvironment(ctx context.Context, repo, env, name string) (*scm.Response, error) {
+	return s.deleteProject(ctx, repo, env, name)
+}
+
+func (s *secretService) ListOrganization(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/variables?%s", encode(org), encodeListOptions(opts))
+	out := []*variable{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertVariableList(out, ""), res, err
+}
+
+func (s *secretService) SetOrganization(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/variables", encode(org))
+	return s.set(ctx, path, "", "", input)
+}
+
+func (s *secretService) DeleteOrganization(ctx context.Context, org, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/variables/%s", encode(org), name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function returns the project variables with the
+// environment scope. Variables are filtered client side, so
+// a page may hold fewer variables than the page size.
+func (s *secretService) listProject(ctx context.Context, repo, scope string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/variables?%s", encode(repo), encodeListOptions(opts))
+	out := []*variable{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	var scoped []*variable
+	for _, v := range out {
+		if v.EnvironmentScope == scope {
+			scoped = append(scoped, v)
+		}
+	}
+	env := scope
+	if scope == "*" {
+		env = ""
+	}
+	return convertVariableList(scoped, env), res, nil
+}
+
+func (s *secretService) setProject(ctx context.Context, repo, scope string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/variables", encode(repo))
+	return s.set(ctx, path, scope, encodeScopeFilter(scope), input)
+}
+
+func (s *secretService) deleteProject(ctx context.Context, repo, scope, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/variables/%s?%s", encode(repo), name, encodeScopeFilter(scope))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function updates the variable, or creates it if
+// it does not exist.
+func (s *secretService) set(ctx context.Context, path, scope, filter string, input *scm.SecretInput) (*scm.Response, error) {
+	in := &variableInput{
+		Value:            input.Value,
+		Masked:           input.Masked,
+		Protected:        input.Protected,
+		EnvironmentScope: scope,
+	}
+	update := fmt.Sprintf("%s/%s", path, input.Name)
+	if filter != "" {
+		update = fmt.Sprintf("%s?%s", update, filter)
+	}
+	res, err := s.client.do(ctx, "PUT", update, in, nil)
+	if res != nil && res.Status == http.StatusNotFound {
+		in.Key = input.Name
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+	}
+	if err != nil {
+		return res, err
+	}
+	return res, scm.CheckUnsupportedFields(input, "Visibility", "SelectedRepositoryIDs")
+}
+
+func encodeScopeFilter(scope string) string {
+	params := url.Values{}
+	params.Set("filter[environment_scope]", scope)
+	return params.Encode()
+}
+
+func convertVariableList(from []*variable, env string) []*scm.Secret {
+	to := []*scm.Secret{}
+	for _, v := range from {
+		to = append(to, &scm.Secret{
+			Name:        v.Key,
+			Environment: env,
+			Masked:      v.Masked,
+			Protected:   v.Protected,
+		})
+	}
+	return to
+}
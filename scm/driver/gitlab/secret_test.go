@@ -0,0 +1,130 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestSecretListEnvironment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/variables").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/variables.json")
+
+	client := NewDefault()
+	got, res, err := client.Secrets.ListEnvironment(context.Background(), "diaspora/diaspora", "production", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/variables.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestSecretSet_Update(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/variables/DEPLOY_TOKEN").
+		MatchParam("filter[environment_scope]", "production").
+		JSON(map[string]interface{}{
+			"value":             "s3cr3t",
+			"masked":            true,
+			"protected":         true,
+			"environment_scope": "production",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.SecretInput{
+		Name:      "DEPLOY_TOKEN",
+		Value:     "s3cr3t",
+		Masked:    true,
+		Protected: true,
+	}
+
+	client := NewDefault()
+	_, err := client.Secrets.SetEnvironment(context.Background(), "diaspora/diaspora", "production", input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSecretSet_Create(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/variables/DEPLOY_TOKEN").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"404 Variable Not Found"}`)
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/variables").
+		JSON(map[string]interface{}{
+			"key":               "DEPLOY_TOKEN",
+			"value":             "s3cr3t",
+			"masked":            false,
+			"protected":         false,
+			"environment_scope": "*",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.SecretInput{
+		Name:  "DEPLOY_TOKEN",
+		Value: "s3cr3t",
+	}
+
+	client := NewDefault()
+	_, err := client.Secrets.Set(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSecretDeleteOrganization(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/groups/diaspora/variables/DEPLOY_TOKEN").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Secrets.DeleteOrganization(context.Background(), "diaspora", "DEPLOY_TOKEN")
+	if err != nil {
+		t.Error(err)
+	}
+}
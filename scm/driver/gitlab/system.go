@@ -0,0 +1,119 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type systemHookService struct {
+	client *wrapper
+}
+
+type systemHook struct {
+	ID                     int       `json:"id"`
+	URL                    string    `json:"url"`
+	PushEvents             bool      `json:"push_events"`
+	TagPushEvents          bool      `json:"tag_push_events"`
+	MergeRequestsEvents    bool      `json:"merge_requests_events"`
+	RepositoryUpdateEvents bool      `json:"repository_update_events"`
+	EnableSslVerification  bool      `json:"enable_ssl_verification"`
+	CreatedAt              time.Time `json:"created_at"`
+}
+
+func (s *systemHookService) Find(ctx context.Context, id string) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/hooks/%s", id)
+	out := new(systemHook)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertSystemHook(out), res, err
+}
+
+func (s *systemHookService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/hooks?%s", encodeListOptions(opts))
+	out := []*systemHook{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertSystemHookList(out), res, err
+}
+
+func (s *systemHookService) Create(ctx context.Context, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	params := encodeSystemHookInput(input)
+	path := fmt.Sprintf("api/v4/hooks?%s", params.Encode())
+	out := new(systemHook)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertSystemHook(out), res, err
+}
+
+func (s *systemHookService) Update(ctx context.Context, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	params := encodeSystemHookInput(input)
+	path := fmt.Sprintf("api/v4/hooks/%s?%s", input.ID, params.Encode())
+	out := new(systemHook)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	return convertSystemHook(out), res, err
+}
+
+func (s *systemHookService) Delete(ctx context.Context, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/hooks/%s", id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function to encode the common hook input as gitlab
+// system hook query parameters. System hooks only support a
+// subset of the project hook events.
+func encodeSystemHookInput(from *scm.HookInput) url.Values {
+	hasStarEvents := false
+	for _, event := range from.NativeEvents {
+		if event == "*" {
+			hasStarEvents = true
+		}
+	}
+	params := url.Values{}
+	params.Set("url", from.Target)
+	if from.Secret != "" {
+		params.Set("token", from.Secret)
+	}
+	params.Set("enable_ssl_verification", strconv.FormatBool(!from.SkipVerify))
+	params.Set("push_events", strconv.FormatBool(from.Events.Push || from.Events.Branch || hasStarEvents))
+	params.Set("tag_push_events", strconv.FormatBool(from.Events.Tag || hasStarEvents))
+	params.Set("merge_requests_events", strconv.FormatBool(from.Events.PullRequest || hasStarEvents))
+	params.Set("repository_update_events", strconv.FormatBool(from.Events.Repository || hasStarEvents))
+	return params
+}
+
+func convertSystemHookList(from []*systemHook) []*scm.Hook {
+	to := []*scm.Hook{}
+	for _, v := range from {
+		to = append(to, convertSystemHook(v))
+	}
+	return to
+}
+
+func convertSystemHook(from *systemHook) *scm.Hook {
+	var events []string
+	if from.PushEvents {
+		events = append(events, "push")
+	}
+	if from.TagPushEvents {
+		events = append(events, "tag")
+	}
+	if from.MergeRequestsEvents {
+		events = append(events, "merge")
+	}
+	if from.RepositoryUpdateEvents {
+		events = append(events, "repository_update")
+	}
+	return &scm.Hook{
+		ID:         strconv.Itoa(from.ID),
+		Active:     true,
+		Target:     from.URL,
+		Events:     events,
+		SkipVerify: !from.EnableSslVerification,
+	}
+}
@@ -12,7 +12,9 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -42,14 +44,24 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	switch event {
 	case "Push Hook", "Tag Push Hook":
 		hook, err = parsePushHook(data)
-	case "Issue Hook":
-		return nil, scm.UnknownWebhook{Event: event}
+	case "Issue Hook", "Confidential Issue Hook":
+		hook, err = parseIssueHook(s, data)
 	case "Merge Request Hook":
 		hook, err = parsePullRequestHook(data)
 	case "Note Hook":
 		hook, err = parseCommentHook(s, data)
 	case "Release Hook":
 		hook, err = parseReleaseHook(s, data)
+	case "Pipeline Hook":
+		hook, err = parsePipelineHook(data)
+	case "Job Hook":
+		hook, err = parseJobHook(data)
+	case "Deployment Hook":
+		hook, err = parseDeploymentHook(data)
+	case "Member Hook":
+		hook, err = parseMemberHook(data)
+	case "System Hook":
+		hook, err = parseSystemHook(data)
 	default:
 		return nil, scm.UnknownWebhook{Event: event}
 	}
@@ -79,6 +91,10 @@ func parsePushHook(data []byte) (scm.Webhook, error) {
 	if err != nil {
 		return nil, err
 	}
+	// system hooks only populate the event name.
+	if src.ObjectKind == "" {
+		src.ObjectKind = src.EventName
+	}
 	switch {
 	case src.ObjectKind == "push" && src.Before == "0000000000000000000000000000000000000000":
 		// TODO we previously considered returning a
@@ -141,6 +157,48 @@ func parseCommentHook(s *webhookService, data []byte) (scm.Webhook, error) {
 	}
 }
 
+func parseIssueHook(s *webhookService, data []byte) (scm.Webhook, error) {
+	src := new(issueHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	switch src.ObjectAttributes.Action {
+	case "", "open", "close", "reopen", "update":
+		// no-op
+	default:
+		return nil, scm.UnknownWebhook{Event: src.ObjectAttributes.Action}
+	}
+	return convertIssueHook(s, src)
+}
+
+func parsePipelineHook(data []byte) (scm.Webhook, error) {
+	src := new(pipelineHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertPipelineHook(src), nil
+}
+
+func parseJobHook(data []byte) (scm.Webhook, error) {
+	src := new(jobHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertJobHook(src), nil
+}
+
+func parseDeploymentHook(data []byte) (scm.Webhook, error) {
+	src := new(deploymentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertDeploymentHook(src), nil
+}
+
 func parseReleaseHook(s *webhookService, data []byte) (scm.Webhook, error) {
 	src := new(releaseHook)
 	err := json.Unmarshal(data, src)
@@ -150,6 +208,52 @@ func parseReleaseHook(s *webhookService, data []byte) (scm.Webhook, error) {
 	return convertReleaseHook(src)
 }
 
+// parseSystemHook parses a system hook payload. System hooks
+// deliver push and merge request events using the project hook
+// payloads, alongside instance level events such as project
+// and group membership changes.
+func parseSystemHook(data []byte) (scm.Webhook, error) {
+	src := new(systemHookEvent)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	kind := src.ObjectKind
+	if kind == "" {
+		kind = src.EventName
+	}
+	switch kind {
+	case "push", "tag_push":
+		return parsePushHook(data)
+	case "merge_request":
+		return parsePullRequestHook(data)
+	case "project_create", "project_destroy", "project_rename", "project_transfer", "project_update":
+		return parseSystemRepositoryHook(data)
+	case "user_add_to_group", "user_remove_from_group", "user_update_for_group":
+		return parseMemberHook(data)
+	default:
+		return nil, scm.UnknownWebhook{Event: kind}
+	}
+}
+
+func parseSystemRepositoryHook(data []byte) (scm.Webhook, error) {
+	src := new(systemRepositoryHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertSystemRepositoryHook(src), nil
+}
+
+func parseMemberHook(data []byte) (scm.Webhook, error) {
+	src := new(memberHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertMemberHook(src), nil
+}
+
 func convertPushHook(src *pushHook) *scm.PushHook {
 	repo := *convertRepositoryHook(&src.Project)
 	dst := &scm.PushHook{
@@ -185,9 +289,38 @@ func convertPushHook(src *pushHook) *scm.PushHook {
 		dst.Commit.Message = src.Commits[len(src.Commits)-1].Message
 		dst.Commit.Link = src.Commits[len(src.Commits)-1].URL
 	}
+	dst.Commits = convertPushCommits(src.Commits)
+	return dst
+}
+
+func convertPushCommits(src []pushCommit) []scm.PushCommit {
+	var dst []scm.PushCommit
+	for _, s := range src {
+		dst = append(dst, convertPushCommit(&s))
+	}
 	return dst
 }
 
+func convertPushCommit(src *pushCommit) scm.PushCommit {
+	timestamp, _ := time.Parse(time.RFC3339, src.Timestamp)
+	// gitlab only reports the commit author, so the
+	// committer is left empty.
+	return scm.PushCommit{
+		ID:      src.ID,
+		Message: src.Message,
+		Author: scm.Signature{
+			Name:  src.Author.Name,
+			Email: src.Author.Email,
+			Date:  timestamp,
+		},
+		Timestamp: timestamp,
+		Link:      src.URL,
+		Added:     src.Added,
+		Removed:   src.Removed,
+		Modified:  src.Modified,
+	}
+}
+
 func converBranchHook(src *pushHook) *scm.BranchHook {
 	action := scm.ActionCreate
 	commit := src.After
@@ -270,12 +403,12 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 		Head: scm.PullRequestBranch{
 			Sha: sha,
 		},
-		Source: src.ObjectAttributes.SourceBranch,
-		Target: src.ObjectAttributes.TargetBranch,
-		Fork:   fork,
-		Link:   src.ObjectAttributes.URL,
-		Closed: src.ObjectAttributes.State != "opened",
-		Merged: src.ObjectAttributes.State == "merged",
+		Source:   src.ObjectAttributes.SourceBranch,
+		Target:   src.ObjectAttributes.TargetBranch,
+		Fork:     fork,
+		Link:     src.ObjectAttributes.URL,
+		Closed:   src.ObjectAttributes.State != "opened",
+		Merged:   src.ObjectAttributes.State == "merged",
 		MergeSha: src.ObjectAttributes.MergeCommitSha,
 		// Created   : src.ObjectAttributes.CreatedAt,
 		// Updated  : src.ObjectAttributes.UpdatedAt, // 2017-12-10 17:01:11 UTC
@@ -310,7 +443,7 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 }
 
 func convertIssueCommentHook(s *webhookService, src *commentHook) (*scm.IssueCommentHook, error) {
-	commentAuthor, err := s.userService.FindLoginByID(context.TODO(), src.ObjectAttributes.AuthorID)
+	commentAuthor, err := s.findUser(src.ObjectAttributes.AuthorID, &src.User)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find comment author %w", err)
 	}
@@ -346,16 +479,281 @@ func convertIssueCommentHook(s *webhookService, src *commentHook) (*scm.IssueCom
 	return hook, nil
 }
 
+// helper function returns the user with the id. Without a
+// user service, see NewWebHookService, the user is the sender
+// of the webhook if the ids match, or else only has the id.
+func (s *webhookService) findUser(id int, sender *hookUser) (*scm.User, error) {
+	if s.userService != nil {
+		return s.userService.FindLoginByID(context.TODO(), id)
+	}
+	if sender.ID == id {
+		return convertHookUser(sender), nil
+	}
+	return &scm.User{ID: id}, nil
+}
+
+func convertIssueHook(s *webhookService, src *issueHook) (*scm.IssueHook, error) {
+	author, err := s.findUser(src.ObjectAttributes.AuthorID, &src.User)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find issue author %w", err)
+	}
+
+	createdAt, _ := time.Parse("2006-01-02 15:04:05 MST", src.ObjectAttributes.CreatedAt)
+	updatedAt, _ := time.Parse("2006-01-02 15:04:05 MST", src.ObjectAttributes.UpdatedAt)
+
+	issue := scm.Issue{
+		Number:      src.ObjectAttributes.Iid,
+		Title:       src.ObjectAttributes.Title,
+		Body:        src.ObjectAttributes.Description,
+		Link:        src.ObjectAttributes.URL,
+		State:       gitlabStateToSCMState(src.ObjectAttributes.State),
+		Author:      *author,
+		Created:     createdAt,
+		Updated:     updatedAt,
+		Closed:      src.ObjectAttributes.State != "opened",
+		PullRequest: false,
+	}
+	for _, l := range src.Labels {
+		issue.Labels = append(issue.Labels, l.Title)
+	}
+	for _, u := range src.Assignees {
+		issue.Assignees = append(issue.Assignees, *convertHookUser(&u))
+	}
+
+	hook := &scm.IssueHook{
+		Action: convertIssueAction(src.ObjectAttributes.Action),
+		Repo:   *convertRepositoryHook(&src.Project),
+		Issue:  issue,
+		Sender: *convertHookUser(&src.User),
+	}
+
+	// label and assignee changes are reported by gitlab as
+	// an update, with the previous and current values listed
+	// in the changes section of the payload.
+	if hook.Action != scm.ActionUpdate {
+		return hook, nil
+	}
+	if added := diffHookLabels(src.Changes.Labels.Current, src.Changes.Labels.Previous); len(added) > 0 {
+		hook.Action = scm.ActionLabel
+		hook.Label = convertHookLabel(&added[0])
+	} else if removed := diffHookLabels(src.Changes.Labels.Previous, src.Changes.Labels.Current); len(removed) > 0 {
+		hook.Action = scm.ActionUnlabel
+		hook.Label = convertHookLabel(&removed[0])
+	} else if len(src.Changes.Assignees.Current) > len(src.Changes.Assignees.Previous) {
+		hook.Action = scm.ActionAssigned
+	} else if len(src.Changes.Assignees.Current) < len(src.Changes.Assignees.Previous) {
+		hook.Action = scm.ActionUnassigned
+	}
+	return hook, nil
+}
+
+func convertPipelineHook(src *pipelineHook) *scm.PipelineHook {
+	repo := *convertRepositoryHook(&src.Project)
+	dst := &scm.PipelineHook{
+		Action: convertPipelineAction(src.ObjectAttributes.Status),
+		Repo:   repo,
+		Pipeline: scm.Pipeline{
+			ID:             src.ObjectAttributes.ID,
+			Number:         src.ObjectAttributes.Iid,
+			Status:         convertPipelineState(src.ObjectAttributes.Status),
+			DetailedStatus: src.ObjectAttributes.DetailedStatus,
+			Ref:            src.ObjectAttributes.Ref,
+			Tag:            src.ObjectAttributes.Tag,
+			Sha:            src.ObjectAttributes.Sha,
+			BeforeSha:      src.ObjectAttributes.BeforeSha,
+			Source:         src.ObjectAttributes.Source,
+			Stages:         src.ObjectAttributes.Stages,
+			Link:           src.ObjectAttributes.URL,
+			Created:        parseHookTime(src.ObjectAttributes.CreatedAt),
+			Finished:       parseHookTime(src.ObjectAttributes.FinishedAt),
+			Duration:       convertHookDuration(src.ObjectAttributes.Duration),
+			QueuedDuration: convertHookDuration(src.ObjectAttributes.QueuedDuration),
+		},
+		Commit: scm.Commit{
+			Sha:     src.Commit.ID,
+			Message: src.Commit.Message,
+			Author: scm.Signature{
+				Name:  src.Commit.Author.Name,
+				Email: src.Commit.Author.Email,
+				Date:  parseHookTime(src.Commit.Timestamp),
+			},
+			Committer: scm.Signature{
+				Name:  src.Commit.Author.Name,
+				Email: src.Commit.Author.Email,
+				Date:  parseHookTime(src.Commit.Timestamp),
+			},
+			Link: src.Commit.URL,
+		},
+		Sender: *convertHookUser(&src.User),
+	}
+	if len(src.ObjectAttributes.Variables) > 0 {
+		dst.Pipeline.Variables = map[string]string{}
+		for _, v := range src.ObjectAttributes.Variables {
+			dst.Pipeline.Variables[v.Key] = v.Value
+		}
+	}
+	for _, b := range src.Builds {
+		dst.Jobs = append(dst.Jobs, scm.PipelineJob{
+			ID:             b.ID,
+			PipelineID:     src.ObjectAttributes.ID,
+			Name:           b.Name,
+			Stage:          b.Stage,
+			Status:         convertPipelineState(b.Status),
+			DetailedStatus: b.Status,
+			Ref:            src.ObjectAttributes.Ref,
+			Tag:            src.ObjectAttributes.Tag,
+			Sha:            src.ObjectAttributes.Sha,
+			Link:           fmt.Sprintf("%s/-/jobs/%d", repo.Link, b.ID),
+			Runner:         b.Runner.Description,
+			AllowFailure:   b.AllowFailure,
+			FailureReason:  b.FailureReason,
+			Environment:    b.Environment.Name,
+			Created:        parseHookTime(b.CreatedAt),
+			Started:        parseHookTime(b.StartedAt),
+			Finished:       parseHookTime(b.FinishedAt),
+			Duration:       convertHookDuration(b.Duration),
+			QueuedDuration: convertHookDuration(b.QueuedDuration),
+		})
+	}
+	if src.MergeRequest != nil {
+		dst.PullRequest = &scm.PullRequest{
+			Number: src.MergeRequest.Iid,
+			Title:  src.MergeRequest.Title,
+			State:  gitlabStateToSCMState(src.MergeRequest.State),
+			Sha:    src.ObjectAttributes.Sha,
+			Ref:    fmt.Sprintf("refs/merge-requests/%d/head", src.MergeRequest.Iid),
+			Source: src.MergeRequest.SourceBranch,
+			Target: src.MergeRequest.TargetBranch,
+			Base: scm.PullRequestBranch{
+				Ref: src.MergeRequest.TargetBranch,
+			},
+			Head: scm.PullRequestBranch{
+				Ref: src.MergeRequest.SourceBranch,
+				Sha: src.ObjectAttributes.Sha,
+			},
+			Link:   src.MergeRequest.URL,
+			Closed: src.MergeRequest.State != "opened",
+			Merged: src.MergeRequest.State == "merged",
+		}
+	}
+	return dst
+}
+
+func convertJobHook(src *jobHook) *scm.JobHook {
+	repo := *convertRepositoryHook(&src.Project)
+	if repo.FullName == "" {
+		// older gitlab versions do not include the project
+		// in the job payload.
+		namespace, name := scm.Split(src.ProjectName)
+		repo = scm.Repository{
+			ID:        strconv.Itoa(src.ProjectID),
+			Namespace: namespace,
+			Name:      name,
+			FullName:  src.ProjectName,
+			Clone:     src.Repository.GitHTTPURL,
+			CloneSSH:  src.Repository.GitSSHURL,
+			Link:      src.Repository.Homepage,
+		}
+	}
+	dst := &scm.JobHook{
+		Action: convertPipelineAction(src.BuildStatus),
+		Repo:   repo,
+		Job: scm.PipelineJob{
+			ID:             src.BuildID,
+			PipelineID:     src.PipelineID,
+			Name:           src.BuildName,
+			Stage:          src.BuildStage,
+			Status:         convertPipelineState(src.BuildStatus),
+			DetailedStatus: src.BuildStatus,
+			Ref:            src.Ref,
+			Tag:            src.Tag,
+			Sha:            src.Sha,
+			Link:           fmt.Sprintf("%s/-/jobs/%d", repo.Link, src.BuildID),
+			Runner:         src.Runner.Description,
+			AllowFailure:   src.BuildAllowFailure,
+			FailureReason:  src.BuildFailureReason,
+			Environment:    src.Environment.Name,
+			Created:        parseHookTime(src.BuildCreatedAt),
+			Started:        parseHookTime(src.BuildStartedAt),
+			Finished:       parseHookTime(src.BuildFinishedAt),
+			Duration:       convertHookDuration(src.BuildDuration),
+			QueuedDuration: convertHookDuration(src.BuildQueuedDuration),
+		},
+		Commit: scm.Commit{
+			Sha:     src.Sha,
+			Message: src.Commit.Message,
+			Author: scm.Signature{
+				Name:  src.Commit.AuthorName,
+				Email: src.Commit.AuthorEmail,
+			},
+			Committer: scm.Signature{
+				Name:  src.Commit.AuthorName,
+				Email: src.Commit.AuthorEmail,
+			},
+		},
+		Sender: *convertHookUser(&src.User),
+	}
+	return dst
+}
+
+func convertDeploymentHook(src *deploymentHook) *scm.DeploymentStatusHook {
+	repo := *convertRepositoryHook(&src.Project)
+	sender := convertHookUser(&src.User)
+	changed := parseHookTime(src.StatusChangedAt)
+	deployment := scm.Deployment{
+		ID:                    strconv.Itoa(src.DeploymentID),
+		Namespace:             repo.Namespace,
+		Name:                  repo.Name,
+		FullName:              repo.FullName,
+		Link:                  src.DeployableURL,
+		Sha:                   commitSha(src.CommitURL, src.ShortSha),
+		Ref:                   src.Ref,
+		Description:           src.CommitTitle,
+		OriginalEnvironment:   src.Environment,
+		Environment:           src.Environment,
+		RepositoryLink:        repo.Link,
+		Author:                sender,
+		ProductionEnvironment: src.EnvironmentTier == "production",
+	}
+	return &scm.DeploymentStatusHook{
+		Deployment: deployment,
+		DeploymentStatus: scm.DeploymentStatus{
+			ID:              strconv.Itoa(src.DeploymentID),
+			State:           src.Status,
+			Author:          sender,
+			Environment:     src.Environment,
+			EnvironmentLink: src.EnvironmentExternalURL,
+			LogLink:         src.DeployableURL,
+			RepositoryLink:  repo.Link,
+			TargetLink:      src.EnvironmentExternalURL,
+			Created:         changed,
+			Updated:         changed,
+		},
+		Action: scm.ActionCreate,
+		Repo:   repo,
+		Sender: *sender,
+	}
+}
+
+// helper function returns the full sha from the commit url of
+// a deployment, which only has the short sha.
+func commitSha(link, short string) string {
+	if sha := path.Base(link); link != "" && strings.HasPrefix(sha, short) {
+		return sha
+	}
+	return short
+}
+
 func convertMergeRequestCommentHook(s *webhookService, src *commentHook) (*scm.PullRequestCommentHook, error) {
 
 	// There are two users needed here: the comment author and the MergeRequest author.
 	// Since we only have the user name, we need to use the user service to fetch these.
-	commentAuthor, err := s.userService.FindLoginByID(context.TODO(), src.ObjectAttributes.AuthorID)
+	commentAuthor, err := s.findUser(src.ObjectAttributes.AuthorID, &src.User)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find comment author %w", err)
 	}
 
-	mrAuthor, err := s.userService.FindLoginByID(context.TODO(), src.MergeRequest.AuthorID)
+	mrAuthor, err := s.findUser(src.MergeRequest.AuthorID, &src.User)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find mr author %w", err)
 	}
@@ -434,6 +832,67 @@ func convertRepositoryHook(from *project) *scm.Repository {
 	}
 }
 
+func convertSystemRepositoryHook(src *systemRepositoryHook) *scm.RepositoryHook {
+	dst := &scm.RepositoryHook{
+		Repo: convertSystemRepository(src, src.PathWithNamespace),
+	}
+	switch src.EventName {
+	case "project_create":
+		dst.Action = scm.ActionCreate
+	case "project_destroy":
+		dst.Action = scm.ActionDelete
+	case "project_rename", "project_transfer":
+		dst.Action = scm.ActionRenamed
+		previous := convertSystemRepository(src, src.OldPathWithNamespace)
+		dst.Previous = &previous
+	default:
+		dst.Action = scm.ActionUpdate
+	}
+	return dst
+}
+
+func convertSystemRepository(src *systemRepositoryHook, fullName string) scm.Repository {
+	namespace, name := scm.Split(fullName)
+	return scm.Repository{
+		ID:        strconv.Itoa(src.ProjectID),
+		Namespace: namespace,
+		Name:      name,
+		FullName:  fullName,
+		Private:   src.ProjectVisibility != "public",
+		Created:   src.CreatedAt,
+		Updated:   src.UpdatedAt,
+	}
+}
+
+func convertMemberHook(src *memberHook) *scm.OrganizationHook {
+	dst := &scm.OrganizationHook{
+		Organization: scm.Organization{
+			ID:   src.GroupID,
+			Name: src.GroupPath,
+		},
+		Member: scm.User{
+			ID:    src.UserID,
+			Login: src.UserUsername,
+			Name:  src.UserName,
+			Email: src.UserEmail,
+		},
+		Membership: scm.Membership{
+			State:            "active",
+			Role:             src.GroupAccess,
+			OrganizationName: src.GroupPath,
+		},
+	}
+	switch src.EventName {
+	case "user_add_to_group":
+		dst.Action = scm.ActionAdded
+	case "user_remove_from_group":
+		dst.Action = scm.ActionRemoved
+	case "user_update_for_group":
+		dst.Action = scm.ActionUpdate
+	}
+	return dst
+}
+
 func convertReleaseHook(from *releaseHook) (*scm.ReleaseHook, error) {
 	created, err := time.Parse("2006-01-02 15:04:05 MST", from.CreatedAt)
 	if err != nil {
@@ -460,6 +919,94 @@ func convertReleaseHook(from *releaseHook) (*scm.ReleaseHook, error) {
 	}, nil
 }
 
+func convertHookUser(from *hookUser) *scm.User {
+	return &scm.User{
+		ID:     from.ID,
+		Login:  from.Username,
+		Name:   from.Name,
+		Email:  from.Email,
+		Avatar: from.AvatarURL,
+	}
+}
+
+func convertHookLabel(from *hookLabel) scm.Label {
+	return scm.Label{
+		ID:          int64(from.ID),
+		Name:        from.Title,
+		Description: from.Description,
+		Color:       from.Color,
+	}
+}
+
+// diffHookLabels returns the labels in a that are not in b.
+func diffHookLabels(a, b []hookLabel) []hookLabel {
+	var diff []hookLabel
+	for _, l := range a {
+		found := false
+		for _, o := range b {
+			if o.ID == l.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diff = append(diff, l)
+		}
+	}
+	return diff
+}
+
+func convertIssueAction(src string) scm.Action {
+	switch src {
+	case "", "open":
+		return scm.ActionOpen
+	case "close":
+		return scm.ActionClose
+	case "reopen":
+		return scm.ActionReopen
+	default:
+		return scm.ActionUpdate
+	}
+}
+
+// convertPipelineAction derives the hook action from the
+// pipeline or job status, since gitlab does not send one.
+func convertPipelineAction(status string) scm.Action {
+	switch status {
+	case "success", "failed", "canceled", "skipped":
+		return scm.ActionCompleted
+	case "created":
+		return scm.ActionCreate
+	default:
+		return scm.ActionUpdate
+	}
+}
+
+func convertPipelineState(from string) scm.State {
+	switch from {
+	case "created", "waiting_for_resource", "preparing", "scheduled", "manual":
+		return scm.StatePending
+	default:
+		return convertState(from)
+	}
+}
+
+// parseHookTime parses the timestamps found in gitlab
+// hook payloads, which are either in the legacy UTC format
+// or in RFC3339 format depending on the hook and version.
+func parseHookTime(s string) time.Time {
+	for _, layout := range []string{"2006-01-02 15:04:05 MST", "2006-01-02 15:04:05 -0700", time.RFC3339} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func convertHookDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
 func convertAction(src string) (action scm.Action) {
 	switch src {
 	case "create":
@@ -491,35 +1038,73 @@ type (
 		HTTPURL           string      `json:"http_url"`
 	}
 
+	// system hook payload, used to determine the event type.
+	systemHookEvent struct {
+		ObjectKind string `json:"object_kind"`
+		EventName  string `json:"event_name"`
+	}
+
+	// system hook project payload.
+	systemRepositoryHook struct {
+		EventName            string    `json:"event_name"`
+		CreatedAt            time.Time `json:"created_at"`
+		UpdatedAt            time.Time `json:"updated_at"`
+		Name                 string    `json:"name"`
+		Path                 string    `json:"path"`
+		PathWithNamespace    string    `json:"path_with_namespace"`
+		OldPathWithNamespace string    `json:"old_path_with_namespace"`
+		ProjectID            int       `json:"project_id"`
+		OwnerName            string    `json:"owner_name"`
+		OwnerEmail           string    `json:"owner_email"`
+		ProjectVisibility    string    `json:"project_visibility"`
+	}
+
+	// group member payload, sent by group and system hooks.
+	memberHook struct {
+		EventName    string    `json:"event_name"`
+		CreatedAt    time.Time `json:"created_at"`
+		UpdatedAt    time.Time `json:"updated_at"`
+		GroupID      int       `json:"group_id"`
+		GroupName    string    `json:"group_name"`
+		GroupPath    string    `json:"group_path"`
+		GroupAccess  string    `json:"group_access"`
+		UserID       int       `json:"user_id"`
+		UserName     string    `json:"user_name"`
+		UserUsername string    `json:"user_username"`
+		UserEmail    string    `json:"user_email"`
+	}
+
+	pushCommit struct {
+		ID        string `json:"id"`
+		Message   string `json:"message"`
+		Timestamp string `json:"timestamp"`
+		URL       string `json:"url"`
+		Author    struct {
+			Name  string `json:"name"`
+			Email string `json:"email"`
+		} `json:"author"`
+		Added    []string `json:"added"`
+		Modified []string `json:"modified"`
+		Removed  []string `json:"removed"`
+	}
+
 	pushHook struct {
-		ObjectKind   string      `json:"object_kind"`
-		EventName    string      `json:"event_name"`
-		Before       string      `json:"before"`
-		After        string      `json:"after"`
-		Ref          string      `json:"ref"`
-		CheckoutSha  string      `json:"checkout_sha"`
-		Message      interface{} `json:"message"`
-		UserID       int         `json:"user_id"`
-		UserName     string      `json:"user_name"`
-		UserUsername string      `json:"user_username"`
-		UserEmail    string      `json:"user_email"`
-		UserAvatar   string      `json:"user_avatar"`
-		ProjectID    int         `json:"project_id"`
-		Project      project     `json:"project"`
-		Commits      []struct {
-			ID        string `json:"id"`
-			Message   string `json:"message"`
-			Timestamp string `json:"timestamp"`
-			URL       string `json:"url"`
-			Author    struct {
-				Name  string `json:"name"`
-				Email string `json:"email"`
-			} `json:"author"`
-			Added    []string      `json:"added"`
-			Modified []interface{} `json:"modified"`
-			Removed  []interface{} `json:"removed"`
-		} `json:"commits"`
-		TotalCommitsCount int `json:"total_commits_count"`
+		ObjectKind        string       `json:"object_kind"`
+		EventName         string       `json:"event_name"`
+		Before            string       `json:"before"`
+		After             string       `json:"after"`
+		Ref               string       `json:"ref"`
+		CheckoutSha       string       `json:"checkout_sha"`
+		Message           interface{}  `json:"message"`
+		UserID            int          `json:"user_id"`
+		UserName          string       `json:"user_name"`
+		UserUsername      string       `json:"user_username"`
+		UserEmail         string       `json:"user_email"`
+		UserAvatar        string       `json:"user_avatar"`
+		ProjectID         int          `json:"project_id"`
+		Project           project      `json:"project"`
+		Commits           []pushCommit `json:"commits"`
+		TotalCommitsCount int          `json:"total_commits_count"`
 		Repository        struct {
 			Name            string `json:"name"`
 			URL             string `json:"url"`
@@ -532,14 +1117,10 @@ type (
 	}
 
 	commentHook struct {
-		ObjectKind string `json:"object_kind"`
-		User       struct {
-			Name      string `json:"name"`
-			Username  string `json:"username"`
-			AvatarURL string `json:"avatar_url"`
-		} `json:"user"`
-		ProjectID        int     `json:"project_id"`
-		Project          project `json:"project"`
+		ObjectKind       string   `json:"object_kind"`
+		User             hookUser `json:"user"`
+		ProjectID        int      `json:"project_id"`
+		Project          project  `json:"project"`
 		ObjectAttributes struct {
 			ID           int         `json:"id"`
 			Note         string      `json:"note"`
@@ -716,15 +1297,31 @@ type (
 		} `json:"repository"`
 	}
 
+	hookUser struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+		Email     string `json:"email"`
+	}
+
+	hookLabel struct {
+		ID          int         `json:"id"`
+		Title       string      `json:"title"`
+		Color       string      `json:"color"`
+		ProjectID   int         `json:"project_id"`
+		CreatedAt   string      `json:"created_at"`
+		UpdatedAt   string      `json:"updated_at"`
+		Template    bool        `json:"template"`
+		Description string      `json:"description"`
+		Type        string      `json:"type"`
+		GroupID     interface{} `json:"group_id"`
+	}
+
 	issueHook struct {
-		ObjectKind string `json:"object_kind"`
-		User       struct {
-			Name      string `json:"name"`
-			Username  string `json:"username"`
-			AvatarURL string `json:"avatar_url"`
-			Email     string `json:"email"`
-		} `json:"user"`
-		Project          project `json:"project"`
+		ObjectKind       string   `json:"object_kind"`
+		User             hookUser `json:"user"`
+		Project          project  `json:"project"`
 		ObjectAttributes struct {
 			AssigneeID          interface{}   `json:"assignee_id"`
 			AuthorID            int           `json:"author_id"`
@@ -755,34 +1352,17 @@ type (
 			AssigneeIds         []interface{} `json:"assignee_ids"`
 			Action              string        `json:"action"`
 		} `json:"object_attributes"`
-		Labels []struct {
-			ID          int         `json:"id"`
-			Title       string      `json:"title"`
-			Color       string      `json:"color"`
-			ProjectID   string      `json:"project_id"`
-			CreatedAt   string      `json:"created_at"`
-			UpdatedAt   string      `json:"updated_at"`
-			Template    bool        `json:"template"`
-			Description string      `json:"description"`
-			Type        string      `json:"type"`
-			GroupID     interface{} `json:"group_id"`
-		} `json:"labels"`
-		Changes struct {
+		Labels    []hookLabel `json:"labels"`
+		Assignees []hookUser  `json:"assignees"`
+		Changes   struct {
 			Labels struct {
-				Previous []interface{} `json:"previous"`
-				Current  []struct {
-					ID          int         `json:"id"`
-					Title       string      `json:"title"`
-					Color       string      `json:"color"`
-					ProjectID   int         `json:"project_id"`
-					CreatedAt   string      `json:"created_at"`
-					UpdatedAt   string      `json:"updated_at"`
-					Template    bool        `json:"template"`
-					Description string      `json:"description"`
-					Type        string      `json:"type"`
-					GroupID     interface{} `json:"group_id"`
-				} `json:"current"`
+				Previous []hookLabel `json:"previous"`
+				Current  []hookLabel `json:"current"`
 			} `json:"labels"`
+			Assignees struct {
+				Previous []hookUser `json:"previous"`
+				Current  []hookUser `json:"current"`
+			} `json:"assignees"`
 		} `json:"changes"`
 		Repository struct {
 			Name        string `json:"name"`
@@ -897,4 +1477,145 @@ type (
 			} `json:"author"`
 		} `json:"commit"`
 	}
+
+	pipelineHook struct {
+		ObjectKind       string `json:"object_kind"`
+		ObjectAttributes struct {
+			ID             int      `json:"id"`
+			Iid            int      `json:"iid"`
+			Ref            string   `json:"ref"`
+			Tag            bool     `json:"tag"`
+			Sha            string   `json:"sha"`
+			BeforeSha      string   `json:"before_sha"`
+			Source         string   `json:"source"`
+			Status         string   `json:"status"`
+			DetailedStatus string   `json:"detailed_status"`
+			Stages         []string `json:"stages"`
+			CreatedAt      string   `json:"created_at"`
+			FinishedAt     string   `json:"finished_at"`
+			Duration       float64  `json:"duration"`
+			QueuedDuration float64  `json:"queued_duration"`
+			Variables      []struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			} `json:"variables"`
+			URL string `json:"url"`
+		} `json:"object_attributes"`
+		MergeRequest *struct {
+			ID              int    `json:"id"`
+			Iid             int    `json:"iid"`
+			Title           string `json:"title"`
+			SourceBranch    string `json:"source_branch"`
+			SourceProjectID int    `json:"source_project_id"`
+			TargetBranch    string `json:"target_branch"`
+			TargetProjectID int    `json:"target_project_id"`
+			State           string `json:"state"`
+			MergeStatus     string `json:"merge_status"`
+			URL             string `json:"url"`
+		} `json:"merge_request"`
+		User    hookUser `json:"user"`
+		Project project  `json:"project"`
+		Commit  struct {
+			ID        string `json:"id"`
+			Message   string `json:"message"`
+			Timestamp string `json:"timestamp"`
+			URL       string `json:"url"`
+			Author    struct {
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			} `json:"author"`
+		} `json:"commit"`
+		Builds []struct {
+			ID             int      `json:"id"`
+			Stage          string   `json:"stage"`
+			Name           string   `json:"name"`
+			Status         string   `json:"status"`
+			CreatedAt      string   `json:"created_at"`
+			StartedAt      string   `json:"started_at"`
+			FinishedAt     string   `json:"finished_at"`
+			Duration       float64  `json:"duration"`
+			QueuedDuration float64  `json:"queued_duration"`
+			FailureReason  string   `json:"failure_reason"`
+			When           string   `json:"when"`
+			Manual         bool     `json:"manual"`
+			AllowFailure   bool     `json:"allow_failure"`
+			User           hookUser `json:"user"`
+			Runner         struct {
+				ID          int    `json:"id"`
+				Description string `json:"description"`
+			} `json:"runner"`
+			Environment struct {
+				Name   string `json:"name"`
+				Action string `json:"action"`
+			} `json:"environment"`
+		} `json:"builds"`
+	}
+
+	jobHook struct {
+		ObjectKind          string   `json:"object_kind"`
+		Ref                 string   `json:"ref"`
+		Tag                 bool     `json:"tag"`
+		BeforeSha           string   `json:"before_sha"`
+		Sha                 string   `json:"sha"`
+		BuildID             int      `json:"build_id"`
+		BuildName           string   `json:"build_name"`
+		BuildStage          string   `json:"build_stage"`
+		BuildStatus         string   `json:"build_status"`
+		BuildCreatedAt      string   `json:"build_created_at"`
+		BuildStartedAt      string   `json:"build_started_at"`
+		BuildFinishedAt     string   `json:"build_finished_at"`
+		BuildDuration       float64  `json:"build_duration"`
+		BuildQueuedDuration float64  `json:"build_queued_duration"`
+		BuildAllowFailure   bool     `json:"build_allow_failure"`
+		BuildFailureReason  string   `json:"build_failure_reason"`
+		PipelineID          int      `json:"pipeline_id"`
+		ProjectID           int      `json:"project_id"`
+		ProjectName         string   `json:"project_name"`
+		User                hookUser `json:"user"`
+		Commit              struct {
+			ID          int    `json:"id"`
+			Sha         string `json:"sha"`
+			Message     string `json:"message"`
+			AuthorName  string `json:"author_name"`
+			AuthorEmail string `json:"author_email"`
+			AuthorURL   string `json:"author_url"`
+			Status      string `json:"status"`
+		} `json:"commit"`
+		Repository struct {
+			Name        string `json:"name"`
+			Description string `json:"description"`
+			Homepage    string `json:"homepage"`
+			GitSSHURL   string `json:"git_ssh_url"`
+			GitHTTPURL  string `json:"git_http_url"`
+		} `json:"repository"`
+		Project project `json:"project"`
+		Runner  struct {
+			ID          int    `json:"id"`
+			Description string `json:"description"`
+		} `json:"runner"`
+		Environment struct {
+			Name   string `json:"name"`
+			Action string `json:"action"`
+		} `json:"environment"`
+	}
+
+	deploymentHook struct {
+		ObjectKind             string   `json:"object_kind"`
+		Status                 string   `json:"status"`
+		StatusChangedAt        string   `json:"status_changed_at"`
+		DeploymentID           int      `json:"deployment_id"`
+		DeployableID           int      `json:"deployable_id"`
+		DeployableURL          string   `json:"deployable_url"`
+		Environment            string   `json:"environment"`
+		EnvironmentTier        string   `json:"environment_tier"`
+		EnvironmentSlug        string   `json:"environment_slug"`
+		EnvironmentExternalURL string   `json:"environment_external_url"`
+		Project                project  `json:"project"`
+		ShortSha               string   `json:"short_sha"`
+		User                   hookUser `json:"user"`
+		UserURL                string   `json:"user_url"`
+		CommitURL              string   `json:"commit_url"`
+		CommitTitle            string   `json:"commit_title"`
+		Ref                    string   `json:"ref"`
+	}
 )
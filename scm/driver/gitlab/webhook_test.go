@@ -78,13 +78,109 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/push2.json.golden",
 			obj:    new(scm.PushHook),
 		},
-		// // issue hooks
-		// {
-		// 	event:  "issues",
-		// 	before: "testdata/webhooks/issues_opened.json",
-		// 	after:  "testdata/webhooks/issues_opened.json.golden",
-		// 	obj:    new(scm.IssueHook),
-		// },
+		// issue hooks
+		{
+			event:  "Issue Hook",
+			before: "testdata/webhooks/issue_create.json",
+			after:  "testdata/webhooks/issue_create.json.golden",
+			obj:    new(scm.IssueHook),
+			mockUserService: &mockUserService{
+				users: map[int]*scm.User{
+					51764: {
+						ID:     51764,
+						Login:  "sytses",
+						Name:   "Sid Sijbrandij",
+						Email:  "",
+						Avatar: "https://secure.gravatar.com/avatar/8c58a0be77ee441bb8f8595b7f1b4e87?s=80&d=identicon",
+					},
+				},
+			},
+		},
+		{
+			event:  "Issue Hook",
+			before: "testdata/webhooks/issue_edited.json",
+			after:  "testdata/webhooks/issue_edited.json.golden",
+			obj:    new(scm.IssueHook),
+			mockUserService: &mockUserService{
+				users: map[int]*scm.User{
+					51764: {
+						ID:     51764,
+						Login:  "sytses",
+						Name:   "Sid Sijbrandij",
+						Email:  "",
+						Avatar: "https://secure.gravatar.com/avatar/8c58a0be77ee441bb8f8595b7f1b4e87?s=80&d=identicon",
+					},
+				},
+			},
+		},
+		{
+			event:  "Issue Hook",
+			before: "testdata/webhooks/issue_labeled.json",
+			after:  "testdata/webhooks/issue_labeled.json.golden",
+			obj:    new(scm.IssueHook),
+			mockUserService: &mockUserService{
+				users: map[int]*scm.User{
+					51764: {
+						ID:     51764,
+						Login:  "sytses",
+						Name:   "Sid Sijbrandij",
+						Email:  "",
+						Avatar: "https://secure.gravatar.com/avatar/8c58a0be77ee441bb8f8595b7f1b4e87?s=80&d=identicon",
+					},
+				},
+			},
+		},
+		{
+			event:  "Issue Hook",
+			before: "testdata/webhooks/issue_assigned.json",
+			after:  "testdata/webhooks/issue_assigned.json.golden",
+			obj:    new(scm.IssueHook),
+			mockUserService: &mockUserService{
+				users: map[int]*scm.User{
+					51764: {
+						ID:     51764,
+						Login:  "sytses",
+						Name:   "Sid Sijbrandij",
+						Email:  "",
+						Avatar: "https://secure.gravatar.com/avatar/8c58a0be77ee441bb8f8595b7f1b4e87?s=80&d=identicon",
+					},
+				},
+			},
+		},
+		{
+			event:  "Issue Hook",
+			before: "testdata/webhooks/issue_closed.json",
+			after:  "testdata/webhooks/issue_closed.json.golden",
+			obj:    new(scm.IssueHook),
+			mockUserService: &mockUserService{
+				users: map[int]*scm.User{
+					51764: {
+						ID:     51764,
+						Login:  "sytses",
+						Name:   "Sid Sijbrandij",
+						Email:  "",
+						Avatar: "https://secure.gravatar.com/avatar/8c58a0be77ee441bb8f8595b7f1b4e87?s=80&d=identicon",
+					},
+				},
+			},
+		},
+		{
+			event:  "Issue Hook",
+			before: "testdata/webhooks/issue_reopen.json",
+			after:  "testdata/webhooks/issue_reopen.json.golden",
+			obj:    new(scm.IssueHook),
+			mockUserService: &mockUserService{
+				users: map[int]*scm.User{
+					51764: {
+						ID:     51764,
+						Login:  "sytses",
+						Name:   "Sid Sijbrandij",
+						Email:  "",
+						Avatar: "https://secure.gravatar.com/avatar/8c58a0be77ee441bb8f8595b7f1b4e87?s=80&d=identicon",
+					},
+				},
+			},
+		},
 		// issue comment hooks
 		{
 			event:  "Note Hook",
@@ -158,6 +254,71 @@ func TestWebhooks(t *testing.T) {
 				},
 			},
 		},
+		// pipeline hooks
+		{
+			event:  "Pipeline Hook",
+			before: "testdata/webhooks/pipeline.json",
+			after:  "testdata/webhooks/pipeline.json.golden",
+			obj:    new(scm.PipelineHook),
+		},
+		{
+			event:  "Pipeline Hook",
+			before: "testdata/webhooks/pipeline_merge_request.json",
+			after:  "testdata/webhooks/pipeline_merge_request.json.golden",
+			obj:    new(scm.PipelineHook),
+		},
+		// job hooks
+		{
+			event:  "Job Hook",
+			before: "testdata/webhooks/job.json",
+			after:  "testdata/webhooks/job.json.golden",
+			obj:    new(scm.JobHook),
+		},
+		// deployment hooks
+		{
+			event:  "Deployment Hook",
+			before: "testdata/webhooks/deployment.json",
+			after:  "testdata/webhooks/deployment.json.golden",
+			obj:    new(scm.DeploymentStatusHook),
+		},
+		// group member hooks
+		{
+			event:  "Member Hook",
+			before: "testdata/webhooks/member_add.json",
+			after:  "testdata/webhooks/member_add.json.golden",
+			obj:    new(scm.OrganizationHook),
+		},
+		// system hooks
+		{
+			event:  "System Hook",
+			before: "testdata/webhooks/system_push.json",
+			after:  "testdata/webhooks/system_push.json.golden",
+			obj:    new(scm.PushHook),
+		},
+		{
+			event:  "System Hook",
+			before: "testdata/webhooks/system_branch_delete.json",
+			after:  "testdata/webhooks/system_branch_delete.json.golden",
+			obj:    new(scm.BranchHook),
+		},
+		{
+			event:  "System Hook",
+			before: "testdata/webhooks/system_project_create.json",
+			after:  "testdata/webhooks/system_project_create.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		{
+			event:  "System Hook",
+			before: "testdata/webhooks/system_project_rename.json",
+			after:  "testdata/webhooks/system_project_rename.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		{
+			event:  "System Hook",
+			before: "testdata/webhooks/member_add.json",
+			after:  "testdata/webhooks/member_add.json.golden",
+			obj:    new(scm.OrganizationHook),
+		},
 		// release hooks
 		{
 			event:  "Release Hook",
@@ -226,6 +387,57 @@ func TestWebhooks(t *testing.T) {
 	}
 }
 
+func TestWebhookWithoutUserService(t *testing.T) {
+	tests := []struct {
+		event string
+		file  string
+	}{
+		{"Issue Hook", "testdata/webhooks/issue_create.json"},
+		{"Note Hook", "testdata/webhooks/issue_comment_create.json"},
+		{"Note Hook", "testdata/webhooks/pull_request_comment_create.json"},
+	}
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			f, _ := ioutil.ReadFile(test.file)
+			r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+			r.Header.Set("X-Gitlab-Event", test.event)
+			r.Header.Set("X-Gitlab-Token", "topsecret")
+
+			hook, err := NewWebHookService().Parse(r, secretFunc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var author scm.User
+			switch v := hook.(type) {
+			case *scm.IssueHook:
+				author = v.Issue.Author
+			case *scm.IssueCommentHook:
+				author = v.Comment.Author
+			case *scm.PullRequestCommentHook:
+				author = v.Comment.Author
+			default:
+				t.Fatalf("Unexpected webhook %T", hook)
+			}
+			if got, want := author.ID, 51764; got != want {
+				t.Errorf("Want author id %d, got %d", want, got)
+			}
+		})
+	}
+}
+
+func TestWebhookFindUserFromSender(t *testing.T) {
+	s := new(webhookService)
+	sender := &hookUser{ID: 51764, Username: "sytses", Name: "Sid Sijbrandij"}
+	got, err := s.findUser(51764, sender)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, convertHookUser(sender)); diff != "" {
+		t.Errorf("Want the sender as user")
+		t.Log(diff)
+	}
+}
+
 func TestWebhook_SignatureValid(t *testing.T) {
 	f, _ := ioutil.ReadFile("testdata/webhooks/branch_delete.json")
 	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
@@ -44,3 +44,7 @@ func (s *contentService) Update(ctx context.Context, repo, path string, params *
 func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *contentService) CommitFiles(ctx context.Context, repo string, params *scm.CommitFilesParams) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
@@ -0,0 +1,88 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type deployKeyService struct {
+	client *wrapper
+}
+
+type deployKey struct {
+	ID      int       `json:"id"`
+	Key     string    `json:"key"`
+	URL     string    `json:"url"`
+	Title   string    `json:"title"`
+	Created time.Time `json:"created_at"`
+}
+
+type deployKeyInput struct {
+	Title string `json:"title"`
+	Key   string `json:"key"`
+}
+
+func (s *deployKeyService) Find(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys/%s", repo, id)
+	out := new(deployKey)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDeployKey(out), res, err
+}
+
+// List returns the repository deploy keys. Gogs does not
+// paginate deploy keys, so the list options are ignored.
+func (s *deployKeyService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys", repo)
+	out := []*deployKey{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeployKeyList(out), res, err
+}
+
+// Create creates a repository deploy key. Gogs deploy keys
+// are always read-only, so ReadOnly is ignored.
+func (s *deployKeyService) Create(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys", repo)
+	in := &deployKeyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(deployKey)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployKey(out), res, err
+}
+
+func (s *deployKeyService) Delete(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *deployKeyService) Enable(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func convertDeployKeyList(from []*deployKey) []*scm.DeployKey {
+	to := []*scm.DeployKey{}
+	for _, v := range from {
+		to = append(to, convertDeployKey(v))
+	}
+	return to
+}
+
+func convertDeployKey(from *deployKey) *scm.DeployKey {
+	return &scm.DeployKey{
+		ID:       strconv.Itoa(from.ID),
+		Title:    from.Title,
+		Key:      from.Key,
+		ReadOnly: true,
+		Link:     from.URL,
+		Created:  from.Created,
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestDeployKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/keys/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/deploy_key.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.DeployKeys.Find(context.Background(), "gogits/gogs", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/deploy_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/keys").
+		JSON(map[string]string{"title": "gitops-agent", "key": "ssh-rsa AAA..."}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/deploy_key.json")
+
+	input := &scm.DeployKeyInput{
+		Title:    "gitops-agent",
+		Key:      "ssh-rsa AAA...",
+		ReadOnly: true,
+	}
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.DeployKeys.Create(context.Background(), "gogits/gogs", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/deploy_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/jenkins-x/go-scm/pkg/hmac"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// Generate renders the webhook as the request gogs would
+// send, signed with the secret.
+func (s *webhookService) Generate(hook scm.Webhook, secret string) (*http.Request, error) {
+	var event, guid string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event, guid, payload = "push", v.GUID, convertFromPushHook(v)
+	case *scm.BranchHook:
+		event, payload = convertFromRefAction(v.Action), convertFromRefHook("branch", v.Ref, &v.Repo, &v.Sender)
+	case *scm.TagHook:
+		event, payload = convertFromRefAction(v.Action), convertFromRefHook("tag", v.Ref, &v.Repo, &v.Sender)
+	case *scm.PullRequestHook:
+		event, payload = "pull_request", convertFromPullRequestHook(v)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if guid == "" {
+		guid = scm.NewDeliveryID()
+	}
+	header := http.Header{}
+	header.Set("X-Gogs-Event", event)
+	header.Set("X-Gogs-Delivery", guid)
+	if secret != "" {
+		header.Set("X-Gogs-Signature", hmac.Sign(sha256.New, data, []byte(secret)))
+	}
+	return scm.NewWebhookRequest("/", data, header)
+}
+
+func convertFromRefAction(from scm.Action) string {
+	if from == scm.ActionDelete {
+		return "delete"
+	}
+	return "create"
+}
+
+func convertFromPushHook(from *scm.PushHook) *pushHook {
+	to := &pushHook{
+		Ref:        from.Ref,
+		Before:     from.Before,
+		After:      from.Commit.Sha,
+		Compare:    from.Commit.Link,
+		Repository: convertFromRepository(&from.Repo),
+		Sender:     convertFromUser(&from.Sender),
+		Commits: []commit{{
+			ID:      from.Commit.Sha,
+			Message: from.Commit.Message,
+			Author: signature{
+				Name:     from.Commit.Author.Name,
+				Email:    from.Commit.Author.Email,
+				Username: from.Commit.Author.Login,
+			},
+			Committer: signature{
+				Name:     from.Commit.Committer.Name,
+				Email:    from.Commit.Committer.Email,
+				Username: from.Commit.Committer.Login,
+			},
+			Timestamp: from.Commit.Author.Date,
+		}},
+	}
+	if len(from.Commits) != 0 {
+		to.Commits = nil
+		for _, c := range from.Commits {
+			to.Commits = append(to.Commits, commit{
+				ID:      c.ID,
+				Message: c.Message,
+				URL:     c.Link,
+				Author: signature{
+					Name:     c.Author.Name,
+					Email:    c.Author.Email,
+					Username: c.Author.Login,
+				},
+				Committer: signature{
+					Name:     c.Committer.Name,
+					Email:    c.Committer.Email,
+					Username: c.Committer.Login,
+				},
+				Timestamp: c.Timestamp,
+				Added:     c.Added,
+				Removed:   c.Removed,
+				Modified:  c.Modified,
+			})
+		}
+	}
+	to.Pusher = to.Sender
+	return to
+}
+
+func convertFromRefHook(refType string, ref scm.Reference, repo *scm.Repository, sender *scm.User) *createHook {
+	return &createHook{
+		Ref:           ref.Name,
+		RefType:       refType,
+		DefaultBranch: repo.Branch,
+		Repository:    convertFromRepository(repo),
+		Sender:        convertFromUser(sender),
+	}
+}
+
+func convertFromPullRequestHook(from *scm.PullRequestHook) *pullRequestHook {
+	pr := from.PullRequest
+	to := &pullRequestHook{
+		Action:     convertFromPullRequestAction(from.Action),
+		Number:     pr.Number,
+		Repository: convertFromRepository(&from.Repo),
+		Sender:     convertFromUser(&from.Sender),
+	}
+	to.PullRequest = pullRequest{
+		Number:     pr.Number,
+		User:       convertFromUser(&pr.Author),
+		Title:      pr.Title,
+		Body:       pr.Body,
+		State:      "open",
+		HeadBranch: pr.Source,
+		BaseBranch: pr.Target,
+		BaseRepo:   convertFromRepository(&from.Repo),
+		HTMLURL:    pr.Link,
+		Mergeable:  pr.Mergeable,
+		Merged:     pr.Merged,
+	}
+	if pr.Closed {
+		to.PullRequest.State = "closed"
+	}
+	to.PullRequest.HeadRepo.FullName = pr.Fork
+	return to
+}
+
+func convertFromPullRequestAction(from scm.Action) string {
+	switch from {
+	case scm.ActionUpdate:
+		return "edited"
+	case scm.ActionClose, scm.ActionMerge:
+		return "closed"
+	case scm.ActionReopen:
+		return "reopened"
+	case scm.ActionLabel:
+		return "labeled"
+	case scm.ActionUnlabel:
+		return "unlabeled"
+	case scm.ActionSync:
+		return "synchronized"
+	default:
+		return "opened"
+	}
+}
+
+func convertFromRepository(from *scm.Repository) repository {
+	id, _ := strconv.Atoi(from.ID)
+	to := repository{
+		ID:            id,
+		Name:          from.Name,
+		FullName:      from.FullName,
+		Private:       from.Private,
+		HTMLURL:       from.Link,
+		SSHURL:        from.CloneSSH,
+		CloneURL:      from.Clone,
+		DefaultBranch: from.Branch,
+	}
+	to.Owner.Username = from.Namespace
+	if from.Perm != nil {
+		to.Permissions = perm{
+			Admin: from.Perm.Admin,
+			Push:  from.Perm.Push,
+			Pull:  from.Perm.Pull,
+		}
+	}
+	return to
+}
+
+func convertFromUser(from *scm.User) user {
+	return user{
+		ID:       from.ID,
+		Login:    from.Login,
+		Username: from.Login,
+		Fullname: from.Name,
+		Email:    from.Email,
+		Avatar:   from.Avatar,
+	}
+}
@@ -88,6 +88,9 @@ type (
 		Author    signature `json:"author"`
 		Committer signature `json:"committer"`
 		Timestamp time.Time `json:"timestamp"`
+		Added     []string  `json:"added"`
+		Removed   []string  `json:"removed"`
+		Modified  []string  `json:"modified"`
 	}
 
 	// gogs commit detail object.
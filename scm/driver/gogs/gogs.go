@@ -36,7 +36,9 @@ func New(uri string) (*scm.Client, error) {
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverGogs
+	client.Checks = scm.NewStatusChecksService(client.Client)
 	client.Contents = &contentService{client}
+	client.DeployKeys = &deployKeyService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
 	client.Milestones = &milestoneService{client}
@@ -68,6 +68,66 @@ func (s *organizationService) ListMemberships(ctx context.Context, opts scm.List
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *organizationService) ListHooks(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, org string, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, org string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, org, team string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, org, team string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, org, team, user, role string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, org, team, user string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) AddTeamRepository(ctx context.Context, org, team, repo, permission string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveTeamRepository(ctx context.Context, org, team, repo string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) InviteMember(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveMember(ctx context.Context, org, user string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) SetMemberRole(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListOutsideCollaborators(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.TeamMember, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
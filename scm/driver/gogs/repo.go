@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -45,8 +46,67 @@ func (s *repositoryService) ListCollaborators(ctx context.Context, repo string,
 	return nil, nil, scm.ErrNotSupported
 }
 
-func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+// ListLabels returns the repository labels. Gogs does not
+// paginate labels, so the list options are ignored.
+func (s *repositoryService) ListLabels(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+// CreateLabel creates a repository label. Gogs labels have no
+// description, so the description is ignored.
+func (s *repositoryService) CreateLabel(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	in := &labelInput{
+		Name:  input.Name,
+		Color: convertLabelColor(input.Color),
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *repositoryService) UpdateLabel(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	id, res, err := s.lookupLabel(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, id)
+	in := &labelInput{
+		Name:  input.Name,
+		Color: convertLabelColor(input.Color),
+	}
+	out := new(label)
+	res, err = s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *repositoryService) DeleteLabel(ctx context.Context, repo, name string) (*scm.Response, error) {
+	id, res, err := s.lookupLabel(ctx, repo, name)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function returns the id of the named label, or
+// ErrNotFound if the repository has no such label.
+func (s *repositoryService) lookupLabel(ctx context.Context, repo, name string) (int, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return 0, res, err
+	}
+	for _, l := range out {
+		if l.Name == name {
+			return l.ID, res, nil
+		}
+	}
+	return 0, res, scm.ErrNotFound
 }
 
 func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Repository, *scm.Response, error) {
@@ -115,11 +175,37 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
-	return convertHook(out), res, err
+	if err != nil {
+		return nil, res, err
+	}
+	return convertHook(out), res, checkHookEvents(input)
 }
 
 func (s *repositoryService) UpdateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/hooks/%s", repo, input.ID)
+	in := new(hook)
+	in.Active = true
+	in.Config.Secret = input.Secret
+	in.Config.ContentType = "json"
+	in.Config.URL = input.Target
+	in.Events = append(
+		input.NativeEvents,
+		convertHookEvent(input.Events)...,
+	)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertHook(out), res, checkHookEvents(input)
+}
+
+func (s *repositoryService) EnsureHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.EnsureHookResult, *scm.Response, error) {
+	// gogs hooks do not support skipping tls verification.
+	in := *input
+	in.SkipVerify = false
+	events := append(input.NativeEvents, convertHookEvent(input.Events)...)
+	return scm.EnsureHook(ctx, s, repo, &in, events)
 }
 
 func (s *repositoryService) CreateStatus(context.Context, string, string, *scm.StatusInput) (*scm.Status, *scm.Response, error) {
@@ -135,11 +221,66 @@ func (s *repositoryService) Delete(context.Context, string) (*scm.Response, erro
 	return nil, scm.ErrNotSupported
 }
 
+// Update enables or disables the issue tracker and wiki, which
+// are the only repository settings the Gogs API can change.
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositorySettings) (*scm.Repository, *scm.Response, error) {
+	if input.HasIssues != nil {
+		path := fmt.Sprintf("api/v1/repos/%s/issue-tracker", repo)
+		in := &issueTrackerInput{EnableIssues: input.HasIssues}
+		res, err := s.client.do(ctx, "PATCH", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	if input.HasWiki != nil {
+		path := fmt.Sprintf("api/v1/repos/%s/wiki", repo)
+		in := &wikiInput{EnableWiki: input.HasWiki}
+		res, err := s.client.do(ctx, "PATCH", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	out, res, err := s.Find(ctx, repo)
+	if err != nil {
+		return nil, res, err
+	}
+	return out, res, scm.CheckUnsupportedFields(input,
+		"Description",
+		"Homepage",
+		"Visibility",
+		"DefaultBranch",
+		"Archived",
+		"AllowMergeCommit",
+		"AllowSquashMerge",
+		"AllowRebaseMerge",
+		"DeleteBranchOnMerge",
+		"Topics",
+	)
+}
+
+func (s *repositoryService) Rename(context.Context, string, string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Transfer(context.Context, string, string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
 
 type (
+	// gogs issue tracker settings.
+	issueTrackerInput struct {
+		EnableIssues *bool `json:"enable_issues"`
+	}
+
+	// gogs wiki settings.
+	wikiInput struct {
+		EnableWiki *bool `json:"enable_wiki"`
+	}
+
 	// gogs repository resource.
 	repository struct {
 		ID            int       `json:"id"`
@@ -179,6 +320,19 @@ type (
 		ContentType string `json:"content_type"`
 		Secret      string `json:"secret"`
 	}
+
+	// gogs label resource.
+	label struct {
+		ID    int    `json:"id"`
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	}
+
+	// gogs label create and edit options.
+	labelInput struct {
+		Name  string `json:"name,omitempty"`
+		Color string `json:"color,omitempty"`
+	}
 )
 
 //
@@ -255,3 +409,34 @@ func convertHookEvent(from scm.HookEvents) []string {
 	}
 	return events
 }
+
+// helper function reports the hook events that gogs does not
+// send, or that are not parsed.
+func checkHookEvents(input *scm.HookInput) error {
+	return scm.CheckUnsupportedFields(&input.Events, "Fork", "Repository", "Status")
+}
+
+func convertLabelList(src []*label) []*scm.Label {
+	var dst []*scm.Label
+	for _, v := range src {
+		dst = append(dst, convertLabel(v))
+	}
+	return dst
+}
+
+func convertLabel(from *label) *scm.Label {
+	return &scm.Label{
+		ID:    int64(from.ID),
+		Name:  from.Name,
+		Color: from.Color,
+	}
+}
+
+// helper function returns the label color with the leading #
+// expected by gogs.
+func convertLabelColor(from string) string {
+	if from == "" || strings.HasPrefix(from, "#") {
+		return from
+	}
+	return "#" + from
+}
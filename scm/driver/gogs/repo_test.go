@@ -190,6 +190,61 @@ func TestHookCreate(t *testing.T) {
 	}
 }
 
+func TestHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/hooks/20").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.UpdateHook(context.Background(), "gogits/gogs", &scm.HookInput{ID: "20"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestHookEnsure(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/hooks").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hooks.json")
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/hooks").
+		Reply(201).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://try.gogs.io")
+	in := &scm.HookInput{
+		Target: "http://example.com/hook",
+		Events: scm.HookEvents{Push: true},
+	}
+	got, _, err := client.Repositories.EnsureHook(context.Background(), "gogits/gogs", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got.Created || got.Updated || len(got.Deleted) != 0 {
+		t.Errorf("Want created hook, got %+v", got)
+	}
+}
+
 func TestHookDelete(t *testing.T) {
 	defer gock.Off()
 
@@ -281,3 +336,116 @@ func TestStatusCreate(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestRepoListLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.ListLabels(context.Background(), "gogits/gogs", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Label{}
+	raw, _ := ioutil.ReadFile("testdata/labels.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoUpdateLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/labels/1").
+		JSON(map[string]string{"name": "kind/bug", "color": "#d73a4a"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name:  "kind/bug",
+		Color: "d73a4a",
+	}
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.UpdateLabel(context.Background(), "gogits/gogs", "bug", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issue-tracker").
+		Reply(204)
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	issues := true
+	in := &scm.RepositorySettings{
+		Description: "Gogs is a painless self-hosted Git service.",
+		HasIssues:   &issues,
+	}
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.Update(context.Background(), "gogits/gogs", in)
+	unsupported, ok := err.(*scm.UnsupportedFieldsError)
+	if !ok {
+		t.Errorf("Want unsupported fields error, got %v", err)
+		return
+	}
+	if diff := cmp.Diff(unsupported.Fields, []string{"Description"}); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoRename(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Repositories.Rename(context.Background(), "gogits/gogs", "gogs2")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -240,9 +240,38 @@ func convertPushHook(dst *pushHook) *scm.PushHook {
 				Date:  dst.Commits[0].Timestamp,
 			},
 		},
-		Repo:   *convertRepository(&dst.Repository),
-		Sender: *convertUser(&dst.Sender),
+		Commits: convertPushCommits(dst.Commits),
+		Repo:    *convertRepository(&dst.Repository),
+		Sender:  *convertUser(&dst.Sender),
+	}
+}
+
+func convertPushCommits(src []commit) []scm.PushCommit {
+	var dst []scm.PushCommit
+	for _, c := range src {
+		dst = append(dst, scm.PushCommit{
+			ID:      c.ID,
+			Message: c.Message,
+			Author: scm.Signature{
+				Login: c.Author.Username,
+				Email: c.Author.Email,
+				Name:  c.Author.Name,
+				Date:  c.Timestamp,
+			},
+			Committer: scm.Signature{
+				Login: c.Committer.Username,
+				Email: c.Committer.Email,
+				Name:  c.Committer.Name,
+				Date:  c.Timestamp,
+			},
+			Timestamp: c.Timestamp,
+			Link:      c.URL,
+			Added:     c.Added,
+			Removed:   c.Removed,
+			Modified:  c.Modified,
+		})
 	}
+	return dst
 }
 
 func convertPullRequestHook(dst *pullRequestHook) *scm.PullRequestHook {
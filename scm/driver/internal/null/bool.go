@@ -46,3 +46,12 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 func (b Bool) IsZero() bool {
 	return !b.Valid
 }
+
+// MarshalJSON implements json.Marshaler. It will encode
+// null if this Bool is null.
+func (b Bool) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.Bool)
+}
@@ -56,3 +56,12 @@ func (i *Int) UnmarshalJSON(data []byte) error {
 func (i Int) IsZero() bool {
 	return !i.Valid
 }
+
+// MarshalJSON implements json.Marshaler. It will encode
+// null if this Int is null.
+func (i Int) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(i.Int64)
+}
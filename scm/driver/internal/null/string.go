@@ -47,3 +47,22 @@ func (s *String) UnmarshalJSON(data []byte) error {
 func (s String) IsZero() bool {
 	return !s.Valid
 }
+
+// MarshalJSON implements json.Marshaler. It will encode
+// null if this String is null.
+func (s String) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(s.String)
+}
+
+// NewString creates a new String.
+func NewString(s string, valid bool) String {
+	return String{
+		NullString: sql.NullString{
+			String: s,
+			Valid:  valid,
+		},
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package webhooktest provides the tests shared by drivers
+// that generate webhooks.
+package webhooktest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+const secret = "71295b197fa25f4356d2fb9965df3f2379d903d7"
+
+// Test is a webhook payload in testdata/webhooks and the
+// event it is delivered as.
+type Test struct {
+	Event string
+	File  string
+}
+
+// Service is a webhook service that can generate webhooks.
+type Service interface {
+	scm.WebhookService
+	scm.WebhookGenerator
+}
+
+// RoundTrip checks every generated webhook parses back to the
+// webhook it was generated from. The header func returns the
+// headers the provider sends with the event.
+func RoundTrip(t *testing.T, s Service, header func(event string) http.Header, tests []Test) {
+	for _, test := range tests {
+		test := test
+		t.Run(test.File, func(t *testing.T) {
+			data, err := ioutil.ReadFile(filepath.Join("testdata/webhooks", test.File))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r, err := scm.NewWebhookRequest("/", data, header(test.Event))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := s.Parse(r, noSecretFunc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req, err := s.Generate(want, secret)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := s.Parse(req, secretFunc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("Generated webhook does not round trip")
+				t.Log(diff)
+			}
+		})
+	}
+}
+
+// NotSupported checks webhooks the service cannot generate
+// return ErrNotSupported.
+func NotSupported(t *testing.T, s scm.WebhookGenerator) {
+	_, err := s.Generate(&scm.StarHook{}, secret)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
+func noSecretFunc(scm.Webhook) (string, error) {
+	return "", nil
+}
+
+func secretFunc(scm.Webhook) (string, error) {
+	return secret, nil
+}
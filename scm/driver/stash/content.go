@@ -43,3 +43,51 @@ func (s *contentService) Update(ctx context.Context, repo, path string, params *
 func (s *contentService) Delete(ctx context.Context, repo, path, ref string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+// CommitFiles uses the file edit API, which commits a single
+// created or updated file as the authenticated user.
+func (s *contentService) CommitFiles(ctx context.Context, repo string, params *scm.CommitFilesParams) (*scm.Commit, *scm.Response, error) {
+	if len(params.Changes) != 1 || params.Changes[0].Executable != nil {
+		return nil, nil, scm.ErrNotSupported
+	}
+	change := params.Changes[0]
+	if change.Action != scm.FileCreate && change.Action != scm.FileUpdate {
+		return nil, nil, scm.ErrNotSupported
+	}
+
+	branch := params.Branch
+	if branch == "" {
+		branch = params.Base
+	}
+	f := newForm()
+	f.add("content", change.Data)
+	f.add("message", []byte(params.Message))
+	f.add("branch", []byte(branch))
+	source := branch
+	if branch != params.Base {
+		_, res, err := s.client.Git.FindBranch(ctx, repo, branch)
+		switch {
+		case err == scm.ErrNotFound:
+			source = params.Base
+			f.add("sourceBranch", []byte(source))
+		case err != nil:
+			return nil, res, err
+		}
+	}
+	if change.Action == scm.FileUpdate {
+		head, res, err := s.client.Git.FindBranch(ctx, repo, source)
+		if err != nil {
+			return nil, res, err
+		}
+		f.add("sourceCommitId", []byte(head.Sha))
+	}
+
+	namespace, name := scm.Split(repo)
+	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/browse/%s", namespace, name, change.Path)
+	out := new(commit)
+	res, err := s.client.do(ctx, "PUT", endpoint, f, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertCommit(out), res, scm.CheckUnsupportedFields(params, "Author")
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -65,3 +66,79 @@ func TestContentDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestContentCommitFiles(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/branches").
+		MatchParam("filterText", "feature").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"size": 0, "limit": 25, "isLastPage": true, "values": [], "start": 0}`)
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/branches").
+		MatchParam("filterText", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch.json")
+
+	want := map[string][]string{
+		"content":        {"Hello World!"},
+		"message":        {"update readme"},
+		"branch":         {"feature"},
+		"sourceBranch":   {"master"},
+		"sourceCommitId": {"11ce869211917dd65610e70fcee454943b35ac6e"},
+	}
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/browse/README").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				return false, err
+			}
+			return cmp.Equal(req.MultipartForm.Value, want), nil
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	params := &scm.CommitFilesParams{
+		Base:    "master",
+		Branch:  "feature",
+		Message: "update readme",
+		Changes: []scm.FileChange{
+			{Action: scm.FileUpdate, Path: "README", Data: []byte("Hello World!")},
+		},
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Contents.CommitFiles(context.Background(), "PRJ/my-repo", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commit := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit.json.golden")
+	json.Unmarshal(raw, commit)
+
+	if diff := cmp.Diff(got, commit); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentCommitFilesMultiple(t *testing.T) {
+	params := &scm.CommitFilesParams{
+		Base: "master",
+		Changes: []scm.FileChange{
+			{Action: scm.FileCreate, Path: "README"},
+			{Action: scm.FileDelete, Path: "LICENSE"},
+		},
+	}
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Contents.CommitFiles(context.Background(), "PRJ/my-repo", params)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// deliveryService exposes the latest webhook invocation
+// which is the only delivery recorded by bitbucket server.
+type deliveryService struct {
+	client *wrapper
+}
+
+type invocation struct {
+	ID      int    `json:"id"`
+	Event   string `json:"event"`
+	Request struct {
+		URL    string `json:"url"`
+		Method string `json:"method"`
+	} `json:"request"`
+	Result struct {
+		Description string `json:"description"`
+		Outcome     string `json:"outcome"`
+	} `json:"result"`
+	Duration int64 `json:"duration"`
+	Start    int64 `json:"start"`
+	Finish   int64 `json:"finish"`
+}
+
+func (s *deliveryService) List(ctx context.Context, repo, hook string, opts scm.ListOptions) ([]*scm.WebhookDelivery, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/%s/latest", namespace, name, hook)
+	out := new(invocation)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	// no content is returned if the hook was never invoked.
+	if res.Status == 204 {
+		return []*scm.WebhookDelivery{}, res, nil
+	}
+	return []*scm.WebhookDelivery{convertInvocation(out)}, res, nil
+}
+
+func (s *deliveryService) Find(ctx context.Context, repo, hook, id string) (*scm.WebhookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deliveryService) Redeliver(ctx context.Context, repo, hook, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Ping sends a test request to the hook url. Bitbucket server
+// tests urls rather than hooks so the hook is looked up first.
+func (s *deliveryService) Ping(ctx context.Context, repo, hookID string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/%s", namespace, name, hookID)
+	out := new(hook)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	params := url.Values{}
+	params.Set("url", out.URL)
+	path = fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/test?%s", namespace, name, params.Encode())
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func convertInvocation(from *invocation) *scm.WebhookDelivery {
+	code, _ := strconv.Atoi(from.Result.Description)
+	return &scm.WebhookDelivery{
+		ID:         strconv.Itoa(from.ID),
+		Event:      from.Event,
+		Status:     from.Result.Outcome,
+		StatusCode: code,
+		Duration:   time.Duration(from.Duration) * time.Millisecond,
+		Delivered:  time.Unix(from.Start/1000, 0),
+		Request: &scm.WebhookDeliveryRequest{
+			URL: from.Request.URL,
+		},
+	}
+}
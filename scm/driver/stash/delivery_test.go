@@ -0,0 +1,86 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestDeliveryList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1/latest").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook_latest.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Deliveries.List(context.Background(), "PRJ/my-repo", "1", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.WebhookDelivery{}
+	raw, _ := ioutil.ReadFile("testdata/webhook_latest.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeliveryListEmpty(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1/latest").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Deliveries.List(context.Background(), "PRJ/my-repo", "1", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 0 {
+		t.Errorf("Expected no deliveries, got %d", len(got))
+	}
+}
+
+func TestDeliveryPing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/test").
+		MatchParam("url", "http://example.com").
+		Reply(200).
+		Type("application/json").
+		BodyString("{}")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Deliveries.Ping(context.Background(), "PRJ/my-repo", "1")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expected all mocks to be called")
+	}
+}
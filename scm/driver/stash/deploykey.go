@@ -0,0 +1,102 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// deployKeyService manages the repository access keys of
+// Bitbucket Server.
+type deployKeyService struct {
+	client *wrapper
+}
+
+type accessKey struct {
+	Key struct {
+		ID    int    `json:"id,omitempty"`
+		Text  string `json:"text"`
+		Label string `json:"label,omitempty"`
+	} `json:"key"`
+	Permission string `json:"permission"`
+}
+
+type accessKeys struct {
+	pagination
+	Values []*accessKey `json:"values"`
+}
+
+func (s *deployKeyService) Find(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh/%s", namespace, name, id)
+	out := new(accessKey)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertAccessKey(out), res, err
+}
+
+func (s *deployKeyService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.DeployKey, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh?%s", namespace, name, encodeListOptions(opts))
+	out := new(accessKeys)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertAccessKeyList(out), res, nil
+}
+
+func (s *deployKeyService) Create(ctx context.Context, repo string, input *scm.DeployKeyInput) (*scm.DeployKey, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh", namespace, name)
+	in := new(accessKey)
+	in.Key.Text = input.Key
+	in.Key.Label = input.Title
+	in.Permission = convertAccessKeyPermission(input.ReadOnly)
+	out := new(accessKey)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertAccessKey(out), res, err
+}
+
+func (s *deployKeyService) Delete(ctx context.Context, repo, id string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh/%s", namespace, name, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *deployKeyService) Enable(ctx context.Context, repo, id string) (*scm.DeployKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func convertAccessKeyList(from *accessKeys) []*scm.DeployKey {
+	to := []*scm.DeployKey{}
+	for _, v := range from.Values {
+		to = append(to, convertAccessKey(v))
+	}
+	return to
+}
+
+func convertAccessKey(from *accessKey) *scm.DeployKey {
+	return &scm.DeployKey{
+		ID:       strconv.Itoa(from.Key.ID),
+		Title:    from.Key.Label,
+		Key:      from.Key.Text,
+		ReadOnly: from.Permission == "REPO_READ",
+	}
+}
+
+func convertAccessKeyPermission(readOnly bool) string {
+	if readOnly {
+		return "REPO_READ"
+	}
+	return "REPO_WRITE"
+}
@@ -0,0 +1,121 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestDeployKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/access_key.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.DeployKeys.Find(context.Background(), "PRJ/my-repo", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/access_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh").
+		Reply(200).
+		Type("application/json").
+		File("testdata/access_keys.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.DeployKeys.List(context.Background(), "PRJ/my-repo", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.DeployKey{}
+	raw, _ := ioutil.ReadFile("testdata/access_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh").
+		JSON(map[string]interface{}{
+			"key": map[string]string{
+				"text":  "ssh-rsa AAA... agent@example.com",
+				"label": "gitops-agent",
+			},
+			"permission": "REPO_READ",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/access_key.json")
+
+	input := &scm.DeployKeyInput{
+		Title:    "gitops-agent",
+		Key:      "ssh-rsa AAA... agent@example.com",
+		ReadOnly: true,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.DeployKeys.Create(context.Background(), "PRJ/my-repo", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployKey)
+	raw, _ := ioutil.ReadFile("testdata/access_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh/1").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.DeployKeys.Delete(context.Background(), "PRJ/my-repo", "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
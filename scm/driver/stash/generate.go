@@ -0,0 +1,204 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/jenkins-x/go-scm/pkg/hmac"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// Generate renders the webhook as the request bitbucket server
+// would send, signed with the secret.
+func (s *webhookService) Generate(hook scm.Webhook, secret string) (*http.Request, error) {
+	var event, guid string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event, guid, payload = "repo:refs_changed", v.GUID, convertFromPushHook(v)
+	case *scm.BranchHook:
+		event, payload = "repo:refs_changed", convertFromRefHook("BRANCH", "refs/heads/", v.Ref, v.Action, &v.Repo, &v.Sender)
+	case *scm.TagHook:
+		event, payload = "repo:refs_changed", convertFromRefHook("TAG", "refs/tags/", v.Ref, v.Action, &v.Repo, &v.Sender)
+	case *scm.PullRequestHook:
+		event = convertFromPullRequestAction(v.Action)
+		if event == "" {
+			return nil, scm.ErrNotSupported
+		}
+		payload = convertFromPullRequestHook(event, v)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if guid == "" {
+		guid = scm.NewDeliveryID()
+	}
+	header := http.Header{}
+	header.Set("X-Event-Key", event)
+	header.Set("X-Request-Id", guid)
+	if secret != "" {
+		header.Set("X-Hub-Signature", hmac.SignPrefix("sha256", data, []byte(secret)))
+	}
+	return scm.NewWebhookRequest("/", data, header)
+}
+
+func convertFromPushHook(from *scm.PushHook) *pushHook {
+	to := &pushHook{
+		EventKey:   "repo:refs_changed",
+		Actor:      convertFromUser(&from.Sender),
+		Repository: convertFromRepository(&from.Repo),
+	}
+	// the push hook has no commit details, the event date is
+	// parsed as the commit date.
+	if date := from.Commit.Author.Date; !date.IsZero() {
+		to.Date = date.UTC().Format("2006-01-02T15:04:05+0000")
+	}
+	c := &change{
+		RefID:    from.Ref,
+		FromHash: from.Before,
+		ToHash:   from.Commit.Sha,
+		Type:     "UPDATE",
+	}
+	c.Ref.ID = from.Ref
+	c.Ref.DisplayID = scm.TrimRef(from.Ref)
+	c.Ref.Type = "BRANCH"
+	if scm.IsTag(from.Ref) {
+		c.Ref.Type = "TAG"
+	}
+	to.Changes = append(to.Changes, c)
+	return to
+}
+
+func convertFromRefHook(refType, prefix string, ref scm.Reference, action scm.Action, repo *scm.Repository, sender *scm.User) *pushHook {
+	to := &pushHook{
+		EventKey:   "repo:refs_changed",
+		Actor:      convertFromUser(sender),
+		Repository: convertFromRepository(repo),
+	}
+	c := &change{
+		RefID:    prefix + ref.Name,
+		FromHash: scm.EmptyCommit,
+		ToHash:   ref.Sha,
+		Type:     "ADD",
+	}
+	if action == scm.ActionDelete {
+		c.FromHash, c.ToHash = ref.Sha, scm.EmptyCommit
+		c.Type = "DELETE"
+	}
+	c.Ref.ID = prefix + ref.Name
+	c.Ref.DisplayID = ref.Name
+	c.Ref.Type = refType
+	to.Changes = append(to.Changes, c)
+	return to
+}
+
+// helper function returns the event key of the pull request
+// action, or an empty string if the action has no event.
+func convertFromPullRequestAction(from scm.Action) string {
+	switch from {
+	case scm.ActionOpen:
+		return "pr:opened"
+	case scm.ActionClose:
+		return "pr:declined"
+	case scm.ActionMerge:
+		return "pr:merged"
+	case scm.ActionSync:
+		return "pr:from_ref_updated"
+	case scm.ActionUpdate:
+		return "pr:modified"
+	case scm.ActionDelete:
+		return "pr:deleted"
+	default:
+		return ""
+	}
+}
+
+func convertFromPullRequestHook(event string, from *scm.PullRequestHook) *pullRequestHook {
+	pr := &from.PullRequest
+	to := &pullRequestHook{
+		EventKey: event,
+		Actor:    convertFromUser(&from.Sender),
+		PullRequest: &pullRequest{
+			ID:          pr.Number,
+			Title:       pr.Title,
+			Description: pr.Body,
+			State:       "OPEN",
+			Open:        !pr.Closed,
+			Closed:      pr.Closed,
+			CreatedDate: pr.Created.Unix() * 1000,
+			UpdatedDate: pr.Updated.Unix() * 1000,
+			FromRef: prRepoRef{
+				ID:           "refs/heads/" + pr.Source,
+				DisplayID:    pr.Source,
+				LatestCommit: pr.Sha,
+				Repository:   *convertFromRepository(&pr.Head.Repo),
+			},
+			ToRef: prRepoRef{
+				ID:           "refs/heads/" + pr.Target,
+				DisplayID:    pr.Target,
+				LatestCommit: pr.Base.Sha,
+				Repository:   *convertFromRepository(&from.Repo),
+			},
+			Author: prUser{User: *convertFromUser(&pr.Author), Role: "AUTHOR"},
+		},
+	}
+	switch {
+	case pr.Merged:
+		to.PullRequest.State = "MERGED"
+	case pr.Closed:
+		to.PullRequest.State = "DECLINED"
+	}
+	for i := range pr.Reviewers {
+		to.PullRequest.Reviewers = append(to.PullRequest.Reviewers, prUser{
+			User: *convertFromUser(&pr.Reviewers[i]),
+			Role: "REVIEWER",
+		})
+	}
+	if pr.Link != "" {
+		to.PullRequest.Links.Self = []link{{Href: pr.Link}}
+	}
+	return to
+}
+
+func convertFromRepository(from *scm.Repository) *repository {
+	id, _ := strconv.Atoi(from.ID)
+	to := &repository{
+		Slug:   from.Name,
+		ID:     id,
+		Name:   from.Name,
+		ScmID:  "git",
+		State:  "AVAILABLE",
+		Public: !from.Private,
+	}
+	to.Project.Key = from.Namespace
+	if from.Link != "" {
+		to.Links.Self = []link{{Href: from.Link}}
+	}
+	if from.Clone != "" {
+		to.Links.Clone = append(to.Links.Clone, link{Href: from.Clone, Name: "http"})
+	}
+	if from.CloneSSH != "" {
+		to.Links.Clone = append(to.Links.Clone, link{Href: from.CloneSSH, Name: "ssh"})
+	}
+	return to
+}
+
+func convertFromUser(from *scm.User) *user {
+	return &user{
+		Name:         from.Login,
+		EmailAddress: from.Email,
+		ID:           from.ID,
+		DisplayName:  from.Name,
+		Active:       true,
+		Slug:         from.Login,
+		Type:         "NORMAL",
+	}
+}
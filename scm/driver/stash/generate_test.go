@@ -0,0 +1,41 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm/driver/internal/webhooktest"
+)
+
+func TestWebhookGenerate(t *testing.T) {
+	tests := []webhooktest.Test{
+		{Event: "repo:refs_changed", File: "push.json"},
+		{Event: "repo:refs_changed", File: "push_branch_create.json"},
+		{Event: "repo:refs_changed", File: "push_branch_delete.json"},
+		{Event: "repo:refs_changed", File: "push_tag_create.json"},
+		{Event: "repo:refs_changed", File: "push_tag_delete.json"},
+		{Event: "pr:opened", File: "pr_open.json"},
+		{Event: "pr:declined", File: "pr_declined.json"},
+		{Event: "pr:merged", File: "pr_merged.json"},
+		{Event: "pr:modified", File: "pr_modified.json"},
+		{Event: "pr:from_ref_updated", File: "pr_ref_updated.json"},
+		{Event: "pr:deleted", File: "pr_deleted.json"},
+	}
+	webhooktest.RoundTrip(t, new(webhookService), generateHeader, tests)
+}
+
+func TestWebhookGenerateNotSupported(t *testing.T) {
+	webhooktest.NotSupported(t, new(webhookService))
+}
+
+// generateHeader returns the headers Bitbucket Server sends with the event.
+func generateHeader(event string) http.Header {
+	header := http.Header{}
+	header.Set("X-Event-Key", event)
+	header.Set("X-Request-Id", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+	return header
+}
@@ -7,6 +7,7 @@ package stash
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -118,6 +119,151 @@ func (s *organizationService) ListMemberships(ctx context.Context, opts scm.List
 	return nil, nil, scm.ErrNotSupported
 }
 
+// ListHooks returns the project level webhooks.
+func (s *organizationService) ListHooks(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/webhooks?%s", org, encodeListOptions(opts))
+	out := new(hooks)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertHookList(out), res, err
+}
+
+// CreateHook creates a project level webhook.
+func (s *organizationService) CreateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/webhooks", org)
+	in := convertFromHookInput(input)
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	// bitbucket server does not send commit status events.
+	return convertHook(out), res, scm.CheckUnsupportedFields(&input.Events, "Status")
+}
+
+// UpdateHook updates a project level webhook.
+func (s *organizationService) UpdateHook(ctx context.Context, org string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/webhooks/%s", org, input.ID)
+	in := convertFromHookInput(input)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertHook(out), res, scm.CheckUnsupportedFields(&input.Events, "Status")
+}
+
+// DeleteHook deletes a project level webhook.
+func (s *organizationService) DeleteHook(ctx context.Context, org string, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/webhooks/%s", org, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// CreateTeam creates a group. Bitbucket Server groups are
+// global, so the organization is ignored.
+func (s *organizationService) CreateTeam(ctx context.Context, org string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/admin/groups?name=%s", url.QueryEscape(input.Name))
+	out := new(group)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertGroup(out), res, scm.CheckUnsupportedFields(input, "Slug", "Description", "Privacy", "ParentTeamID")
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, org, team string, input *scm.Team) (*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, org, team string) (*scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/admin/groups?name=%s", url.QueryEscape(team))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// AddTeamMember adds the user to the group. Groups have no
+// member roles, so the role is ignored.
+func (s *organizationService) AddTeamMember(ctx context.Context, org, team, user, role string) (*scm.Response, error) {
+	in := &groupUsersInput{
+		Group: team,
+		Users: []string{user},
+	}
+	return s.client.do(ctx, "POST", "rest/api/1.0/admin/groups/add-users", in, nil)
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, org, team, user string) (*scm.Response, error) {
+	in := &groupUserInput{
+		Context:  team,
+		ItemName: user,
+	}
+	return s.client.do(ctx, "POST", "rest/api/1.0/admin/groups/remove-user", in, nil)
+}
+
+func (s *organizationService) AddTeamRepository(ctx context.Context, org, team, repo, permission string) (*scm.Response, error) {
+	apiPerm := permissionToAPIString(permission, false)
+	if apiPerm == "" {
+		return nil, fmt.Errorf("unknown permission '%s'", permission)
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/permissions/groups?name=%s&permission=%s", namespace, name, url.QueryEscape(team), apiPerm)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *organizationService) RemoveTeamRepository(ctx context.Context, org, team, repo string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/permissions/groups?name=%s", namespace, name, url.QueryEscape(team))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// InviteMember grants the user a project permission, since
+// project membership is given by permissions. Members are
+// granted read access and admins admin access.
+func (s *organizationService) InviteMember(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	return s.SetMemberRole(ctx, org, user, role)
+}
+
+func (s *organizationService) RemoveMember(ctx context.Context, org, user string) (*scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/permissions/users?name=%s", org, url.QueryEscape(user))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) SetMemberRole(ctx context.Context, org, user, role string) (*scm.Response, error) {
+	perm := "PROJECT_READ"
+	if role == scm.AdminRole {
+		perm = "PROJECT_ADMIN"
+	}
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/permissions/users?name=%s&permission=%s", org, url.QueryEscape(user), perm)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *organizationService) ListOutsideCollaborators(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.TeamMember, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+type group struct {
+	Name      string `json:"name"`
+	Deletable bool   `json:"deletable"`
+}
+
+type groupUsersInput struct {
+	Group string   `json:"group"`
+	Users []string `json:"users"`
+}
+
+type groupUserInput struct {
+	Context  string `json:"context"`
+	ItemName string `json:"itemName"`
+}
+
+func convertGroup(from *group) *scm.Team {
+	return &scm.Team{
+		Name: from.Name,
+		Slug: from.Name,
+	}
+}
+
 func convertParticipantsToTeamMembers(from *participants) []*scm.TeamMember {
 	var teamMembers []*scm.TeamMember
 	for _, f := range from.Values {
@@ -150,3 +150,247 @@ func TestOrganizationIsAdmin(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestOrganizationHookList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/webhooks").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhooks.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.ListHooks(context.Background(), "PRJ", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Hook{}
+	raw, _ := ioutil.ReadFile("testdata/webhooks.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/webhooks").
+		Reply(201).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.CreateHook(context.Background(), "PRJ", &scm.HookInput{
+		Name:   "example",
+		Target: "http://example.com",
+		Secret: "12345",
+		Events: scm.HookEvents{Push: true},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/webhook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookCreateUnsupportedEvents(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/webhooks").
+		Reply(201).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.CreateHook(context.Background(), "PRJ", &scm.HookInput{
+		Target: "http://example.com",
+		Events: scm.HookEvents{Push: true, Status: true},
+	})
+	fields, ok := err.(*scm.UnsupportedFieldsError)
+	if !ok {
+		t.Fatalf("Expect UnsupportedFieldsError, got %v", err)
+	}
+	if diff := cmp.Diff([]string{"Status"}, fields.Fields); diff != "" {
+		t.Errorf("Unexpected unsupported fields")
+		t.Log(diff)
+	}
+	if got == nil {
+		t.Errorf("Expect the created hook")
+	}
+}
+
+func TestOrganizationHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/webhooks/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.UpdateHook(context.Background(), "PRJ", &scm.HookInput{ID: "1"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/webhook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/projects/PRJ/webhooks/1").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.DeleteHook(context.Background(), "PRJ", "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOrganizationTeamCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/admin/groups").
+		MatchParam("name", "developers").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/group.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.CreateTeam(context.Background(), "PRJ", &scm.Team{Name: "developers"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/group.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationTeamAddMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/admin/groups/add-users").
+		JSON(map[string]interface{}{"group": "developers", "users": []string{"jcitizen"}}).
+		Reply(200).
+		SetHeaders(mockHeaders)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.AddTeamMember(context.Background(), "PRJ", "developers", "jcitizen", scm.MemberRole)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOrganizationTeamRemoveMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/admin/groups/remove-user").
+		JSON(map[string]string{"context": "developers", "itemName": "jcitizen"}).
+		Reply(200).
+		SetHeaders(mockHeaders)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.RemoveTeamMember(context.Background(), "PRJ", "developers", "jcitizen")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOrganizationTeamAddRepository(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/groups").
+		MatchParam("name", "developers").
+		MatchParam("permission", "REPO_WRITE").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.AddTeamRepository(context.Background(), "PRJ", "developers", "PRJ/my-repo", scm.WritePermission)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOrganizationSetMemberRole(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/permissions/users").
+		MatchParam("name", "jcitizen").
+		MatchParam("permission", "PROJECT_ADMIN").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.SetMemberRole(context.Background(), "PRJ", "jcitizen", scm.AdminRole)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOrganizationRemoveMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/projects/PRJ/permissions/users").
+		MatchParam("name", "jcitizen").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.RemoveMember(context.Background(), "PRJ", "jcitizen")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOrganizationTeamUpdate(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Organizations.UpdateTeam(context.Background(), "PRJ", "developers", &scm.Team{Name: "devs"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
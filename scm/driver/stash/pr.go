@@ -301,6 +301,18 @@ func (s *pullService) ClearMilestone(ctx context.Context, repo string, prID int)
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) ListReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) CreateReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) DeleteReaction(ctx context.Context, repo string, number int, content scm.ReactionContent) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 type createPRInput struct {
 	Title       string           `json:"title,omitempty"`
 	Description string           `json:"description,omitempty"`
@@ -419,7 +431,8 @@ func convertPullRequest(from *pullRequest) *scm.PullRequest {
 
 type pullRequestComment struct {
 	Properties struct {
-		RepositoryID int `json:"repositoryId"`
+		RepositoryID int                `json:"repositoryId"`
+		Reactions    []*commentReaction `json:"reactions"`
 	} `json:"properties"`
 	ID                  int                  `json:"id"`
 	Version             int                  `json:"version"`
@@ -0,0 +1,281 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// branch restriction types managed by the branch protection
+// service.
+const (
+	restrictionReadOnly        = "read-only"
+	restrictionNoDeletes       = "no-deletes"
+	restrictionFastForwardOnly = "fast-forward-only"
+)
+
+type branchProtectionService struct {
+	client *wrapper
+}
+
+type refMatcher struct {
+	ID        string `json:"id"`
+	DisplayID string `json:"displayId,omitempty"`
+	Type      struct {
+		ID string `json:"id"`
+	} `json:"type"`
+}
+
+type restrictions struct {
+	pagination
+	Values []*restriction `json:"values"`
+}
+
+type restriction struct {
+	ID      int         `json:"id"`
+	Type    string      `json:"type"`
+	Matcher *refMatcher `json:"matcher"`
+	Users   []*user     `json:"users"`
+	Groups  []string    `json:"groups"`
+}
+
+type restrictionInput struct {
+	Type    string      `json:"type"`
+	Matcher *refMatcher `json:"matcher"`
+	Users   []string    `json:"users"`
+	Groups  []string    `json:"groups"`
+}
+
+type buildConditions struct {
+	pagination
+	Values []*buildCondition `json:"values"`
+}
+
+type buildCondition struct {
+	ID              int         `json:"id,omitempty"`
+	BuildParentKeys []string    `json:"buildParentKeys"`
+	RefMatcher      *refMatcher `json:"refMatcher"`
+}
+
+type pullRequestSettings struct {
+	RequiredApprovers int `json:"requiredApprovers"`
+}
+
+func (s *branchProtectionService) Find(ctx context.Context, repo, branch string) (*scm.BranchProtection, *scm.Response, error) {
+	matcher := convertRefMatcher(branch)
+	list, res, err := s.listRestrictions(ctx, repo, matcher)
+	if err != nil {
+		return nil, res, err
+	}
+	condition, res, err := s.findCondition(ctx, repo, matcher)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(list) == 0 && condition == nil {
+		return nil, res, scm.ErrNotFound
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/settings/pull-requests", namespace, name)
+	settings := new(pullRequestSettings)
+	res, err = s.client.do(ctx, "GET", path, nil, settings)
+	if err != nil {
+		return nil, res, err
+	}
+	to := &scm.BranchProtection{
+		Branch:            branch,
+		RequiredApprovals: settings.RequiredApprovers,
+		AllowForcePush:    true,
+		AllowDeletion:     true,
+	}
+	if condition != nil {
+		to.RequiredStatusChecks = condition.BuildParentKeys
+	}
+	for _, r := range list {
+		switch r.Type {
+		case restrictionReadOnly:
+			to.Push = &scm.BranchRestrictions{Teams: r.Groups}
+			for _, u := range r.Users {
+				to.Push.Users = append(to.Push.Users, u.Name)
+			}
+		case restrictionFastForwardOnly:
+			to.AllowForcePush = false
+		case restrictionNoDeletes:
+			to.AllowDeletion = false
+		}
+	}
+	return to, res, nil
+}
+
+// Set replaces the branch restrictions and required builds
+// of the branch. The required approvals are a merge check of
+// the repository, and apply to all branches.
+func (s *branchProtectionService) Set(ctx context.Context, repo string, input *scm.BranchProtection) (*scm.BranchProtection, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	matcher := convertRefMatcher(input.Branch)
+	res, err := s.deleteRestrictions(ctx, repo, matcher)
+	if err != nil {
+		return nil, res, err
+	}
+
+	path := fmt.Sprintf("rest/branch-permissions/2.0/projects/%s/repos/%s/restrictions", namespace, name)
+	var in []*restrictionInput
+	if v := input.Push; v != nil {
+		in = append(in, &restrictionInput{Type: restrictionReadOnly, Users: v.Users, Groups: v.Teams})
+	}
+	if !input.AllowForcePush {
+		in = append(in, &restrictionInput{Type: restrictionFastForwardOnly})
+	}
+	if !input.AllowDeletion {
+		in = append(in, &restrictionInput{Type: restrictionNoDeletes})
+	}
+	for _, r := range in {
+		r.Matcher = matcher
+		if res, err = s.client.do(ctx, "POST", path, r, nil); err != nil {
+			return nil, res, err
+		}
+	}
+
+	condition, res, err := s.findCondition(ctx, repo, matcher)
+	if err != nil {
+		return nil, res, err
+	}
+	conditionIn := &buildCondition{
+		BuildParentKeys: input.RequiredStatusChecks,
+		RefMatcher:      matcher,
+	}
+	switch {
+	case condition != nil && len(input.RequiredStatusChecks) == 0:
+		path = fmt.Sprintf("rest/required-builds/latest/projects/%s/repos/%s/condition/%d", namespace, name, condition.ID)
+		res, err = s.client.do(ctx, "DELETE", path, nil, nil)
+	case condition != nil:
+		path = fmt.Sprintf("rest/required-builds/latest/projects/%s/repos/%s/condition/%d", namespace, name, condition.ID)
+		res, err = s.client.do(ctx, "PUT", path, conditionIn, nil)
+	case len(input.RequiredStatusChecks) > 0:
+		path = fmt.Sprintf("rest/required-builds/latest/projects/%s/repos/%s/condition", namespace, name)
+		res, err = s.client.do(ctx, "POST", path, conditionIn, nil)
+	}
+	if err != nil {
+		return nil, res, err
+	}
+
+	path = fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/settings/pull-requests", namespace, name)
+	settings := &pullRequestSettings{RequiredApprovers: input.RequiredApprovals}
+	res, err = s.client.do(ctx, "POST", path, settings, settings)
+	if err != nil {
+		return nil, res, err
+	}
+
+	to := &scm.BranchProtection{
+		Branch:               input.Branch,
+		RequiredStatusChecks: input.RequiredStatusChecks,
+		RequiredApprovals:    settings.RequiredApprovers,
+		Push:                 input.Push,
+		AllowForcePush:       input.AllowForcePush,
+		AllowDeletion:        input.AllowDeletion,
+	}
+	return to, res, scm.CheckUnsupportedFields(input,
+		"StrictStatusChecks",
+		"DismissStaleReviews",
+		"RequireCodeOwnerReview",
+		"Merge",
+		"RequireLinearHistory",
+	)
+}
+
+// Delete removes the branch restrictions and required builds
+// of the branch. The required approvals of the repository are
+// left unchanged.
+func (s *branchProtectionService) Delete(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	matcher := convertRefMatcher(branch)
+	res, err := s.deleteRestrictions(ctx, repo, matcher)
+	if err != nil {
+		return res, err
+	}
+	condition, res, err := s.findCondition(ctx, repo, matcher)
+	if err != nil || condition == nil {
+		return res, err
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/required-builds/latest/projects/%s/repos/%s/condition/%d", namespace, name, condition.ID)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function returns the restrictions managed by the
+// branch protection service for the ref matcher.
+func (s *branchProtectionService) listRestrictions(ctx context.Context, repo string, matcher *refMatcher) ([]*restriction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	params := url.Values{}
+	params.Set("matcherType", matcher.Type.ID)
+	params.Set("matcherId", matcher.ID)
+	params.Set("limit", "100")
+	path := fmt.Sprintf("rest/branch-permissions/2.0/projects/%s/repos/%s/restrictions?%s", namespace, name, params.Encode())
+	out := new(restrictions)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	var list []*restriction
+	for _, r := range out.Values {
+		switch r.Type {
+		case restrictionReadOnly, restrictionNoDeletes, restrictionFastForwardOnly:
+			list = append(list, r)
+		}
+	}
+	return list, res, nil
+}
+
+func (s *branchProtectionService) deleteRestrictions(ctx context.Context, repo string, matcher *refMatcher) (*scm.Response, error) {
+	list, res, err := s.listRestrictions(ctx, repo, matcher)
+	if err != nil {
+		return res, err
+	}
+	namespace, name := scm.Split(repo)
+	for _, r := range list {
+		path := fmt.Sprintf("rest/branch-permissions/2.0/projects/%s/repos/%s/restrictions/%d", namespace, name, r.ID)
+		if res, err = s.client.do(ctx, "DELETE", path, nil, nil); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// helper function returns the required builds condition of
+// the ref matcher, or nil if there is none.
+func (s *branchProtectionService) findCondition(ctx context.Context, repo string, matcher *refMatcher) (*buildCondition, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/required-builds/latest/projects/%s/repos/%s/conditions?limit=100", namespace, name)
+	out := new(buildConditions)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, c := range out.Values {
+		if c.RefMatcher != nil && c.RefMatcher.ID == matcher.ID {
+			return c, res, nil
+		}
+	}
+	return nil, res, nil
+}
+
+// helper function returns the ref matcher of the branch name
+// or pattern.
+func convertRefMatcher(branch string) *refMatcher {
+	to := new(refMatcher)
+	if strings.Contains(branch, "*") {
+		to.ID = branch
+		to.DisplayID = branch
+		to.Type.ID = "PATTERN"
+		return to
+	}
+	to.ID = "refs/heads/" + branch
+	to.DisplayID = branch
+	to.Type.ID = "BRANCH"
+	return to
+}
@@ -0,0 +1,211 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestBranchProtectionFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		MatchParam("matcherType", "BRANCH").
+		MatchParam("matcherId", "refs/heads/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/required-builds/latest/projects/PRJ/repos/my-repo/conditions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/build_conditions.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/settings/pull-requests").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_settings.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.BranchProtections.Find(context.Background(), "PRJ/my-repo", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestBranchProtectionFind_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"size":0,"limit":100,"isLastPage":true,"values":[],"start":0}`)
+
+	gock.New("http://example.com:7990").
+		Get("/rest/required-builds/latest/projects/PRJ/repos/my-repo/conditions").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"size":0,"limit":100,"isLastPage":true,"values":[],"start":0}`)
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.BranchProtections.Find(context.Background(), "PRJ/my-repo", "develop")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect ErrNotFound, got %v", err)
+	}
+}
+
+func TestBranchProtectionSet(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions/1").
+		Reply(204)
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions/2").
+		Reply(204)
+
+	gock.New("http://example.com:7990").
+		Post("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		JSON(map[string]interface{}{
+			"type": "read-only",
+			"matcher": map[string]interface{}{
+				"id":        "refs/heads/master",
+				"displayId": "master",
+				"type":      map[string]interface{}{"id": "BRANCH"},
+			},
+			"users":  []string{"jcitizen"},
+			"groups": []string{"release-managers"},
+		}).
+		Reply(200).
+		Type("application/json")
+
+	gock.New("http://example.com:7990").
+		Post("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		JSON(map[string]interface{}{
+			"type": "no-deletes",
+			"matcher": map[string]interface{}{
+				"id":        "refs/heads/master",
+				"displayId": "master",
+				"type":      map[string]interface{}{"id": "BRANCH"},
+			},
+			"users":  nil,
+			"groups": nil,
+		}).
+		Reply(200).
+		Type("application/json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/required-builds/latest/projects/PRJ/repos/my-repo/conditions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/build_conditions.json")
+
+	gock.New("http://example.com:7990").
+		Put("/rest/required-builds/latest/projects/PRJ/repos/my-repo/condition/7").
+		Reply(200).
+		Type("application/json")
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/settings/pull-requests").
+		JSON(map[string]interface{}{"requiredApprovers": 2}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_settings.json")
+
+	input := &scm.BranchProtection{
+		Branch:               "master",
+		RequiredStatusChecks: []string{"ci/build", "ci/lint"},
+		RequiredApprovals:    2,
+		Push: &scm.BranchRestrictions{
+			Users: []string{"jcitizen"},
+			Teams: []string{"release-managers"},
+		},
+		AllowForcePush: true,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.BranchProtections.Set(context.Background(), "PRJ/my-repo", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests made")
+	}
+}
+
+func TestBranchProtectionDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions/1").
+		Reply(204)
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions/2").
+		Reply(204)
+
+	gock.New("http://example.com:7990").
+		Get("/rest/required-builds/latest/projects/PRJ/repos/my-repo/conditions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/build_conditions.json")
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/required-builds/latest/projects/PRJ/repos/my-repo/condition/7").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.BranchProtections.Delete(context.Background(), "PRJ/my-repo", "master")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests made")
+	}
+}
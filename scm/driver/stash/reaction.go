@@ -0,0 +1,125 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type emoticon struct {
+	Shortcut string `json:"shortcut"`
+	URL      string `json:"url"`
+	Value    string `json:"value"`
+}
+
+type commentReaction struct {
+	Emoticon emoticon `json:"emoticon"`
+	Users    []*user  `json:"users"`
+}
+
+type commentReactionOutput struct {
+	Emoticon emoticon `json:"emoticon"`
+	User     user     `json:"user"`
+}
+
+// ListCommentReactions returns the reactions on a pull request
+// comment. The reactions are properties of the comment, so the
+// list options are ignored.
+func (s *pullService) ListCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", namespace, name, number, id)
+	out := new(pullRequestComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCommentReactionList(out.Properties.Reactions), res, err
+}
+
+func (s *pullService) CreateCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/comment-likes/latest/projects/%s/repos/%s/pull-requests/%d/comments/%d/reactions/%s", namespace, name, number, id, convertReactionContent(content))
+	out := new(commentReactionOutput)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	return convertCommentReactionOutput(out), res, err
+}
+
+func (s *pullService) DeleteCommentReaction(ctx context.Context, repo string, number, id int, content scm.ReactionContent) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/comment-likes/latest/projects/%s/repos/%s/pull-requests/%d/comments/%d/reactions/%s", namespace, name, number, id, convertReactionContent(content))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function returns one reaction for each user of each
+// emoticon, since Bitbucket groups reactions by emoticon.
+func convertCommentReactionList(from []*commentReaction) []*scm.Reaction {
+	to := []*scm.Reaction{}
+	for _, r := range from {
+		for _, u := range r.Users {
+			to = append(to, &scm.Reaction{
+				Content: convertEmoticon(r.Emoticon.Shortcut),
+				Author:  *convertUser(u),
+			})
+		}
+	}
+	return to
+}
+
+func convertCommentReactionOutput(from *commentReactionOutput) *scm.Reaction {
+	return &scm.Reaction{
+		Content: convertEmoticon(from.Emoticon.Shortcut),
+		Author:  *convertUser(&from.User),
+	}
+}
+
+// helper function returns the emoticon shortcut of the
+// reaction content.
+func convertReactionContent(from scm.ReactionContent) string {
+	switch from {
+	case scm.ReactionPlusOne:
+		return "thumbsup"
+	case scm.ReactionMinusOne:
+		return "thumbsdown"
+	case scm.ReactionLaugh:
+		return "laughing"
+	case scm.ReactionConfused:
+		return "confused"
+	case scm.ReactionHeart:
+		return "heart"
+	case scm.ReactionHooray:
+		return "tada"
+	case scm.ReactionRocket:
+		return "rocket"
+	case scm.ReactionEyes:
+		return "eyes"
+	default:
+		return ""
+	}
+}
+
+// helper function returns the reaction content of the
+// emoticon shortcut.
+func convertEmoticon(from string) scm.ReactionContent {
+	switch from {
+	case "thumbsup":
+		return scm.ReactionPlusOne
+	case "thumbsdown":
+		return scm.ReactionMinusOne
+	case "laughing":
+		return scm.ReactionLaugh
+	case "confused":
+		return scm.ReactionConfused
+	case "heart":
+		return scm.ReactionHeart
+	case "tada":
+		return scm.ReactionHooray
+	case "rocket":
+		return scm.ReactionRocket
+	case "eyes":
+		return scm.ReactionEyes
+	default:
+		return scm.ReactionUnknown
+	}
+}
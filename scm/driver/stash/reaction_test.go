@@ -0,0 +1,90 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestPullListCommentReactions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_comment_reactions.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.PullRequests.ListCommentReactions(context.Background(), "PRJ/my-repo", 1, 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reaction{}
+	raw, _ := ioutil.ReadFile("testdata/pr_comment_reactions.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullCreateCommentReaction(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/comment-likes/latest/projects/PRJ/repos/my-repo/pull-requests/1/comments/1/reactions/eyes").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_comment_reaction.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.PullRequests.CreateCommentReaction(context.Background(), "PRJ/my-repo", 1, 1, scm.ReactionEyes)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reaction)
+	raw, _ := ioutil.ReadFile("testdata/pr_comment_reaction.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullDeleteCommentReaction(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/comment-likes/latest/projects/PRJ/repos/my-repo/pull-requests/1/comments/1/reactions/thumbsup").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.DeleteCommentReaction(context.Background(), "PRJ/my-repo", 1, 1, scm.ReactionPlusOne)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPullCreateReaction_NotSupported(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.PullRequests.CreateReaction(context.Background(), "PRJ/my-repo", 1, scm.ReactionEyes)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect ErrNotSupported, got %v", err)
+	}
+}
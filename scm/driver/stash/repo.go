@@ -32,8 +32,9 @@ type repository struct {
 			Self []link `json:"self"`
 		} `json:"links"`
 	} `json:"project"`
-	Public bool `json:"public"`
-	Links  struct {
+	Public   bool `json:"public"`
+	Archived bool `json:"archived"`
+	Links    struct {
 		Clone []link `json:"clone"`
 		Self  []link `json:"self"`
 	} `json:"links"`
@@ -136,6 +137,18 @@ func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInp
 	return convertRepository(out), res, err
 }
 
+type repoSettings struct {
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Public      *bool             `json:"public,omitempty"`
+	Archived    *bool             `json:"archived,omitempty"`
+	Project     *forkProjectInput `json:"project,omitempty"`
+}
+
+type defaultBranchInput struct {
+	ID string `json:"id"`
+}
+
 type forkProjectInput struct {
 	Key string `json:"key,omitempty"`
 }
@@ -277,6 +290,18 @@ func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions)
 	return nil, nil, nil
 }
 
+func (s *repositoryService) CreateLabel(context.Context, string, *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) UpdateLabel(context.Context, string, string, *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteLabel(context.Context, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 // Find returns the repository by name.
 func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Repository, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
@@ -394,22 +419,38 @@ func (s *repositoryService) ListStatus(ctx context.Context, _, ref string, opts
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks", namespace, name)
-	in := new(hookInput)
-	in.URL = input.Target
-	in.Active = true
-	in.Name = input.Name
-	in.Config.Secret = input.Secret
-	in.Events = append(
-		input.NativeEvents,
-		convertHookEvents(input.Events)...,
-	)
+	in := convertFromHookInput(input)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
-	return convertHook(out), res, err
+	if err != nil {
+		return nil, res, err
+	}
+	// bitbucket server does not send commit status events.
+	return convertHook(out), res, scm.CheckUnsupportedFields(&input.Events, "Status")
 }
 
+// UpdateHook updates a repository webhook.
 func (s *repositoryService) UpdateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/%s", namespace, name, input.ID)
+	in := convertFromHookInput(input)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertHook(out), res, scm.CheckUnsupportedFields(&input.Events, "Status")
+}
+
+// EnsureHook makes sure exactly one repository webhook
+// targets the input url with the input events.
+func (s *repositoryService) EnsureHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.EnsureHookResult, *scm.Response, error) {
+	// bitbucket server hooks do not support skipping tls
+	// verification.
+	in := *input
+	in.SkipVerify = false
+	events := append(input.NativeEvents, convertHookEvents(input.Events)...)
+	return scm.EnsureHook(ctx, s, repo, &in, events)
 }
 
 // CreateStatus creates a new commit status.
@@ -439,8 +480,78 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
-func (s *repositoryService) Delete(context.Context, string) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", namespace, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Update updates the repository settings. Public repositories
+// allow anonymous read access.
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositorySettings) (*scm.Repository, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	if input.DefaultBranch != "" {
+		path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches/default", namespace, name)
+		in := &defaultBranchInput{ID: scm.ExpandRef(input.DefaultBranch, "refs/heads")}
+		res, err := s.client.do(ctx, "PUT", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	in := &repoSettings{
+		Description: input.Description,
+		Archived:    input.Archived,
+	}
+	unsupported := []string{
+		"Homepage",
+		"AllowMergeCommit",
+		"AllowSquashMerge",
+		"AllowRebaseMerge",
+		"DeleteBranchOnMerge",
+		"HasIssues",
+		"HasWiki",
+		"Topics",
+	}
+	switch input.Visibility {
+	case scm.VisibilityPublic, scm.VisibilityPrivate:
+		public := input.Visibility == scm.VisibilityPublic
+		in.Public = &public
+	case scm.VisibilityInternal:
+		unsupported = append(unsupported, "Visibility")
+	}
+	if *in == (repoSettings{}) {
+		out, res, err := s.Find(ctx, repo)
+		if err != nil {
+			return nil, res, err
+		}
+		return out, res, scm.CheckUnsupportedFields(input, unsupported...)
+	}
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", namespace, name)
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertRepository(out), res, scm.CheckUnsupportedFields(input, unsupported...)
+}
+
+// Rename renames the repository, which also changes its slug.
+func (s *repositoryService) Rename(ctx context.Context, repo, newName string) (*scm.Repository, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", namespace, name)
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, &repoSettings{Name: newName}, out)
+	return convertRepository(out), res, err
+}
+
+// Transfer moves the repository to another project.
+func (s *repositoryService) Transfer(ctx context.Context, repo, project string) (*scm.Repository, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", namespace, name)
+	in := &repoSettings{Project: &forkProjectInput{Key: project}}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertRepository(out), res, err
 }
 
 // helper function to convert from the gogs repository list to
@@ -464,6 +575,7 @@ func convertRepository(from *repository) *scm.Repository {
 		Link:      extractSelfLink(from.Links.Self),
 		Branch:    "master",
 		Private:   !from.Public,
+		Archived:  from.Archived,
 		CloneSSH:  extractLink(from.Links.Clone, "ssh"),
 		Clone:     anonymizeLink(extractLink(from.Links.Clone, "http")),
 	}
@@ -512,6 +624,19 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertFromHookInput(from *scm.HookInput) *hookInput {
+	to := new(hookInput)
+	to.URL = from.Target
+	to.Active = true
+	to.Name = from.Name
+	to.Config.Secret = from.Secret
+	to.Events = append(
+		from.NativeEvents,
+		convertHookEvents(from.Events)...,
+	)
+	return to
+}
+
 func convertHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push || from.Branch || from.Tag {
@@ -530,6 +655,12 @@ func convertHookEvents(from scm.HookEvents) []string {
 		events = append(events, "pr:comment:deleted")
 		events = append(events, "pr:comment:edited")
 	}
+	if from.Fork {
+		events = append(events, "repo:forked")
+	}
+	if from.Repository {
+		events = append(events, "repo:modified")
+	}
 	return events
 }
 
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -486,6 +487,47 @@ func TestRepositoryHookCreate(t *testing.T) {
 	}
 }
 
+func TestRepositoryHookCreateUnsupportedEvents(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			in := new(hookInput)
+			if err := json.NewDecoder(req.Body).Decode(in); err != nil {
+				return false, err
+			}
+			return cmp.Equal(in.Events, []string{"repo:forked", "repo:modified"}), nil
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.CreateHook(context.Background(), "PRJ/my-repo", &scm.HookInput{
+		Target: "http://example.com",
+		Events: scm.HookEvents{
+			Fork:       true,
+			Repository: true,
+			Status:     true,
+		},
+	})
+	fields, ok := err.(*scm.UnsupportedFieldsError)
+	if !ok {
+		t.Fatalf("Expect UnsupportedFieldsError, got %v", err)
+	}
+	if diff := cmp.Diff([]string{"Status"}, fields.Fields); diff != "" {
+		t.Errorf("Unexpected unsupported fields")
+		t.Log(diff)
+	}
+	if got == nil {
+		t.Errorf("Expect the created hook")
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests made")
+	}
+}
+
 func TestConvertFromState(t *testing.T) {
 	tests := []struct {
 		src scm.State
@@ -527,6 +569,75 @@ func TestConvertFromState(t *testing.T) {
 	}
 }
 
+func TestRepositoryHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.UpdateHook(context.Background(), "PRJ/my-repo", &scm.HookInput{
+		ID:     "1",
+		Name:   "example",
+		Target: "http://example.com",
+		Secret: "12345",
+		Events: scm.HookEvents{
+			PullRequest: true,
+			Push:        true,
+		},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/webhook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryHookEnsure(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhooks.json")
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.EnsureHook(context.Background(), "PRJ/my-repo", &scm.HookInput{
+		Name:   "example",
+		Target: "http://example.com",
+		Secret: "12345",
+		Events: scm.HookEvents{Push: true},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got.Updated || got.Created || len(got.Deleted) != 0 {
+		t.Errorf("Want updated hook, got %+v", got)
+	}
+	if got, want := got.Hook.ID, "1"; got != want {
+		t.Errorf("Want hook ID %q, got %q", want, got)
+	}
+}
+
 func TestConvertState(t *testing.T) {
 	tests := []struct {
 		src string
@@ -614,3 +725,81 @@ func TestRepositoryService_AddCollaborator(t *testing.T) {
 		t.Errorf("Expected collaborator to not already exist")
 	}
 }
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/branches/default").
+		JSON(map[string]string{"id": "refs/heads/main"}).
+		Reply(204)
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		JSON(map[string]interface{}{"public": true}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	in := &scm.RepositorySettings{
+		Visibility:    scm.VisibilityPublic,
+		DefaultBranch: "main",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.Update(context.Background(), "PRJ/my-repo", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryTransfer(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/OLD/repos/my-repo").
+		JSON(map[string]interface{}{"project": map[string]string{"key": "PRJ"}}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.Transfer(context.Background(), "OLD/my-repo", "PRJ")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.FullName, "PRJ/my-repo"; got != want {
+		t.Errorf("Want repository %s, got %s", want, got)
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		Reply(202)
+
+	client, _ := New("http://example.com:7990")
+	res, err := client.Repositories.Delete(context.Background(), "PRJ/my-repo")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 202; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"mime/multipart"
 	"net/url"
 	"strings"
 
@@ -38,7 +39,10 @@ func New(uri string) (*scm.Client, error) {
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverStash
+	client.BranchProtections = &branchProtectionService{client}
+	client.Checks = scm.NewStatusChecksService(client.Client)
 	client.Contents = &contentService{client}
+	client.DeployKeys = &deployKeyService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
 	client.Milestones = &milestoneService{client}
@@ -48,6 +52,7 @@ func New(uri string) (*scm.Client, error) {
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.Deliveries = &deliveryService{client}
 	return client.Client, nil
 }
 
@@ -73,7 +78,11 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	}
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
-	if in != nil {
+	if f, ok := in.(*form); ok {
+		f.writer.Close()
+		req.Header.Add("Content-Type", f.writer.FormDataContentType())
+		req.Body = &f.body
+	} else if in != nil {
 		buf := new(bytes.Buffer)
 		json.NewEncoder(buf).Encode(in) // #nosec
 		req.Header.Add("Content-Type", "application/json")
@@ -98,7 +107,8 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 		return res, err
 	}
 
-	if out == nil {
+	// no content responses have nothing to decode.
+	if out == nil || res.Status == 204 {
 		return res, nil
 	}
 
@@ -114,6 +124,23 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// form is a multipart form request body.
+type form struct {
+	body   bytes.Buffer
+	writer *multipart.Writer
+}
+
+func newForm() *form {
+	f := new(form)
+	f.writer = multipart.NewWriter(&f.body)
+	return f
+}
+
+func (f *form) add(name string, value []byte) {
+	w, _ := f.writer.CreateFormField(name)
+	w.Write(value) // #nosec
+}
+
 // pagination represents Bitbucket pagination properties
 // embedded in list responses.
 type pagination struct {
@@ -44,14 +44,22 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	var hook scm.Webhook
 	event := req.Header.Get("X-Event-Key")
 	switch event {
-	case "repo:refs_changed":
+	case "repo:refs_changed", "mirror:repo_synchronized":
 		hook, err = s.parsePushHook(data, guid)
-	case "pr:opened", "pr:declined", "pr:merged", "pr:from_ref_updated", "pr:modified":
+	case "repo:modified":
+		hook, err = s.parseRepositoryHook(data)
+	case "repo:forked":
+		hook, err = s.parseForkHook(data)
+	case "repo:comment:added":
+		hook, err = s.parseCommitComment(data, guid)
+	case "pr:opened", "pr:declined", "pr:merged", "pr:from_ref_updated", "pr:modified", "pr:deleted":
 		hook, err = s.parsePullRequest(data)
-	case "pr:comment:added", "pr:comment:edited":
+	case "pr:comment:added", "pr:comment:edited", "pr:comment:deleted":
 		hook, err = s.parsePullRequestComment(data, guid)
 	case "pr:reviewer:approved", "pr:reviewer:unapproved", "pr:reviewer:needs_work":
 		hook, err = s.parsePullRequestApproval(data)
+	case "pr:reviewer:updated":
+		hook, err = s.parsePullRequestReviewers(data)
 	default:
 		return nil, scm.UnknownWebhook{Event: event}
 	}
@@ -120,6 +128,8 @@ func (s *webhookService) parsePullRequest(data []byte) (scm.Webhook, error) {
 		dst.Action = scm.ActionSync
 	case "pr:modified":
 		dst.Action = scm.ActionUpdate
+	case "pr:deleted":
+		dst.Action = scm.ActionDelete
 	default:
 		return nil, nil
 	}
@@ -133,6 +143,67 @@ func (s *webhookService) parsePullRequestComment(data []byte, guid string) (scm.
 		return nil, err
 	}
 	dst := convertPullRequestCommentHook(src)
+	if src.EventKey == "pr:comment:deleted" {
+		dst.Action = scm.ActionDelete
+	}
+	dst.GUID = guid
+	return dst, nil
+}
+
+func (s *webhookService) parsePullRequestReviewers(data []byte) (scm.Webhook, error) {
+	src := new(pullRequestReviewersHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertPullRequestHook(&src.pullRequestHook)
+	dst.Changes.Reviewers.Added = convertUsers(src.AddedReviewers)
+	dst.Changes.Reviewers.Removed = convertUsers(src.RemovedReviewers)
+	switch {
+	case len(src.AddedReviewers) != 0 && len(src.RemovedReviewers) != 0:
+		dst.Action = scm.ActionReviewersUpdated
+	case len(src.AddedReviewers) != 0:
+		dst.Action = scm.ActionReviewRequested
+	default:
+		dst.Action = scm.ActionReviewRequestRemoved
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parseRepositoryHook(data []byte) (scm.Webhook, error) {
+	src := new(repositoryHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	if src.New == nil {
+		return nil, errors.New("Repository hook is missing the repository")
+	}
+	return convertRepositoryHook(src), nil
+}
+
+func (s *webhookService) parseForkHook(data []byte) (scm.Webhook, error) {
+	src := new(forkHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	if src.Repository == nil {
+		return nil, errors.New("Fork hook is missing the repository")
+	}
+	return convertForkHook(src), nil
+}
+
+func (s *webhookService) parseCommitComment(data []byte, guid string) (scm.Webhook, error) {
+	src := new(commitCommentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	if src.Repository == nil {
+		return nil, errors.New("Commit comment hook is missing the repository")
+	}
+	dst := convertCommitCommentHook(src)
 	dst.GUID = guid
 	return dst, nil
 }
@@ -189,6 +260,39 @@ type pullRequestCommentHook struct {
 	Comment     *prComment   `json:"comment"`
 }
 
+type pullRequestReviewersHook struct {
+	pullRequestHook
+	AddedReviewers   []*user `json:"addedReviewers"`
+	RemovedReviewers []*user `json:"removedReviewers"`
+}
+
+type repositoryHook struct {
+	EventKey string      `json:"eventKey"`
+	Date     string      `json:"date"`
+	Actor    *user       `json:"actor"`
+	Old      *repository `json:"old"`
+	New      *repository `json:"new"`
+}
+
+type forkHook struct {
+	EventKey   string `json:"eventKey"`
+	Date       string `json:"date"`
+	Actor      *user  `json:"actor"`
+	Repository *struct {
+		repository
+		Origin *repository `json:"origin"`
+	} `json:"repository"`
+}
+
+type commitCommentHook struct {
+	EventKey   string      `json:"eventKey"`
+	Date       string      `json:"date"`
+	Actor      *user       `json:"actor"`
+	Comment    *prComment  `json:"comment"`
+	Repository *repository `json:"repository"`
+	Commit     string      `json:"commit"`
+}
+
 type prComment struct {
 	ID        int    `json:"id"`
 	Version   int    `json:"version"`
@@ -226,7 +330,7 @@ type pullRequestApprovalHook struct {
 func convertPushHook(src *pushHook) *scm.PushHook {
 	change := src.Changes[0]
 	repo := convertRepository(src.Repository)
-	sender := convertUser(src.Actor)
+	sender := convertSender(src.Actor)
 	signer := convertSignature(src.Actor)
 	signer.Date, _ = time.Parse("2006-01-02T15:04:05+0000", src.Date)
 	sha := change.ToHash
@@ -241,13 +345,13 @@ func convertPushHook(src *pushHook) *scm.PushHook {
 		},
 		After:  sha,
 		Repo:   *repo,
-		Sender: *sender,
+		Sender: sender,
 	}
 }
 
 func convertTagHook(src *pushHook) *scm.TagHook {
 	change := src.Changes[0]
-	sender := convertUser(src.Actor)
+	sender := convertSender(src.Actor)
 	repo := convertRepository(src.Repository)
 
 	dst := &scm.TagHook{
@@ -257,7 +361,7 @@ func convertTagHook(src *pushHook) *scm.TagHook {
 		},
 		Action: scm.ActionCreate,
 		Repo:   *repo,
-		Sender: *sender,
+		Sender: sender,
 	}
 	if change.Type == "DELETE" {
 		dst.Action = scm.ActionDelete
@@ -268,7 +372,7 @@ func convertTagHook(src *pushHook) *scm.TagHook {
 
 func convertBranchHook(src *pushHook) *scm.BranchHook {
 	change := src.Changes[0]
-	sender := convertUser(src.Actor)
+	sender := convertSender(src.Actor)
 	repo := convertRepository(src.Repository)
 
 	dst := &scm.BranchHook{
@@ -278,7 +382,7 @@ func convertBranchHook(src *pushHook) *scm.BranchHook {
 		},
 		Action: scm.ActionCreate,
 		Repo:   *repo,
-		Sender: *sender,
+		Sender: sender,
 	}
 	if change.Type == "DELETE" {
 		dst.Action = scm.ActionDelete
@@ -287,7 +391,20 @@ func convertBranchHook(src *pushHook) *scm.BranchHook {
 	return dst
 }
 
+// convertSender returns the user that triggered the
+// event. Events raised by the server itself, such as
+// mirror synchronization, do not include an actor.
+func convertSender(actor *user) scm.User {
+	if actor == nil {
+		return scm.User{}
+	}
+	return *convertUser(actor)
+}
+
 func convertSignature(actor *user) scm.Signature {
+	if actor == nil {
+		return scm.Signature{}
+	}
 	return scm.Signature{
 		Name:   actor.DisplayName,
 		Email:  actor.EmailAddress,
@@ -317,6 +434,16 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 	}
 }
 
+func convertUsers(from []*user) []scm.User {
+	var to []scm.User
+	for _, v := range from {
+		if v != nil {
+			to = append(to, *convertUser(v))
+		}
+	}
+	return to
+}
+
 func convertPullRequestCommentHook(src *pullRequestCommentHook) *scm.PullRequestCommentHook {
 	toRepo := convertRepository(&src.PullRequest.ToRef.Repository)
 	fromRepo := convertRepository(&src.PullRequest.FromRef.Repository)
@@ -337,6 +464,46 @@ func convertPullRequestCommentHook(src *pullRequestCommentHook) *scm.PullRequest
 	}
 }
 
+func convertRepositoryHook(src *repositoryHook) *scm.RepositoryHook {
+	dst := &scm.RepositoryHook{
+		Action: scm.ActionUpdate,
+		Repo:   *convertRepository(src.New),
+		Sender: convertSender(src.Actor),
+	}
+	if src.Old != nil {
+		dst.Previous = convertRepository(src.Old)
+		if src.Old.Slug != src.New.Slug || src.Old.Name != src.New.Name {
+			dst.Action = scm.ActionRenamed
+		}
+	}
+	return dst
+}
+
+func convertForkHook(src *forkHook) *scm.ForkHook {
+	repo := &src.Repository.repository
+	if src.Repository.Origin != nil {
+		repo = src.Repository.Origin
+	}
+	return &scm.ForkHook{
+		Repo:   *convertRepository(repo),
+		Sender: convertSender(src.Actor),
+	}
+}
+
+func convertCommitCommentHook(src *commitCommentHook) *scm.CommitCommentHook {
+	author := src.Actor
+	if src.Comment != nil && src.Comment.Author != nil {
+		author = src.Comment.Author
+	}
+	return &scm.CommitCommentHook{
+		Action:  scm.ActionCreate,
+		Repo:    *convertRepository(src.Repository),
+		Commit:  scm.Commit{Sha: src.Commit},
+		Comment: convertComment(src.Comment),
+		Sender:  convertSender(author),
+	}
+}
+
 func convertComment(src *prComment) scm.Comment {
 	dst := scm.Comment{}
 	if src != nil {
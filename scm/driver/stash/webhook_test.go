@@ -6,6 +6,7 @@ package stash
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
 )
 
 func TestWebhooks(t *testing.T) {
@@ -37,6 +39,14 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/push.json.golden",
 			obj:    new(scm.PushHook),
 		},
+		// mirror synchronized
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "mirror:repo_synchronized",
+			before: "testdata/webhooks/mirror_repo_synchronized.json",
+			after:  "testdata/webhooks/mirror_repo_synchronized.json.golden",
+			obj:    new(scm.PushHook),
+		},
 
 		//
 		// tag events
@@ -124,6 +134,14 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_declined.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		// pull request deleted
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:deleted",
+			before: "testdata/webhooks/pr_deleted.json",
+			after:  "testdata/webhooks/pr_deleted.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
 		// pull request comment
 		{
 			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
@@ -132,6 +150,14 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_comment.json.golden",
 			obj:    new(scm.PullRequestCommentHook),
 		},
+		// pull request comment deleted
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:comment:deleted",
+			before: "testdata/webhooks/pr_comment_deleted.json",
+			after:  "testdata/webhooks/pr_comment_deleted.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
 		// pull request approved
 		{
 			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
@@ -156,6 +182,59 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_needs_work.json.golden",
 			obj:    new(scm.ReviewHook),
 		},
+		// pull request reviewer added
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:updated",
+			before: "testdata/webhooks/pr_reviewer_updated.json",
+			after:  "testdata/webhooks/pr_reviewer_updated.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		// pull request reviewer removed
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:updated",
+			before: "testdata/webhooks/pr_reviewer_removed.json",
+			after:  "testdata/webhooks/pr_reviewer_removed.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		// pull request reviewer added and removed
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:updated",
+			before: "testdata/webhooks/pr_reviewer_changed.json",
+			after:  "testdata/webhooks/pr_reviewer_changed.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+
+		//
+		// repository events
+		//
+
+		// repository renamed
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:modified",
+			before: "testdata/webhooks/repo_modified.json",
+			after:  "testdata/webhooks/repo_modified.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// repository forked
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:forked",
+			before: "testdata/webhooks/repo_forked.json",
+			after:  "testdata/webhooks/repo_forked.json.golden",
+			obj:    new(scm.ForkHook),
+		},
+		// commit comment
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:comment:added",
+			before: "testdata/webhooks/repo_comment_added.json",
+			after:  "testdata/webhooks/repo_comment_added.json.golden",
+			obj:    new(scm.CommitCommentHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -240,3 +319,102 @@ func TestWebhookVerified(t *testing.T) {
 func secretFunc(scm.Webhook) (string, error) {
 	return "71295b197fa25f4356d2fb9965df3f2379d903d7", nil
 }
+
+func TestWebhookEnrichPush(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/commits/131cb13f4aed12e725177bc4b7c28db67839bf9f").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/commits/131cb13f4aed12e725177bc4b7c28db67839bf9f/changes").
+		MatchParam("limit", "100").
+		Reply(200).
+		Type("application/json").
+		File("testdata/changes.json")
+
+	client, _ := New("http://example.com:7990")
+	hook := &scm.PushHook{
+		Ref:   "refs/heads/master",
+		After: "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+		Repo:  scm.Repository{Namespace: "PRJ", Name: "my-repo", FullName: "PRJ/my-repo"},
+		Commit: scm.Commit{
+			Sha: "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+		},
+		Commits: []scm.PushCommit{
+			{ID: "131cb13f4aed12e725177bc4b7c28db67839bf9f"},
+		},
+	}
+	if err := scm.EnrichPushHook(context.Background(), client.Git, hook); err != nil {
+		t.Fatal(err)
+	}
+
+	commit := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit.json.golden")
+	json.Unmarshal(raw, commit)
+
+	want := []scm.PushCommit{{
+		ID:        "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+		Message:   commit.Message,
+		Author:    commit.Author,
+		Committer: commit.Committer,
+		Timestamp: commit.Committer.Date,
+		Added:     []string{"README.md", "main.go"},
+		Removed:   []string{".gitignore", "README"},
+		Modified:  []string{"COPYING"},
+	}}
+	if diff := cmp.Diff(want, hook.Commits); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if got, want := hook.Commit.Message, commit.Message; got != want {
+		t.Errorf("Want head commit message %q, got %q", want, got)
+	}
+}
+
+// TestWebhookEnrichPushHead verifies only the head commit is
+// fetched for a push of several commits that lists none.
+func TestWebhookEnrichPushHead(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/commits/131cb13f4aed12e725177bc4b7c28db67839bf9f").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	client, _ := New("http://example.com:7990")
+	hook := &scm.PushHook{
+		Ref:    "refs/heads/master",
+		Before: "9cd6fc1bb2bdbe4f13c4c1e8b3a2f3eb40ee9e8f",
+		After:  "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+		Repo:   scm.Repository{Namespace: "PRJ", Name: "my-repo", FullName: "PRJ/my-repo"},
+	}
+	if err := scm.EnrichPushHook(context.Background(), client.Git, hook); err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests made")
+	}
+
+	commit := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit.json.golden")
+	json.Unmarshal(raw, commit)
+
+	if len(hook.Commits) != 0 {
+		t.Errorf("Want no commits, got %d commits", len(hook.Commits))
+	}
+	if got, want := hook.Commit.Sha, hook.After; got != want {
+		t.Errorf("Want head commit sha %q, got %q", want, got)
+	}
+	if got, want := hook.Commit.Message, commit.Message; got != want {
+		t.Errorf("Want head commit message %q, got %q", want, got)
+	}
+	if diff := cmp.Diff(commit.Author, hook.Commit.Author); diff != "" {
+		t.Errorf("Unexpected head commit author")
+		t.Log(diff)
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/pkg/errors"
+
+	"github.com/jenkins-x/go-scm/scm/capture"
+	"github.com/jenkins-x/go-scm/scm/factory"
+	"github.com/jenkins-x/go-scm/scm/factory/examples/helpers"
+)
+
+const usage = `usage:
+  webhooks list dir
+  webhooks show dir id
+  webhooks replay dir id driver [secret]
+  webhooks send dir id url [secret]
+
+GitLab tokens and secret query parameters are redacted when
+captured, pass the secret to restore them.
+`
+
+func main() {
+	args := os.Args
+	if len(args) < 3 {
+		fmt.Print(usage)
+		return
+	}
+	archive := capture.NewArchive(args[2])
+
+	switch {
+	case args[1] == "list":
+		deliveries, err := archive.List()
+		if err != nil {
+			helpers.Fail(err)
+			return
+		}
+		for _, d := range deliveries {
+			fmt.Printf("%s %s %s %s %d bytes\n", d.ID, d.Received.Format("2006-01-02T15:04:05Z"), d.Method, d.URL, len(d.Body))
+		}
+	case args[1] == "show" && len(args) > 3:
+		d := find(archive, args[3])
+		fmt.Printf("%s %s\n", d.Method, d.URL)
+		for k, v := range d.Header {
+			for _, vv := range v {
+				fmt.Printf("%s: %s\n", k, vv)
+			}
+		}
+		fmt.Printf("\n%s\n", string(d.Body))
+		if d.Truncated {
+			fmt.Printf("WARNING: body truncated to %d bytes\n", capture.MaxBodySize)
+		}
+	case args[1] == "replay" && len(args) > 4:
+		d := find(archive, args[3])
+		service, err := factory.NewWebHookService(args[4])
+		if err != nil {
+			helpers.Fail(err)
+			return
+		}
+		if service == nil {
+			helpers.Fail(fmt.Errorf("driver %s does not support webhooks", args[4]))
+			return
+		}
+		secret := ""
+		if len(args) > 5 {
+			secret = args[5]
+			d = withSecret(d, secret)
+		}
+		hook, err := capture.Parse(d, service, func(scm.Webhook) (string, error) {
+			return secret, nil
+		})
+		if err != nil {
+			helpers.Fail(errors.Wrap(err, "failed to parse delivery"))
+			return
+		}
+		data, err := yaml.Marshal(hook)
+		if err != nil {
+			helpers.Fail(errors.Wrap(err, "failed to marshal webhook as YAML"))
+			return
+		}
+		fmt.Printf("%s:\n%s\n", hook.Kind(), string(data))
+	case args[1] == "send" && len(args) > 4:
+		d := find(archive, args[3])
+		if len(args) > 5 {
+			d = withSecret(d, args[5])
+		}
+		req, err := d.Request()
+		if err != nil {
+			helpers.Fail(err)
+			return
+		}
+		target, err := url.Parse(args[4])
+		if err != nil {
+			helpers.Fail(errors.Wrapf(err, "failed to parse url %s", args[4]))
+			return
+		}
+		// keep the query of the delivery, some providers pass
+		// the secret as a query parameter.
+		if target.RawQuery == "" {
+			target.RawQuery = req.URL.RawQuery
+		}
+		req.URL = target
+		req.Host = target.Host
+		req.RequestURI = ""
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			helpers.Fail(err)
+			return
+		}
+		defer res.Body.Close()
+		body, _ := ioutil.ReadAll(res.Body)
+		fmt.Printf("%s\n%s\n", res.Status, string(body))
+	default:
+		fmt.Print(usage)
+	}
+}
+
+func find(archive *capture.Archive, id string) *capture.Delivery {
+	d, err := archive.Find(id)
+	if err != nil {
+		helpers.Fail(errors.Wrapf(err, "failed to find delivery %s", id))
+	}
+	return d
+}
+
+func withSecret(d *capture.Delivery, secret string) *capture.Delivery {
+	out, err := d.WithSecret(secret)
+	if err != nil {
+		helpers.Fail(errors.Wrapf(err, "failed to restore the secret of delivery %s", d.ID))
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+)
+
+// GenerateWebhook renders the webhook as the http request the
+// provider would send, signed with the secret, so it can be
+// used to test webhook consumers. ErrNotSupported is returned
+// if the driver cannot generate webhooks.
+func GenerateWebhook(client *Client, hook Webhook, secret string) (*http.Request, error) {
+	g, ok := client.Webhooks.(WebhookGenerator)
+	if !ok {
+		return nil, ErrNotSupported
+	}
+	return g.Generate(hook, secret)
+}
+
+// NewWebhookRequest returns a webhook POST request to the
+// target with the json payload and headers.
+func NewWebhookRequest(target string, payload []byte, header http.Header) (*http.Request, error) {
+	req, err := http.NewRequest("POST", target, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		for _, vv := range v {
+			req.Header.Add(k, vv)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+// NewDeliveryID returns a random uuid for use as a webhook
+// delivery id.
+func NewDeliveryID() string {
+	b := make([]byte, 16)
+	rand.Read(b) // #nosec
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
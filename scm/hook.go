@@ -0,0 +1,190 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"net/url"
+	"sort"
+)
+
+// SystemHookService provides access to instance wide webhooks
+// that receive events for every repository, eg GitLab system
+// hooks and Gitea system webhooks. Requires admin access.
+type SystemHookService interface {
+	// Find returns a system webhook.
+	Find(context.Context, string) (*Hook, *Response, error)
+
+	// List returns a list of system webhooks.
+	List(context.Context, ListOptions) ([]*Hook, *Response, error)
+
+	// Create creates a new system webhook.
+	Create(context.Context, *HookInput) (*Hook, *Response, error)
+
+	// Update updates a system webhook.
+	Update(context.Context, *HookInput) (*Hook, *Response, error)
+
+	// Delete deletes a system webhook.
+	Delete(context.Context, string) (*Response, error)
+}
+
+// EnsureHookResult reports the changes made by
+// RepositoryService.EnsureHook.
+type EnsureHookResult struct {
+	// Hook is the hook pointing at the target URL after
+	// reconciliation.
+	Hook *Hook
+
+	// Created is true if no hook pointed at the target URL
+	// and a new one was created.
+	Created bool
+
+	// Updated is true if an existing hook was updated to
+	// converge its events, settings or secret.
+	Updated bool
+
+	// Deleted lists the IDs of duplicate hooks that pointed
+	// at the target URL and were removed.
+	Deleted []string
+}
+
+// Changed returns true if EnsureHook modified any hooks.
+func (r *EnsureHookResult) Changed() bool {
+	return r.Created || r.Updated || len(r.Deleted) > 0
+}
+
+// EnsureHook makes sure the repository has exactly one hook
+// pointing at the input target with the given native events.
+// Hooks are matched by target URL, ignoring any secret query
+// parameter. The first matching hook is updated if its events,
+// active state or TLS verification differ and any further
+// matches are deleted. Hook secrets cannot be read back from
+// the providers, so the matching hook is always updated if the
+// input has a secret, to apply a rotated secret.
+//
+// An UnsupportedFieldsError from creating or updating the hook
+// is returned together with the result.
+//
+// Drivers use this to implement RepositoryService.EnsureHook,
+// passing the native events the input converts to.
+func EnsureHook(ctx context.Context, s RepositoryService, repo string, input *HookInput, events []string) (*EnsureHookResult, *Response, error) {
+	hooks, res, err := listAllHooks(ctx, s, repo)
+	if err != nil {
+		return nil, res, err
+	}
+
+	var matches []*Hook
+	for _, hook := range hooks {
+		if hookTargetEqual(hook.Target, input.Target) {
+			matches = append(matches, hook)
+		}
+	}
+
+	result := new(EnsureHookResult)
+	if len(matches) == 0 {
+		hook, res, err := s.CreateHook(ctx, repo, input)
+		if err != nil && !isUnsupportedFields(err) {
+			return nil, res, err
+		}
+		result.Hook = hook
+		result.Created = true
+		return result, res, err
+	}
+
+	for _, hook := range matches[1:] {
+		res, err = s.DeleteHook(ctx, repo, hook.ID)
+		if err != nil {
+			return result, res, err
+		}
+		result.Deleted = append(result.Deleted, hook.ID)
+	}
+
+	hook := matches[0]
+	result.Hook = hook
+	if input.Secret == "" && hook.Active && hook.SkipVerify == input.SkipVerify && hookEventsEqual(hook.Events, events) {
+		return result, res, nil
+	}
+
+	in := *input
+	in.ID = hook.ID
+	hook, res, err = s.UpdateHook(ctx, repo, &in)
+	if err != nil && !isUnsupportedFields(err) {
+		return result, res, err
+	}
+	result.Hook = hook
+	result.Updated = true
+	return result, res, err
+}
+
+// helper function returns true if the hook was saved without
+// the events the provider does not support.
+func isUnsupportedFields(err error) bool {
+	_, ok := err.(*UnsupportedFieldsError)
+	return ok
+}
+
+// helper function to list all repository hooks, following
+// pagination.
+func listAllHooks(ctx context.Context, s RepositoryService, repo string) ([]*Hook, *Response, error) {
+	var all []*Hook
+	opts := ListOptions{Page: 1, Size: 100}
+	for {
+		hooks, res, err := s.ListHooks(ctx, repo, opts)
+		if err != nil {
+			return nil, res, err
+		}
+		all = append(all, hooks...)
+		if res == nil || res.Page.Next <= opts.Page {
+			return all, res, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// helper function returns true if the hook targets are the
+// same url. The secret query parameter is ignored since some
+// providers embed the secret in the hook url.
+func hookTargetEqual(a, b string) bool {
+	return stripHookSecret(a) == stripHookSecret(b)
+}
+
+func stripHookSecret(target string) string {
+	u, err := url.Parse(target)
+	if err != nil {
+		return target
+	}
+	params := u.Query()
+	params.Del("secret")
+	u.RawQuery = params.Encode()
+	return u.String()
+}
+
+// helper function returns true if both lists contain the
+// same events, ignoring order and duplicates.
+func hookEventsEqual(a, b []string) bool {
+	a, b = uniqueSorted(a), uniqueSorted(b)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func uniqueSorted(in []string) []string {
+	set := map[string]struct{}{}
+	for _, s := range in {
+		set[s] = struct{}{}
+	}
+	out := make([]string, 0, len(set))
+	for s := range set {
+		out = append(out, s)
+	}
+	sort.Strings(out)
+	return out
+}
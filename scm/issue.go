@@ -62,6 +62,16 @@ type (
 		Updated time.Time
 	}
 
+	// Reaction represents an emoji reaction to an issue, pull
+	// request or comment. The ID is zero on providers that do
+	// not identify reactions.
+	Reaction struct {
+		ID      int
+		Content ReactionContent
+		Author  User
+		Created time.Time
+	}
+
 	// CommentInput provides the input fields required for
 	// creating an issue comment.
 	CommentInput struct {
@@ -147,6 +157,26 @@ type (
 
 		// ClearMilestone removes the milestone from an issue
 		ClearMilestone(ctx context.Context, repo string, id int) (*Response, error)
+
+		// ListReactions returns the reactions on an issue.
+		ListReactions(ctx context.Context, repo string, number int, opts ListOptions) ([]*Reaction, *Response, error)
+
+		// CreateReaction adds a reaction to an issue.
+		CreateReaction(ctx context.Context, repo string, number int, content ReactionContent) (*Reaction, *Response, error)
+
+		// DeleteReaction removes the reaction of the authenticated
+		// user from an issue.
+		DeleteReaction(ctx context.Context, repo string, number int, content ReactionContent) (*Response, error)
+
+		// ListCommentReactions returns the reactions on an issue comment.
+		ListCommentReactions(ctx context.Context, repo string, number, id int, opts ListOptions) ([]*Reaction, *Response, error)
+
+		// CreateCommentReaction adds a reaction to an issue comment.
+		CreateCommentReaction(ctx context.Context, repo string, number, id int, content ReactionContent) (*Reaction, *Response, error)
+
+		// DeleteCommentReaction removes the reaction of the
+		// authenticated user from an issue comment.
+		DeleteCommentReaction(ctx context.Context, repo string, number, id int, content ReactionContent) (*Response, error)
 	}
 )
 
@@ -0,0 +1,215 @@
+package labels
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// Label is a desired repository label. PreviousNames lists the
+// former names of the label, so an existing label is renamed
+// rather than recreated and stays on its issues and pull
+// requests.
+type Label struct {
+	Name          string   `json:"name"`
+	Color         string   `json:"color"`
+	Description   string   `json:"description,omitempty"`
+	PreviousNames []string `json:"previousNames,omitempty"`
+}
+
+// Config is the desired label set of a repository.
+type Config struct {
+	Labels []Label `json:"labels"`
+}
+
+// SyncOptions configures how labels are reconciled.
+type SyncOptions struct {
+	// Prune deletes the repository labels that are not in the
+	// desired label set.
+	Prune bool
+
+	// DryRun computes the changes without applying them.
+	DryRun bool
+}
+
+// SyncResult reports the label changes made by Sync. Renamed
+// maps the previous name of each renamed label to its new name.
+type SyncResult struct {
+	Created []string
+	Updated []string
+	Renamed map[string]string
+	Deleted []string
+}
+
+// LoadConfig reads the desired label set from a YAML file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read label config %s: %w", path, err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates the desired label set from
+// YAML.
+func ParseConfig(data []byte) (*Config, error) {
+	config := new(Config)
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse label config: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Validate returns an error if a label has no name, or if a
+// name or previous name is used more than once. Names are
+// compared case-insensitively.
+func (c *Config) Validate() error {
+	seen := map[string]bool{}
+	for _, l := range c.Labels {
+		if l.Name == "" {
+			return fmt.Errorf("label with color %q has no name", l.Color)
+		}
+		for _, name := range append([]string{l.Name}, l.PreviousNames...) {
+			key := strings.ToLower(name)
+			if seen[key] {
+				return fmt.Errorf("label name %q is used more than once", name)
+			}
+			seen[key] = true
+		}
+	}
+	return nil
+}
+
+// Sync reconciles the labels of the repository with the desired
+// labels. A desired label that does not exist is renamed from
+// its first existing previous name, or created otherwise.
+// Existing labels are updated when their name, color or
+// description differ, and deleted when pruning.
+func Sync(ctx context.Context, client *scm.Client, repo string, desired []Label, opts SyncOptions) (*SyncResult, error) {
+	config := &Config{Labels: desired}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	existing, err := listAll(ctx, client, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels of %s: %w", repo, err)
+	}
+	current := map[string]*scm.Label{}
+	for _, l := range existing {
+		current[strings.ToLower(l.Name)] = l
+	}
+
+	result := &SyncResult{Renamed: map[string]string{}}
+	matched := map[string]bool{}
+	for _, want := range desired {
+		input := &scm.LabelInput{
+			Name:        want.Name,
+			Color:       want.Color,
+			Description: want.Description,
+		}
+		if have, ok := current[strings.ToLower(want.Name)]; ok {
+			matched[strings.ToLower(have.Name)] = true
+			if !changed(have, want) {
+				continue
+			}
+			result.Updated = append(result.Updated, want.Name)
+			if opts.DryRun {
+				continue
+			}
+			if _, _, err := client.Repositories.UpdateLabel(ctx, repo, have.Name, input); err != nil {
+				return result, fmt.Errorf("failed to update label %s of %s: %w", have.Name, repo, err)
+			}
+			continue
+		}
+		if have := findPrevious(current, want); have != nil {
+			matched[strings.ToLower(have.Name)] = true
+			result.Renamed[have.Name] = want.Name
+			if opts.DryRun {
+				continue
+			}
+			if _, _, err := client.Repositories.UpdateLabel(ctx, repo, have.Name, input); err != nil {
+				return result, fmt.Errorf("failed to rename label %s of %s to %s: %w", have.Name, repo, want.Name, err)
+			}
+			continue
+		}
+		result.Created = append(result.Created, want.Name)
+		if opts.DryRun {
+			continue
+		}
+		if _, _, err := client.Repositories.CreateLabel(ctx, repo, input); err != nil {
+			return result, fmt.Errorf("failed to create label %s in %s: %w", want.Name, repo, err)
+		}
+	}
+
+	if !opts.Prune {
+		return result, nil
+	}
+	for _, l := range existing {
+		if matched[strings.ToLower(l.Name)] {
+			continue
+		}
+		result.Deleted = append(result.Deleted, l.Name)
+		if opts.DryRun {
+			continue
+		}
+		if _, err := client.Repositories.DeleteLabel(ctx, repo, l.Name); err != nil {
+			return result, fmt.Errorf("failed to delete label %s of %s: %w", l.Name, repo, err)
+		}
+	}
+	return result, nil
+}
+
+// helper function returns all the labels of the repository.
+func listAll(ctx context.Context, client *scm.Client, repo string) ([]*scm.Label, error) {
+	var all []*scm.Label
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		labels, res, err := client.Repositories.ListLabels(ctx, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, labels...)
+		if res == nil || res.Page.Next == 0 {
+			return all, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// helper function returns the existing label matching the
+// first previous name of the desired label, if any.
+func findPrevious(current map[string]*scm.Label, want Label) *scm.Label {
+	for _, name := range want.PreviousNames {
+		if have, ok := current[strings.ToLower(name)]; ok {
+			return have
+		}
+	}
+	return nil
+}
+
+// helper function returns true if the existing label differs
+// from the desired label. An empty desired color or description
+// is not compared, since updates leave empty fields unchanged.
+func changed(have *scm.Label, want Label) bool {
+	switch {
+	case have.Name != want.Name:
+		return true
+	case want.Color != "" && normalizeColor(have.Color) != normalizeColor(want.Color):
+		return true
+	case want.Description != "" && have.Description != want.Description:
+		return true
+	default:
+		return false
+	}
+}
+
+func normalizeColor(color string) string {
+	return strings.ToLower(strings.TrimPrefix(color, "#"))
+}
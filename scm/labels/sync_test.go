@@ -0,0 +1,103 @@
+package labels
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/fake"
+)
+
+func TestLoadConfig(t *testing.T) {
+	config, err := LoadConfig("testdata/labels.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Label{
+		{Name: "kind/bug", Color: "#d73a4a", Description: "Something isn't working", PreviousNames: []string{"bug"}},
+		{Name: "kind/feature", Color: "a2eeef", Description: "New feature or request", PreviousNames: []string{"enhancement", "feature"}},
+		{Name: "lgtm", Color: "15dd18"},
+	}
+	if !reflect.DeepEqual(config.Labels, want) {
+		t.Errorf("LoadConfig() got %#v, want %#v", config.Labels, want)
+	}
+}
+
+func TestParseConfig_Duplicate(t *testing.T) {
+	_, err := ParseConfig([]byte(`
+labels:
+  - name: bug
+  - name: kind/bug
+    previousNames: [Bug]
+`))
+	if err == nil {
+		t.Errorf("Expect error for duplicate label names")
+	}
+}
+
+func TestSync(t *testing.T) {
+	ctx := context.Background()
+	client, data := fake.NewDefault()
+	data.RepoLabelsExisting = []string{"bug", "enhancement", "lgtm", "wontfix"}
+	data.RepoLabels["lgtm"] = &scm.Label{Name: "lgtm", Color: "15DD18"}
+
+	config, err := LoadConfig("testdata/labels.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Sync(ctx, client, "org/repo", config.Labels, SyncOptions{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &SyncResult{
+		Renamed: map[string]string{"bug": "kind/bug", "enhancement": "kind/feature"},
+		Deleted: []string{"wontfix"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sync() got %#v, want %#v", got, want)
+	}
+
+	existing := append([]string{}, data.RepoLabelsExisting...)
+	sort.Strings(existing)
+	if want := []string{"kind/bug", "kind/feature", "lgtm"}; !reflect.DeepEqual(existing, want) {
+		t.Errorf("RepoLabelsExisting got %v, want %v", existing, want)
+	}
+	if color := data.RepoLabels["kind/bug"].Color; color != "d73a4a" {
+		t.Errorf("kind/bug color got %s, want d73a4a", color)
+	}
+
+	got, err = Sync(ctx, client, "org/repo", config.Labels, SyncOptions{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&SyncResult{Renamed: map[string]string{}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Sync() is not idempotent, got %#v", got)
+	}
+}
+
+func TestSync_DryRun(t *testing.T) {
+	ctx := context.Background()
+	client, data := fake.NewDefault()
+	data.RepoLabelsExisting = []string{"bug"}
+
+	desired := []Label{
+		{Name: "kind/bug", Color: "d73a4a", PreviousNames: []string{"bug"}},
+		{Name: "lgtm", Color: "15dd18"},
+	}
+	got, err := Sync(ctx, client, "org/repo", desired, SyncOptions{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &SyncResult{
+		Created: []string{"lgtm"},
+		Renamed: map[string]string{"bug": "kind/bug"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sync() got %#v, want %#v", got, want)
+	}
+	if want := []string{"bug"}; !reflect.DeepEqual(data.RepoLabelsExisting, want) {
+		t.Errorf("Expect no changes on dry run, got %v", data.RepoLabelsExisting)
+	}
+}
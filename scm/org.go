@@ -8,6 +8,16 @@ import (
 	"context"
 )
 
+const (
+	// MemberRole means the user is a regular member of the
+	// organization or team
+	MemberRole = "member"
+	// MaintainerRole means the user is a maintainer of the team
+	MaintainerRole = "maintainer"
+	// AdminRole means the user is an admin of the organization
+	AdminRole = "admin"
+)
+
 type (
 	// Organization represents an organization account.
 	Organization struct {
@@ -104,5 +114,63 @@ type (
 
 		// ListMemberships lists organisation memberships for the authenticated user
 		ListMemberships(ctx context.Context, opts ListOptions) ([]*Membership, *Response, error)
+
+		// ListHooks returns the organization webhooks.
+		ListHooks(ctx context.Context, org string, opts ListOptions) ([]*Hook, *Response, error)
+
+		// CreateHook creates an organization webhook.
+		// Events the provider cannot send are reported with
+		// an UnsupportedFieldsError once the hook is created.
+		CreateHook(ctx context.Context, org string, input *HookInput) (*Hook, *Response, error)
+
+		// UpdateHook updates an organization webhook.
+		UpdateHook(ctx context.Context, org string, input *HookInput) (*Hook, *Response, error)
+
+		// DeleteHook deletes an organization webhook.
+		DeleteHook(ctx context.Context, org string, id string) (*Response, error)
+
+		// CreateTeam creates a team in the organization.
+		CreateTeam(ctx context.Context, org string, input *Team) (*Team, *Response, error)
+
+		// UpdateTeam edits the team with the given slug.
+		// Empty input fields are left unchanged.
+		UpdateTeam(ctx context.Context, org, team string, input *Team) (*Team, *Response, error)
+
+		// DeleteTeam deletes the team with the given slug.
+		DeleteTeam(ctx context.Context, org, team string) (*Response, error)
+
+		// AddTeamMember adds the user to the team, or
+		// updates the role of an existing team member. The
+		// role is MemberRole or MaintainerRole.
+		AddTeamMember(ctx context.Context, org, team, user, role string) (*Response, error)
+
+		// RemoveTeamMember removes the user from the team.
+		RemoveTeamMember(ctx context.Context, org, team, user string) (*Response, error)
+
+		// AddTeamRepository grants the team a permission
+		// level on the repository, given by its full name.
+		AddTeamRepository(ctx context.Context, org, team, repo, permission string) (*Response, error)
+
+		// RemoveTeamRepository revokes the team access to
+		// the repository.
+		RemoveTeamRepository(ctx context.Context, org, team, repo string) (*Response, error)
+
+		// InviteMember invites the user to the organization
+		// with the given role, which is MemberRole or
+		// AdminRole.
+		InviteMember(ctx context.Context, org, user, role string) (*Response, error)
+
+		// RemoveMember removes the user from the
+		// organization, or cancels a pending invitation.
+		RemoveMember(ctx context.Context, org, user string) (*Response, error)
+
+		// SetMemberRole changes the organization role of
+		// the member.
+		SetMemberRole(ctx context.Context, org, user, role string) (*Response, error)
+
+		// ListOutsideCollaborators lists the users that are
+		// collaborators on organization repositories without
+		// being organization members.
+		ListOutsideCollaborators(ctx context.Context, org string, opts ListOptions) ([]*TeamMember, *Response, error)
 	}
 )
@@ -0,0 +1,112 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Pipeline represents a CI pipeline run, eg a GitLab
+	// pipeline.
+	Pipeline struct {
+		ID             int
+		Number         int
+		Status         State
+		DetailedStatus string
+		Ref            string
+		Tag            bool
+		Sha            string
+		BeforeSha      string
+		Source         string
+		Stages         []string
+		Link           string
+		Variables      map[string]string
+		Created        time.Time
+		Finished       time.Time
+		Duration       time.Duration
+		QueuedDuration time.Duration
+	}
+
+	// PipelineJob represents a single job of a CI pipeline,
+	// eg a GitLab build.
+	PipelineJob struct {
+		ID             int
+		PipelineID     int
+		Name           string
+		Stage          string
+		Status         State
+		DetailedStatus string
+		Ref            string
+		Tag            bool
+		Sha            string
+		Link           string
+		Runner         string
+		AllowFailure   bool
+		FailureReason  string
+		Environment    string
+		Created        time.Time
+		Started        time.Time
+		Finished       time.Time
+		Duration       time.Duration
+		QueuedDuration time.Duration
+	}
+
+	// PipelineListOptions provides options for querying a
+	// list of pipelines.
+	PipelineListOptions struct {
+		Ref    string
+		Sha    string
+		Status string
+		Page   int
+		Size   int
+	}
+
+	// PipelineInput provides the input fields required for
+	// triggering a pipeline.
+	PipelineInput struct {
+		Ref string
+
+		// Workflow is the workflow file name or id to dispatch
+		// on GitHub and Gitea Actions, or the custom pipeline
+		// name on Bitbucket. It is ignored by GitLab.
+		Workflow string
+
+		// Variables are passed as pipeline variables, or as the
+		// workflow_dispatch inputs on GitHub and Gitea Actions.
+		Variables map[string]string
+	}
+
+	// PipelineService provides access to CI pipelines. On
+	// GitLab the pipeline ID can be passed as the PipelineID
+	// of CommitStatusUpdateOptions to attach a commit status
+	// to the pipeline.
+	PipelineService interface {
+		// List returns a list of pipelines.
+		List(ctx context.Context, repo string, opts PipelineListOptions) ([]*Pipeline, *Response, error)
+
+		// Find returns the pipeline by id.
+		Find(ctx context.Context, repo string, id int) (*Pipeline, *Response, error)
+
+		// Trigger starts a new pipeline. GitHub and Gitea
+		// Actions do not report the dispatched run, in which
+		// case the returned pipeline is nil.
+		Trigger(ctx context.Context, repo string, input *PipelineInput) (*Pipeline, *Response, error)
+
+		// Cancel cancels the pipeline.
+		Cancel(ctx context.Context, repo string, id int) (*Response, error)
+
+		// Retry re-runs the pipeline, returning the retried
+		// pipeline which may have a new id.
+		Retry(ctx context.Context, repo string, id int) (*Pipeline, *Response, error)
+
+		// ListJobs returns the jobs of the pipeline.
+		ListJobs(ctx context.Context, repo string, id int, opts ListOptions) ([]*PipelineJob, *Response, error)
+
+		// JobLog returns the raw log output of the pipeline job.
+		JobLog(ctx context.Context, repo string, pipeline, job int) ([]byte, *Response, error)
+	}
+)
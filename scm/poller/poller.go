@@ -0,0 +1,364 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package poller provides a synthetic event source for
+// repositories that cannot be configured with webhooks.
+//
+// The poller periodically snapshots the branches, tags, pull
+// requests and pull request comments of a repository and emits
+// the difference with the previous snapshot as scm.Webhook
+// values, so they can be handled by the same code as parsed
+// webhooks. The first poll of a repository only records a
+// snapshot and emits no events.
+package poller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// Handler is called for every synthetic webhook.
+type Handler func(scm.Webhook) error
+
+// Poller polls repositories and emits synthetic webhooks.
+type Poller struct {
+	client  *scm.Client
+	store   Store
+	handler Handler
+}
+
+// New returns a Poller that uses the client to poll, the
+// store to persist snapshots and calls the handler for each
+// event.
+func New(client *scm.Client, store Store, handler Handler) *Poller {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	return &Poller{
+		client:  client,
+		store:   store,
+		handler: handler,
+	}
+}
+
+// Run polls the repositories every interval until the context
+// is cancelled. Errors polling a repository are passed to the
+// onError function, if not nil, and do not stop the poller.
+func (p *Poller) Run(ctx context.Context, interval time.Duration, repos []string, onError func(repo string, err error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for _, repo := range repos {
+			if err := p.Poll(ctx, repo); err != nil && onError != nil {
+				onError(repo, err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll snapshots the repository once and emits the changes
+// since the previous snapshot. The snapshot is only saved if
+// all events were handled, so a failed poll is retried in full
+// and events may be delivered more than once.
+func (p *Poller) Poll(ctx context.Context, repo string) error {
+	prev, err := p.store.Load(repo)
+	if err != nil {
+		return err
+	}
+	repository, _, err := p.client.Repositories.Find(ctx, repo)
+	if err != nil {
+		return err
+	}
+	next := &Snapshot{
+		Branches:     map[string]string{},
+		Tags:         map[string]string{},
+		PullRequests: map[int]*PullRequestState{},
+		Polled:       time.Now(),
+	}
+
+	branches, err := p.listBranches(ctx, repo)
+	if err != nil {
+		return err
+	}
+	for _, ref := range branches {
+		next.Branches[ref.Name] = ref.Sha
+	}
+	tags, err := p.listTags(ctx, repo)
+	if err != nil {
+		return err
+	}
+	for _, ref := range tags {
+		next.Tags[ref.Name] = ref.Sha
+	}
+
+	var events []scm.Webhook
+	if prev != nil {
+		events = append(events, diffRefs(*repository, prev.Branches, next.Branches, false)...)
+		events = append(events, diffRefs(*repository, prev.Tags, next.Tags, true)...)
+		for k, v := range prev.PullRequests {
+			next.PullRequests[k] = v
+		}
+	}
+
+	prs, err := p.listPullRequests(ctx, repo, prev)
+	if err != nil {
+		return err
+	}
+	for _, pr := range prs {
+		var old *PullRequestState
+		var reopened bool
+		if prev != nil {
+			old = prev.PullRequests[pr.Number]
+			// closed pull requests are pruned from the
+			// snapshot, so one created before the previous
+			// poll but not recorded has been reopened.
+			if old == nil && !pr.Closed && !pr.Created.IsZero() && pr.Created.Before(prev.Polled) {
+				old = &PullRequestState{Sha: pr.Sha, Title: pr.Title, Body: pr.Body, Closed: true}
+				reopened = true
+			}
+		}
+		state := &PullRequestState{
+			Sha:    pr.Sha,
+			Title:  pr.Title,
+			Body:   pr.Body,
+			Closed: pr.Closed,
+		}
+		if old != nil {
+			state.LastComment = old.LastComment
+		}
+		next.PullRequests[pr.Number] = state
+
+		// nothing is emitted on the first poll, or for closed
+		// pull requests that were never seen open.
+		emit := prev != nil && (old != nil || !pr.Closed)
+		if emit {
+			events = append(events, diffPullRequest(*repository, pr, old)...)
+		}
+
+		// comments are only listed for open pull requests and
+		// ones closed since the previous poll, as closed pull
+		// requests are pruned and never emit comments again.
+		if pr.Closed && (!emit || old.Closed) {
+			continue
+		}
+		comments, err := p.listComments(ctx, repo, pr.Number)
+		if err != nil {
+			return err
+		}
+		for _, c := range comments {
+			if c.ID <= state.LastComment {
+				continue
+			}
+			state.LastComment = c.ID
+			if reopened && c.Created.Before(prev.Polled) {
+				continue
+			}
+			if emit {
+				events = append(events, &scm.PullRequestCommentHook{
+					Action:      scm.ActionCreate,
+					Repo:        *repository,
+					PullRequest: *pr,
+					Comment:     *c,
+					Sender:      c.Author,
+				})
+			}
+		}
+	}
+
+	// closed pull requests are dropped once their final
+	// events have been emitted, so the snapshot only grows
+	// with the open pull requests.
+	for number, state := range next.PullRequests {
+		if state.Closed {
+			delete(next.PullRequests, number)
+		}
+	}
+
+	for _, event := range events {
+		if err := p.handler(event); err != nil {
+			return err
+		}
+	}
+	return p.store.Save(repo, next)
+}
+
+// helper function returns the branch, tag and push events
+// for the changes between two sets of references.
+func diffRefs(repo scm.Repository, prev, next map[string]string, tags bool) []scm.Webhook {
+	prefix := "refs/heads/"
+	if tags {
+		prefix = "refs/tags/"
+	}
+	refEvent := func(name, sha string, action scm.Action) scm.Webhook {
+		ref := scm.Reference{Name: name, Path: prefix + name, Sha: sha}
+		if tags {
+			return &scm.TagHook{Ref: ref, Repo: repo, Action: action}
+		}
+		return &scm.BranchHook{Ref: ref, Repo: repo, Action: action}
+	}
+
+	var events []scm.Webhook
+	for _, name := range sortedKeys(next) {
+		sha, before := next[name], prev[name]
+		switch {
+		case before == "":
+			events = append(events,
+				refEvent(name, sha, scm.ActionCreate),
+				pushEvent(repo, prefix+name, scm.EmptyCommit, sha),
+			)
+		case before != sha:
+			events = append(events, pushEvent(repo, prefix+name, before, sha))
+		}
+	}
+	for _, name := range sortedKeys(prev) {
+		if _, ok := next[name]; !ok {
+			events = append(events,
+				refEvent(name, prev[name], scm.ActionDelete),
+				pushEvent(repo, prefix+name, prev[name], scm.EmptyCommit),
+			)
+		}
+	}
+	return events
+}
+
+func pushEvent(repo scm.Repository, ref, before, after string) *scm.PushHook {
+	return &scm.PushHook{
+		Ref:     ref,
+		Repo:    repo,
+		Before:  before,
+		After:   after,
+		Created: before == scm.EmptyCommit,
+		Deleted: after == scm.EmptyCommit,
+		Commit:  scm.Commit{Sha: after},
+	}
+}
+
+// helper function returns the pull request events for the
+// changes since the recorded state.
+func diffPullRequest(repo scm.Repository, pr *scm.PullRequest, old *PullRequestState) []scm.Webhook {
+	event := func(action scm.Action) scm.Webhook {
+		return &scm.PullRequestHook{
+			Action:      action,
+			Repo:        repo,
+			PullRequest: *pr,
+			Sender:      pr.Author,
+		}
+	}
+	if old == nil {
+		return []scm.Webhook{event(scm.ActionOpen)}
+	}
+
+	var events []scm.Webhook
+	if old.Title != pr.Title || old.Body != pr.Body {
+		events = append(events, event(scm.ActionUpdate))
+	}
+	switch {
+	case !old.Closed && pr.Closed:
+		events = append(events, event(scm.ActionClose))
+	case old.Closed && !pr.Closed:
+		events = append(events, event(scm.ActionReopen))
+	}
+	if old.Sha != pr.Sha && !pr.Closed {
+		events = append(events, event(scm.ActionSync))
+	}
+	return events
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (p *Poller) listBranches(ctx context.Context, repo string) ([]*scm.Reference, error) {
+	var all []*scm.Reference
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		refs, res, err := p.client.Git.ListBranches(ctx, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, refs...)
+		if res == nil || res.Page.Next <= opts.Page {
+			return all, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+func (p *Poller) listTags(ctx context.Context, repo string) ([]*scm.Reference, error) {
+	var all []*scm.Reference
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		refs, res, err := p.client.Git.ListTags(ctx, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, refs...)
+		if res == nil || res.Page.Next <= opts.Page {
+			return all, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// helper function lists the open pull requests on the first
+// poll, and afterwards all pull requests updated since the
+// previous poll. Most drivers ignore UpdatedAfter, so pull
+// requests last updated before the previous poll are dropped
+// after listing.
+func (p *Poller) listPullRequests(ctx context.Context, repo string, prev *Snapshot) ([]*scm.PullRequest, error) {
+	var all []*scm.PullRequest
+	opts := scm.PullRequestListOptions{Page: 1, Size: 100, Open: true}
+	if prev != nil {
+		opts.Closed = true
+		opts.UpdatedAfter = &prev.Polled
+	}
+	for {
+		prs, res, err := p.client.PullRequests.List(ctx, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, pr := range prs {
+			if prev != nil && !pr.Updated.IsZero() && !pr.Updated.After(prev.Polled) {
+				continue
+			}
+			all = append(all, pr)
+		}
+		if res == nil || res.Page.Next <= opts.Page {
+			return all, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+func (p *Poller) listComments(ctx context.Context, repo string, number int) ([]*scm.Comment, error) {
+	var all []*scm.Comment
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		comments, res, err := p.client.PullRequests.ListComments(ctx, repo, number, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, comments...)
+		if res == nil || res.Page.Next <= opts.Page {
+			sort.Slice(all, func(i, j int) bool {
+				return all[i].ID < all[j].ID
+			})
+			return all, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
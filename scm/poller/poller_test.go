@@ -0,0 +1,323 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package poller
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/fake"
+)
+
+const repoName = "octocat/hello-world"
+
+func newClient() (*scm.Client, *fake.Data) {
+	client, data := fake.NewDefault()
+	repo := &scm.Repository{Namespace: "octocat", Name: "hello-world", FullName: repoName}
+	data.Repositories = []*scm.Repository{repo}
+	data.Branches[repoName] = []*scm.Reference{
+		{Name: "master", Sha: "a1"},
+		{Name: "old", Sha: "b1"},
+	}
+	data.Tags[repoName] = []*scm.Reference{
+		{Name: "v1.0.0", Sha: "a1"},
+	}
+	data.PullRequests[1] = &scm.PullRequest{
+		Number: 1,
+		Title:  "first",
+		Sha:    "c1",
+		Base:   scm.PullRequestBranch{Repo: *repo},
+	}
+	data.PullRequestComments[1] = []*scm.Comment{{ID: 10, Body: "lgtm"}}
+	return client, data
+}
+
+func TestPoll(t *testing.T) {
+	client, data := newClient()
+
+	var got []string
+	p := New(client, nil, func(hook scm.Webhook) error {
+		got = append(got, describe(hook))
+		return nil
+	})
+
+	ctx := context.Background()
+	if err := p.Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Expected no events on the first poll, got %v", got)
+	}
+
+	repo := data.Repositories[0]
+	data.Branches[repoName] = []*scm.Reference{
+		{Name: "master", Sha: "a2"},
+		{Name: "feature", Sha: "d1"},
+	}
+	data.Tags[repoName] = append(data.Tags[repoName], &scm.Reference{Name: "v1.1.0", Sha: "a2"})
+	data.PullRequests[1].Sha = "c2"
+	data.PullRequests[1].Title = "first pr"
+	data.PullRequests[2] = &scm.PullRequest{
+		Number: 2,
+		Sha:    "d1",
+		Base:   scm.PullRequestBranch{Repo: *repo},
+	}
+	data.PullRequestComments[1] = append(data.PullRequestComments[1], &scm.Comment{ID: 11, Body: "/test"})
+
+	if err := p.Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"branch created feature d1",
+		"push refs/heads/feature 0000000000000000000000000000000000000000..d1",
+		"push refs/heads/master a1..a2",
+		"branch deleted old b1",
+		"push refs/heads/old b1..0000000000000000000000000000000000000000",
+		"tag created v1.1.0 a2",
+		"push refs/tags/v1.1.0 0000000000000000000000000000000000000000..a2",
+		"pull_request updated 1",
+		"pull_request synchronized 1",
+		"pull_request_comment created 1 11",
+		"pull_request opened 2",
+	}
+	// the fake driver lists pull requests in random order.
+	sort.Strings(want)
+	sort.Strings(got)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected events")
+		t.Log(diff)
+	}
+
+	got = nil
+	data.PullRequests[2].Closed = true
+	if err := p.Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+	want = []string{"pull_request closed 2"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected events")
+		t.Log(diff)
+	}
+}
+
+func TestPollPrunesClosed(t *testing.T) {
+	client, data := newClient()
+	data.PullRequests[1].Created = time.Now().Add(-time.Hour)
+
+	var got []string
+	store := NewMemoryStore()
+	p := New(client, store, func(hook scm.Webhook) error {
+		got = append(got, describe(hook))
+		return nil
+	})
+
+	ctx := context.Background()
+	if err := p.Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+
+	data.PullRequests[1].Closed = true
+	for i := 0; i < 2; i++ {
+		if err := p.Poll(ctx, repoName); err != nil {
+			t.Fatal(err)
+		}
+		snapshot, _ := store.Load(repoName)
+		if _, ok := snapshot.PullRequests[1]; ok {
+			t.Errorf("Expected the closed pull request to be pruned")
+		}
+	}
+	want := []string{"pull_request closed 1"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected events")
+		t.Log(diff)
+	}
+
+	// a pruned pull request that is reopened is reported as
+	// reopened, with only the comments since the last poll.
+	got = nil
+	data.PullRequests[1].Closed = false
+	data.PullRequestComments[1] = append(data.PullRequestComments[1], &scm.Comment{ID: 12, Body: "/retest", Created: time.Now()})
+	if err := p.Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+	want = []string{"pull_request reopened 1", "pull_request_comment created 1 12"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected events")
+		t.Log(diff)
+	}
+	snapshot, _ := store.Load(repoName)
+	if state := snapshot.PullRequests[1]; state == nil || state.LastComment != 12 {
+		t.Errorf("Expected the reopened pull request to be recorded, got %+v", state)
+	}
+}
+
+// countingPullService counts the comment listings of a driver
+// that, like most, ignores UpdatedAfter.
+type countingPullService struct {
+	scm.PullRequestService
+	comments []int
+}
+
+func (s *countingPullService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
+	s.comments = append(s.comments, number)
+	return s.PullRequestService.ListComments(ctx, repo, number, opts)
+}
+
+func TestPollSkipsUnchanged(t *testing.T) {
+	client, data := newClient()
+	pulls := &countingPullService{PullRequestService: client.PullRequests}
+	client.PullRequests = pulls
+
+	old := time.Now().Add(-time.Hour)
+	repo := data.Repositories[0]
+	data.PullRequests[1].Updated = old
+	for i := 2; i <= 4; i++ {
+		data.PullRequests[i] = &scm.PullRequest{
+			Number:  i,
+			Closed:  true,
+			Updated: old,
+			Base:    scm.PullRequestBranch{Repo: *repo},
+		}
+	}
+
+	var got []string
+	p := New(client, nil, func(hook scm.Webhook) error {
+		got = append(got, describe(hook))
+		return nil
+	})
+	ctx := context.Background()
+	if err := p.Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+
+	// nothing changed, so no comments are listed.
+	pulls.comments = nil
+	if err := p.Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+	if len(pulls.comments) != 0 {
+		t.Errorf("Expected no comments listed, got %v", pulls.comments)
+	}
+
+	// a new comment updates the pull request.
+	data.PullRequests[1].Updated = time.Now()
+	data.PullRequestComments[1] = append(data.PullRequestComments[1], &scm.Comment{ID: 11, Body: "/test"})
+	if err := p.Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]int{1}, pulls.comments); diff != "" {
+		t.Errorf("Unexpected comment listings")
+		t.Log(diff)
+	}
+	want := []string{"pull_request_comment created 1 11"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected events")
+		t.Log(diff)
+	}
+}
+
+func TestPollHandlerError(t *testing.T) {
+	client, data := newClient()
+
+	var fail bool
+	var got []string
+	p := New(client, nil, func(hook scm.Webhook) error {
+		if fail {
+			return scm.ErrNotSupported
+		}
+		got = append(got, describe(hook))
+		return nil
+	})
+
+	ctx := context.Background()
+	if err := p.Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+	data.Branches[repoName][0].Sha = "a2"
+
+	fail = true
+	if err := p.Poll(ctx, repoName); err != scm.ErrNotSupported {
+		t.Fatalf("Expected handler error, got %v", err)
+	}
+
+	// the snapshot was not saved so the event is retried.
+	fail = false
+	if err := p.Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"push refs/heads/master a1..a2"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected events")
+		t.Log(diff)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "poller")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	client, data := newClient()
+	var got []string
+	handler := func(hook scm.Webhook) error {
+		got = append(got, describe(hook))
+		return nil
+	}
+
+	ctx := context.Background()
+	if err := New(client, NewFileStore(dir), handler).Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+
+	// a new poller using the same directory must resume
+	// from the saved snapshot.
+	data.Branches[repoName][0].Sha = "a2"
+	if err := New(client, NewFileStore(dir), handler).Poll(ctx, repoName); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"push refs/heads/master a1..a2"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected events")
+		t.Log(diff)
+	}
+
+	snapshot, err := NewFileStore(dir).Load(repoName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := snapshot.PullRequests[1].LastComment, 10; got != want {
+		t.Errorf("Want last comment %d, got %d", want, got)
+	}
+
+	snapshot, err = NewFileStore(dir).Load("octocat/unknown")
+	if err != nil || snapshot != nil {
+		t.Errorf("Expected no snapshot, got %v, %v", snapshot, err)
+	}
+}
+
+func describe(hook scm.Webhook) string {
+	switch v := hook.(type) {
+	case *scm.BranchHook:
+		return "branch " + v.Action.String() + " " + v.Ref.Name + " " + v.Ref.Sha
+	case *scm.TagHook:
+		return "tag " + v.Action.String() + " " + v.Ref.Name + " " + v.Ref.Sha
+	case *scm.PushHook:
+		return "push " + v.Ref + " " + v.Before + ".." + v.After
+	case *scm.PullRequestHook:
+		return "pull_request " + v.Action.String() + " " + strconv.Itoa(v.PullRequest.Number)
+	case *scm.PullRequestCommentHook:
+		return "pull_request_comment " + v.Action.String() + " " + strconv.Itoa(v.PullRequest.Number) + " " + strconv.Itoa(v.Comment.ID)
+	}
+	return string(hook.Kind())
+}
@@ -0,0 +1,130 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package poller
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+type (
+	// Snapshot is the state of a repository recorded by the
+	// previous poll.
+	Snapshot struct {
+		// Branches and Tags map reference names to shas.
+		Branches map[string]string `json:"branches"`
+		Tags     map[string]string `json:"tags"`
+
+		// PullRequests maps open pull request numbers to their
+		// state. Closed pull requests are pruned.
+		PullRequests map[int]*PullRequestState `json:"pull_requests"`
+
+		// Polled is the time the snapshot was taken and is used
+		// to only list pull requests updated since.
+		Polled time.Time `json:"polled"`
+	}
+
+	// PullRequestState is the recorded state of a pull request.
+	PullRequestState struct {
+		Sha         string `json:"sha"`
+		Title       string `json:"title"`
+		Body        string `json:"body"`
+		Closed      bool   `json:"closed"`
+		LastComment int    `json:"last_comment"`
+	}
+
+	// Store persists snapshots between polls so restarts do
+	// not emit spurious events.
+	Store interface {
+		// Load returns the snapshot of the repository, or nil
+		// if the repository was never polled.
+		Load(repo string) (*Snapshot, error)
+
+		// Save stores the snapshot of the repository.
+		Save(repo string, snapshot *Snapshot) error
+	}
+)
+
+// NewMemoryStore returns a Store that keeps snapshots in
+// memory.
+func NewMemoryStore() Store {
+	return &memoryStore{snapshots: map[string]*Snapshot{}}
+}
+
+type memoryStore struct {
+	mu        sync.Mutex
+	snapshots map[string]*Snapshot
+}
+
+func (s *memoryStore) Load(repo string) (*Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.snapshots[repo], nil
+}
+
+func (s *memoryStore) Save(repo string, snapshot *Snapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots[repo] = snapshot
+	return nil
+}
+
+// NewFileStore returns a Store that writes each snapshot to
+// a json file in the given directory.
+func NewFileStore(dir string) Store {
+	return &fileStore{dir: dir}
+}
+
+type fileStore struct {
+	dir string
+}
+
+func (s *fileStore) Load(repo string) (*Snapshot, error) {
+	data, err := ioutil.ReadFile(s.path(repo))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := new(Snapshot)
+	err = json.Unmarshal(data, out)
+	return out, err
+}
+
+func (s *fileStore) Save(repo string, snapshot *Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return err
+	}
+	// write to a temporary file first so a crash cannot
+	// leave a partially written snapshot behind.
+	tmp, err := ioutil.TempFile(s.dir, ".snapshot")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(repo))
+}
+
+func (s *fileStore) path(repo string) string {
+	return filepath.Join(s.dir, url.PathEscape(repo)+".json")
+}
@@ -189,6 +189,28 @@ type (
 
 		// ClearMilestone removes the milestone from a pull request
 		ClearMilestone(ctx context.Context, repo string, prID int) (*Response, error)
+
+		// ListReactions returns the reactions on a pull request.
+		ListReactions(ctx context.Context, repo string, number int, opts ListOptions) ([]*Reaction, *Response, error)
+
+		// CreateReaction adds a reaction to a pull request.
+		CreateReaction(ctx context.Context, repo string, number int, content ReactionContent) (*Reaction, *Response, error)
+
+		// DeleteReaction removes the reaction of the authenticated
+		// user from a pull request.
+		DeleteReaction(ctx context.Context, repo string, number int, content ReactionContent) (*Response, error)
+
+		// ListCommentReactions returns the reactions on a pull
+		// request comment. On providers that separate them, the
+		// comment is a review comment.
+		ListCommentReactions(ctx context.Context, repo string, number, id int, opts ListOptions) ([]*Reaction, *Response, error)
+
+		// CreateCommentReaction adds a reaction to a pull request comment.
+		CreateCommentReaction(ctx context.Context, repo string, number, id int, content ReactionContent) (*Reaction, *Response, error)
+
+		// DeleteCommentReaction removes the reaction of the
+		// authenticated user from a pull request comment.
+		DeleteCommentReaction(ctx context.Context, repo string, number, id int, content ReactionContent) (*Response, error)
 	}
 )
 
@@ -0,0 +1,91 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"reflect"
+	"strings"
+)
+
+type (
+	// BranchProtection represents the protection rules of a
+	// branch, or of the branches matching a pattern on
+	// providers that support patterns.
+	BranchProtection struct {
+		Branch string
+
+		// RequiredStatusChecks lists the status contexts that
+		// must succeed before a pull request can be merged.
+		RequiredStatusChecks []string
+
+		// StrictStatusChecks requires the branch to be up to
+		// date with the base branch before merging.
+		StrictStatusChecks bool
+
+		RequiredApprovals      int
+		DismissStaleReviews    bool
+		RequireCodeOwnerReview bool
+
+		// Push and Merge restrict who can push to, or merge
+		// into, the branch. A nil value places no restriction
+		// and an empty value allows no one.
+		Push  *BranchRestrictions
+		Merge *BranchRestrictions
+
+		AllowForcePush       bool
+		AllowDeletion        bool
+		RequireLinearHistory bool
+	}
+
+	// BranchRestrictions lists the users and teams allowed to
+	// perform an action on a protected branch.
+	BranchRestrictions struct {
+		Users []string
+		Teams []string
+	}
+
+	// BranchProtectionService manages branch protection rules.
+	BranchProtectionService interface {
+		// Find returns the protection rules of the branch.
+		Find(ctx context.Context, repo, branch string) (*BranchProtection, *Response, error)
+
+		// Set replaces the protection rules of the branch.
+		// Fields the provider cannot enforce are reported
+		// with an UnsupportedFieldsError once the supported
+		// fields are applied.
+		Set(ctx context.Context, repo string, input *BranchProtection) (*BranchProtection, *Response, error)
+
+		// Delete removes the protection rules of the branch.
+		Delete(ctx context.Context, repo, branch string) (*Response, error)
+	}
+)
+
+// UnsupportedFieldsError reports the fields of a request that
+// the provider does not support and were not applied.
+type UnsupportedFieldsError struct {
+	Fields []string
+}
+
+func (e *UnsupportedFieldsError) Error() string {
+	return "Fields not supported: " + strings.Join(e.Fields, ", ")
+}
+
+// CheckUnsupportedFields returns an UnsupportedFieldsError
+// listing the named fields that are set on the input struct,
+// or nil if none of them are set.
+func CheckUnsupportedFields(input interface{}, fields ...string) error {
+	v := reflect.Indirect(reflect.ValueOf(input))
+	var set []string
+	for _, name := range fields {
+		if f := v.FieldByName(name); f.IsValid() && !f.IsZero() {
+			set = append(set, name)
+		}
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	return &UnsupportedFieldsError{Fields: set}
+}
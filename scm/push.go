@@ -0,0 +1,103 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+// EnrichPushHook fetches the commit details and changed files
+// missing from the push hook using the git service. It is
+// intended for providers, such as bitbucket and stash, that
+// do not include them in the webhook payload. If the hook
+// lists no commits only the head commit is fetched, since the
+// pushed commits cannot be listed on every provider, and the
+// hook commits are left empty.
+func EnrichPushHook(ctx context.Context, git GitService, hook *PushHook) error {
+	repo := hook.Repository().FullName
+	if len(hook.Commits) == 0 {
+		if hook.After == "" || hook.After == EmptyCommit || hook.Commit.Message != "" {
+			return nil
+		}
+		commit := PushCommit{ID: hook.After}
+		if err := enrichPushCommit(ctx, git, repo, &commit); err != nil {
+			return err
+		}
+		setPushHead(hook, &commit)
+		return nil
+	}
+	for i := range hook.Commits {
+		commit := &hook.Commits[i]
+		if commit.Message == "" && commit.Author.Name == "" {
+			if err := enrichPushCommit(ctx, git, repo, commit); err != nil {
+				return err
+			}
+		}
+		if commit.Added == nil && commit.Removed == nil && commit.Modified == nil {
+			if err := enrichPushCommitChanges(ctx, git, repo, commit); err != nil {
+				return err
+			}
+		}
+		if commit.ID == hook.After && hook.Commit.Message == "" {
+			setPushHead(hook, commit)
+		}
+	}
+	return nil
+}
+
+// helper function copies the pushed head commit to the hook.
+func setPushHead(hook *PushHook, commit *PushCommit) {
+	hook.Commit.Sha = commit.ID
+	hook.Commit.Message = commit.Message
+	hook.Commit.Author = commit.Author
+	hook.Commit.Committer = commit.Committer
+	if hook.Commit.Link == "" {
+		hook.Commit.Link = commit.Link
+	}
+}
+
+func enrichPushCommit(ctx context.Context, git GitService, repo string, commit *PushCommit) error {
+	src, _, err := git.FindCommit(ctx, repo, commit.ID)
+	if err != nil {
+		return err
+	}
+	commit.Message = src.Message
+	commit.Author = src.Author
+	commit.Committer = src.Committer
+	commit.Timestamp = src.Committer.Date
+	if commit.Timestamp.IsZero() {
+		commit.Timestamp = src.Author.Date
+	}
+	if commit.Link == "" {
+		commit.Link = src.Link
+	}
+	return nil
+}
+
+// helper function lists the files changed by the commit.
+func enrichPushCommitChanges(ctx context.Context, git GitService, repo string, commit *PushCommit) error {
+	opts := ListOptions{Page: 1, Size: 100}
+	for {
+		changes, res, err := git.ListChanges(ctx, repo, commit.ID, opts)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			switch {
+			case change.Added:
+				commit.Added = append(commit.Added, change.Path)
+			case change.Deleted:
+				commit.Removed = append(commit.Removed, change.Path)
+			case change.Renamed:
+				commit.Removed = append(commit.Removed, change.PreviousPath)
+				commit.Added = append(commit.Added, change.Path)
+			default:
+				commit.Modified = append(commit.Modified, change.Path)
+			}
+		}
+		if res == nil || res.Page.Next <= opts.Page {
+			return nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
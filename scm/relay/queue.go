@@ -0,0 +1,107 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package relay
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// ErrNotFound is returned when a delivery is not queued.
+var ErrNotFound = errors.New("Delivery not found")
+
+// Status is the state of a delivery.
+type Status string
+
+// Status values.
+const (
+	// StatusPending is a delivery that will be attempted.
+	StatusPending Status = "pending"
+	// StatusDelivered is a delivery accepted by the subscriber.
+	StatusDelivered Status = "delivered"
+	// StatusFailed is a delivery that exhausted its attempts.
+	StatusFailed Status = "failed"
+)
+
+type (
+	// Delivery is a webhook relayed to a subscriber.
+	Delivery struct {
+		ID         string          `json:"id"`
+		Subscriber string          `json:"subscriber"`
+		Kind       scm.WebhookKind `json:"kind"`
+		Payload    []byte          `json:"payload"`
+		Status     Status          `json:"status"`
+		Attempts   int             `json:"attempts"`
+		StatusCode int             `json:"status_code,omitempty"`
+		Error      string          `json:"error,omitempty"`
+		Next       time.Time       `json:"next"`
+		Created    time.Time       `json:"created"`
+		Updated    time.Time       `json:"updated"`
+	}
+
+	// Queue persists deliveries until they are delivered or
+	// exhaust their attempts.
+	Queue interface {
+		// Save adds or updates the delivery.
+		Save(delivery *Delivery) error
+
+		// Find returns the delivery with the given id.
+		Find(id string) (*Delivery, error)
+
+		// Due returns the pending deliveries with a next
+		// attempt at or before the given time.
+		Due(now time.Time) ([]*Delivery, error)
+	}
+)
+
+// NewMemoryQueue returns a Queue that keeps deliveries in
+// memory.
+func NewMemoryQueue() Queue {
+	return &memoryQueue{deliveries: map[string]*Delivery{}}
+}
+
+type memoryQueue struct {
+	mu         sync.Mutex
+	deliveries map[string]*Delivery
+}
+
+func (q *memoryQueue) Save(delivery *Delivery) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	clone := *delivery
+	q.deliveries[delivery.ID] = &clone
+	return nil
+}
+
+func (q *memoryQueue) Find(id string) (*Delivery, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delivery, ok := q.deliveries[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	clone := *delivery
+	return &clone, nil
+}
+
+func (q *memoryQueue) Due(now time.Time) ([]*Delivery, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var out []*Delivery
+	for _, delivery := range q.deliveries {
+		if delivery.Status == StatusPending && !delivery.Next.After(now) {
+			clone := *delivery
+			out = append(out, &clone)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Next.Before(out[j].Next)
+	})
+	return out, nil
+}
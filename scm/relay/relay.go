@@ -0,0 +1,330 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package relay redistributes webhooks to subscribers in the
+// driver independent scm.WebhookWrapper json format.
+//
+// Published webhooks are queued and sent by Retry or Run, so
+// inbound webhooks are never held up by slow subscribers. Each
+// delivery is signed with the secret of the subscriber and
+// retried with exponential backoff until it is accepted
+// or exhausts its attempts. Subscribers verify and parse the
+// relayed webhooks with Parse.
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/jenkins-x/go-scm/pkg/hmac"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// Headers set on relayed webhooks.
+const (
+	HeaderEvent     = "X-Scm-Event"
+	HeaderDelivery  = "X-Scm-Delivery"
+	HeaderSignature = "X-Scm-Signature-256"
+)
+
+// Subscriber receives the relayed webhooks that match its
+// filters. Empty filters match every webhook.
+type Subscriber struct {
+	Name   string
+	URL    string
+	Secret string
+
+	// Kinds are the webhook kinds to relay.
+	Kinds []scm.WebhookKind
+
+	// Repos are the repository full names to relay, which
+	// may be path.Match patterns, eg octocat/*.
+	Repos []string
+
+	// Actions are the webhook actions to relay.
+	Actions []scm.Action
+}
+
+// Match returns true if the webhook matches the filters.
+func (s *Subscriber) Match(hook scm.Webhook) bool {
+	if len(s.Kinds) != 0 && !containsKind(s.Kinds, hook.Kind()) {
+		return false
+	}
+	if len(s.Repos) != 0 && !matchRepo(s.Repos, hook.Repository().FullName) {
+		return false
+	}
+	if len(s.Actions) != 0 && !containsAction(s.Actions, action(hook)) {
+		return false
+	}
+	return true
+}
+
+// Relay delivers webhooks to subscribers.
+type Relay struct {
+	// Client is the http client used for deliveries.
+	Client *http.Client
+
+	// MaxAttempts is the number of attempts before a
+	// delivery fails.
+	MaxAttempts int
+
+	// Backoff returns the delay before the next attempt.
+	Backoff func(attempts int) time.Duration
+
+	// Report, if not nil, is called after every attempt.
+	Report func(delivery *Delivery)
+
+	queue       Queue
+	subscribers map[string]*Subscriber
+	order       []string
+}
+
+// New returns a Relay that persists deliveries in the queue.
+// If the queue is nil deliveries are kept in memory.
+func New(queue Queue, subscribers ...*Subscriber) *Relay {
+	if queue == nil {
+		queue = NewMemoryQueue()
+	}
+	r := &Relay{
+		Client:      &http.Client{Timeout: 30 * time.Second},
+		MaxAttempts: 10,
+		Backoff:     ExponentialBackoff(time.Second, time.Hour),
+		queue:       queue,
+		subscribers: map[string]*Subscriber{},
+	}
+	for _, s := range subscribers {
+		r.subscribers[s.Name] = s
+		r.order = append(r.order, s.Name)
+	}
+	return r
+}
+
+// ExponentialBackoff returns a backoff that doubles the delay
+// after every attempt, up to the max delay.
+func ExponentialBackoff(base, max time.Duration) func(int) time.Duration {
+	return func(attempts int) time.Duration {
+		delay := base
+		for i := 1; i < attempts && delay < max; i++ {
+			delay *= 2
+		}
+		if delay > max {
+			delay = max
+		}
+		return delay
+	}
+}
+
+// Handler returns a handler that parses provider webhooks with
+// the service and queues them for the subscribers.
+func (r *Relay) Handler(service scm.WebhookService, fn scm.SecretFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		hook, err := service.Parse(req, fn)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if hook == nil {
+			return
+		}
+		if _, err := r.Publish(req.Context(), hook); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// Publish queues the webhook for every matching subscriber.
+// The deliveries are sent by Retry or Run.
+func (r *Relay) Publish(ctx context.Context, hook scm.Webhook) ([]*Delivery, error) {
+	wrapper, err := scm.NewWebhookWrapper(hook)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(wrapper)
+	if err != nil {
+		return nil, err
+	}
+	var out []*Delivery
+	for _, name := range r.order {
+		if !r.subscribers[name].Match(hook) {
+			continue
+		}
+		now := time.Now()
+		delivery := &Delivery{
+			ID:         scm.NewDeliveryID(),
+			Subscriber: name,
+			Kind:       hook.Kind(),
+			Payload:    payload,
+			Status:     StatusPending,
+			Next:       now,
+			Created:    now,
+			Updated:    now,
+		}
+		if err := r.queue.Save(delivery); err != nil {
+			return out, err
+		}
+		out = append(out, delivery)
+	}
+	return out, nil
+}
+
+// Retry attempts every delivery that is due.
+func (r *Relay) Retry(ctx context.Context) error {
+	deliveries, err := r.queue.Due(time.Now())
+	if err != nil {
+		return err
+	}
+	for _, delivery := range deliveries {
+		if err := r.attempt(ctx, delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run retries due deliveries every interval until the context
+// is cancelled.
+func (r *Relay) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := r.Retry(ctx); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Status returns the delivery with the given id.
+func (r *Relay) Status(id string) (*Delivery, error) {
+	return r.queue.Find(id)
+}
+
+// helper function sends the delivery to the subscriber and
+// saves the outcome. Only queue errors are returned.
+func (r *Relay) attempt(ctx context.Context, delivery *Delivery) error {
+	delivery.Attempts++
+	delivery.StatusCode = 0
+	delivery.Error = ""
+
+	subscriber, ok := r.subscribers[delivery.Subscriber]
+	if !ok {
+		delivery.Status = StatusFailed
+		delivery.Error = fmt.Sprintf("unknown subscriber %s", delivery.Subscriber)
+	} else {
+		code, err := r.send(ctx, subscriber, delivery)
+		delivery.StatusCode = code
+		switch {
+		case err == nil:
+			delivery.Status = StatusDelivered
+		case delivery.Attempts >= r.MaxAttempts:
+			delivery.Status = StatusFailed
+			delivery.Error = err.Error()
+		default:
+			delivery.Error = err.Error()
+			delivery.Next = time.Now().Add(r.Backoff(delivery.Attempts))
+		}
+	}
+	delivery.Updated = time.Now()
+	if err := r.queue.Save(delivery); err != nil {
+		return err
+	}
+	if r.Report != nil {
+		r.Report(delivery)
+	}
+	return nil
+}
+
+func (r *Relay) send(ctx context.Context, subscriber *Subscriber, delivery *Delivery) (int, error) {
+	req, err := http.NewRequest("POST", subscriber.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(delivery.Kind))
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	if subscriber.Secret != "" {
+		req.Header.Set(HeaderSignature, hmac.SignPrefix("sha256", delivery.Payload, []byte(subscriber.Secret)))
+	}
+	res, err := r.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	io.Copy(ioutil.Discard, res.Body) // #nosec
+	if res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("unexpected status %s", res.Status)
+	}
+	return res.StatusCode, nil
+}
+
+// Parse verifies the signature of a relayed webhook with the
+// subscriber secret and returns the webhook. If the secret is
+// empty no validation is performed.
+func Parse(req *http.Request, secret string) (scm.Webhook, error) {
+	data, err := ioutil.ReadAll(
+		io.LimitReader(req.Body, 10000000),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if secret != "" && !hmac.ValidatePrefix(data, []byte(secret), req.Header.Get(HeaderSignature)) {
+		return nil, scm.ErrSignatureInvalid
+	}
+	wrapper := new(scm.WebhookWrapper)
+	if err := json.Unmarshal(data, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.ToWebhook()
+}
+
+func containsKind(kinds []scm.WebhookKind, kind scm.WebhookKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAction(actions []scm.Action, action scm.Action) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+func matchRepo(patterns []string, repo string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, repo); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// helper function returns the action of the webhook, or zero
+// if the webhook has no action.
+func action(hook scm.Webhook) scm.Action {
+	data, err := json.Marshal(hook)
+	if err != nil {
+		return 0
+	}
+	fields := struct{ Action scm.Action }{}
+	json.Unmarshal(data, &fields) // #nosec
+	return fields.Action
+}
@@ -0,0 +1,358 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package relay
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+var testRepo = scm.Repository{
+	Namespace: "octocat",
+	Name:      "hello-world",
+	FullName:  "octocat/hello-world",
+}
+
+func TestPublish(t *testing.T) {
+	var mu sync.Mutex
+	var got []scm.Webhook
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hook, err := Parse(r, "topsecret")
+		if err != nil {
+			t.Error(err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if got, want := r.Header.Get(HeaderEvent), string(scm.WebhookKindPullRequest); got != want {
+			t.Errorf("Want event header %q, got %q", want, got)
+		}
+		mu.Lock()
+		got = append(got, hook)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	r := New(nil,
+		&Subscriber{Name: "all", URL: server.URL, Secret: "topsecret"},
+		&Subscriber{Name: "pushes", URL: server.URL, Secret: "topsecret", Kinds: []scm.WebhookKind{scm.WebhookKindPush}},
+	)
+	hook := &scm.PullRequestHook{
+		Action:      scm.ActionOpen,
+		PullRequest: scm.PullRequest{Number: 42},
+		Repo:        testRepo,
+	}
+	deliveries, err := r.Publish(context.Background(), hook)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("Want 1 delivery, got %d", len(deliveries))
+	}
+	if len(got) != 0 {
+		t.Fatalf("Want webhook queued, got %d webhooks received", len(got))
+	}
+	if err := r.Retry(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	delivery, err := r.Status(deliveries[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := delivery.Status, StatusDelivered; got != want {
+		t.Errorf("Want status %s, got %s", want, got)
+	}
+	if len(got) != 1 {
+		t.Fatalf("Want 1 webhook received, got %d", len(got))
+	}
+	if diff := cmp.Diff(hook, got[0]); diff != "" {
+		t.Errorf("Relayed webhook does not match")
+		t.Log(diff)
+	}
+}
+
+// allHooks returns a webhook of every type.
+func allHooks() []scm.Webhook {
+	return []scm.Webhook{
+		&scm.BranchHook{Ref: scm.Reference{Name: "feature"}, Repo: testRepo},
+		&scm.CheckRunHook{Action: scm.ActionCompleted, Repo: testRepo},
+		&scm.CheckSuiteHook{Action: scm.ActionCompleted, Repo: testRepo},
+		&scm.CommitCommentHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.DeployHook{Ref: scm.Reference{Name: "master"}, Repo: testRepo},
+		&scm.DeploymentStatusHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.ForkHook{Repo: testRepo},
+		&scm.InstallationHook{Action: scm.ActionCreate},
+		&scm.InstallationRepositoryHook{Action: scm.ActionCreate},
+		&scm.IssueCommentHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.IssueHook{Action: scm.ActionOpen, Repo: testRepo},
+		&scm.JobHook{Repo: testRepo},
+		&scm.LabelHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.MemberHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.MembershipHook{Action: scm.ActionCreate},
+		&scm.MergeGroupHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.MilestoneHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.OrganizationHook{Action: scm.ActionCreate},
+		&scm.PingHook{Repo: testRepo},
+		&scm.PipelineHook{Repo: testRepo},
+		&scm.PublicHook{Repo: testRepo},
+		&scm.PullRequestCommentHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.PullRequestHook{Action: scm.ActionOpen, Repo: testRepo},
+		&scm.PushHook{Ref: "refs/heads/master", Repo: testRepo},
+		&scm.ReleaseHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.RepositoryDispatchHook{EventType: "deploy", Repo: testRepo},
+		&scm.RepositoryHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.ReviewCommentHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.ReviewHook{Action: scm.ActionSubmitted, Repo: testRepo},
+		&scm.StarHook{Action: scm.ActionCreate, Repo: testRepo},
+		&scm.StatusHook{Commit: scm.Commit{Sha: "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d"}, Repo: testRepo},
+		&scm.TagHook{Ref: scm.Reference{Name: "v1.0.0"}, Repo: testRepo},
+		&scm.TeamHook{Action: scm.ActionCreate},
+		&scm.WatchHook{Action: "started", Repo: testRepo},
+		&scm.WorkflowJobHook{Action: scm.ActionCompleted, Repo: testRepo},
+		&scm.WorkflowRunHook{Action: scm.ActionCompleted, Repo: testRepo},
+	}
+}
+
+// TestAllHooks verifies allHooks has a webhook of every type
+// implemented by the scm package.
+func TestAllHooks(t *testing.T) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), "..", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []string
+	for _, file := range pkgs["scm"].Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Name.Name != "Kind" || fn.Recv == nil {
+				continue
+			}
+			if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); ok {
+				want = append(want, star.X.(*ast.Ident).Name)
+			}
+		}
+	}
+	var got []string
+	for _, hook := range allHooks() {
+		got = append(got, reflect.TypeOf(hook).Elem().Name())
+	}
+	sort.Strings(want)
+	sort.Strings(got)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Want a webhook of every type")
+		t.Log(diff)
+	}
+}
+
+func TestPublishAllHooks(t *testing.T) {
+	var got scm.Webhook
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hook, err := Parse(r, "topsecret")
+		if err != nil {
+			t.Error(err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		got = hook
+	}))
+	defer server.Close()
+
+	r := New(nil, &Subscriber{Name: "all", URL: server.URL, Secret: "topsecret"})
+	for _, hook := range allHooks() {
+		got = nil
+		deliveries, err := r.Publish(context.Background(), hook)
+		if err != nil {
+			t.Errorf("Failed to publish %T: %s", hook, err)
+			continue
+		}
+		if err := r.Retry(context.Background()); err != nil {
+			t.Errorf("Failed to deliver %T: %s", hook, err)
+			continue
+		}
+		if len(deliveries) != 1 {
+			t.Errorf("Want %T queued", hook)
+			continue
+		}
+		if delivery, err := r.Status(deliveries[0].ID); err != nil || delivery.Status != StatusDelivered {
+			t.Errorf("Want %T delivered", hook)
+			continue
+		}
+		if diff := cmp.Diff(hook, got); diff != "" {
+			t.Errorf("Relayed %T does not match", hook)
+			t.Log(diff)
+		}
+	}
+}
+
+func TestParseInvalidSignature(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := Parse(r, "othersecret"); err != scm.ErrSignatureInvalid {
+			t.Errorf("Want invalid signature error, got %v", err)
+		}
+	}))
+	defer server.Close()
+
+	r := New(nil, &Subscriber{Name: "all", URL: server.URL, Secret: "topsecret"})
+	if _, err := r.Publish(context.Background(), &scm.PushHook{Repo: testRepo}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Retry(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	var reports []Status
+	r := New(nil, &Subscriber{Name: "flaky", URL: server.URL})
+	r.Backoff = func(int) time.Duration { return 0 }
+	r.Report = func(d *Delivery) { reports = append(reports, d.Status) }
+
+	deliveries, err := r.Publish(context.Background(), &scm.PushHook{Repo: testRepo})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := deliveries[0].ID
+	for i := 0; i < 3; i++ {
+		if err := r.Retry(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	delivery, err := r.Status(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := delivery.Status, StatusDelivered; got != want {
+		t.Errorf("Want status %s, got %s", want, got)
+	}
+	if got, want := delivery.Attempts, 3; got != want {
+		t.Errorf("Want %d attempts, got %d", want, got)
+	}
+	want := []Status{StatusPending, StatusPending, StatusDelivered}
+	if diff := cmp.Diff(want, reports); diff != "" {
+		t.Errorf("Unexpected reports")
+		t.Log(diff)
+	}
+}
+
+func TestMaxAttempts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	r := New(nil, &Subscriber{Name: "broken", URL: server.URL})
+	r.MaxAttempts = 2
+	r.Backoff = func(int) time.Duration { return 0 }
+
+	deliveries, err := r.Publish(context.Background(), &scm.PushHook{Repo: testRepo})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := r.Retry(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	delivery, err := r.Status(deliveries[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := delivery.Status, StatusFailed; got != want {
+		t.Errorf("Want status %s, got %s", want, got)
+	}
+	if got, want := delivery.Attempts, 2; got != want {
+		t.Errorf("Want %d attempts, got %d", want, got)
+	}
+	if got, want := delivery.StatusCode, http.StatusInternalServerError; got != want {
+		t.Errorf("Want status code %d, got %d", want, got)
+	}
+}
+
+func TestSubscriberMatch(t *testing.T) {
+	tests := []struct {
+		subscriber Subscriber
+		hook       scm.Webhook
+		match      bool
+	}{
+		{
+			subscriber: Subscriber{},
+			hook:       &scm.PushHook{Repo: testRepo},
+			match:      true,
+		},
+		{
+			subscriber: Subscriber{Kinds: []scm.WebhookKind{scm.WebhookKindPullRequest}},
+			hook:       &scm.PushHook{Repo: testRepo},
+			match:      false,
+		},
+		{
+			subscriber: Subscriber{Repos: []string{"octocat/*"}},
+			hook:       &scm.PushHook{Repo: testRepo},
+			match:      true,
+		},
+		{
+			subscriber: Subscriber{Repos: []string{"spaceghost/*"}},
+			hook:       &scm.PushHook{Repo: testRepo},
+			match:      false,
+		},
+		{
+			subscriber: Subscriber{Actions: []scm.Action{scm.ActionClose, scm.ActionMerge}},
+			hook:       &scm.PullRequestHook{Action: scm.ActionMerge, Repo: testRepo},
+			match:      true,
+		},
+		{
+			subscriber: Subscriber{Actions: []scm.Action{scm.ActionClose}},
+			hook:       &scm.PullRequestHook{Action: scm.ActionOpen, Repo: testRepo},
+			match:      false,
+		},
+		{
+			subscriber: Subscriber{Actions: []scm.Action{scm.ActionClose}},
+			hook:       &scm.PushHook{Repo: testRepo},
+			match:      false,
+		},
+	}
+	for i, test := range tests {
+		if got, want := test.subscriber.Match(test.hook), test.match; got != want {
+			t.Errorf("Test %d: want match %v, got %v", i, want, got)
+		}
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(time.Second, time.Minute)
+	tests := map[int]time.Duration{
+		1:  time.Second,
+		2:  2 * time.Second,
+		4:  8 * time.Second,
+		10: time.Minute,
+	}
+	for attempts, want := range tests {
+		if got := backoff(attempts); got != want {
+			t.Errorf("Attempt %d: want %s, got %s", attempts, want, got)
+		}
+	}
+}
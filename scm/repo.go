@@ -20,6 +20,15 @@ const (
 	AdminPermission = "admin"
 )
 
+const (
+	// VisibilityPublic means the repository is visible to everyone
+	VisibilityPublic = "public"
+	// VisibilityInternal means the repository is visible to all users of the instance
+	VisibilityInternal = "internal"
+	// VisibilityPrivate means the repository is only visible to its members
+	VisibilityPrivate = "private"
+)
+
 type (
 	// Repository represents a git repository.
 	Repository struct {
@@ -48,6 +57,41 @@ type (
 		Private     bool
 	}
 
+	// RepositorySettings provides the input fields for
+	// updating the settings of a repository. Empty strings
+	// and nil fields are left unchanged.
+	RepositorySettings struct {
+		Description   string
+		Homepage      string
+		Visibility    string
+		DefaultBranch string
+		Archived      *bool
+
+		// AllowMergeCommit, AllowSquashMerge and
+		// AllowRebaseMerge enable the pull request merge
+		// methods.
+		AllowMergeCommit    *bool
+		AllowSquashMerge    *bool
+		AllowRebaseMerge    *bool
+		DeleteBranchOnMerge *bool
+
+		HasIssues *bool
+		HasWiki   *bool
+
+		// Topics replaces the repository topics. An empty
+		// non-nil value removes all topics.
+		Topics []string
+	}
+
+	// LabelInput provides the input fields required for
+	// creating or updating a repository label. The color is
+	// a hex code, with or without a leading #.
+	LabelInput struct {
+		Name        string
+		Color       string
+		Description string
+	}
+
 	// Perm represents a user's repository permissions.
 	Perm struct {
 		Pull  bool
@@ -68,6 +112,9 @@ type (
 	// HookInput provides the input fields required for
 	// creating or updating repository webhooks.
 	HookInput struct {
+		// ID identifies the hook to edit when updating
+		// a repository webhook.
+		ID         string
 		Name       string
 		Target     string
 		Secret     string
@@ -85,14 +132,17 @@ type (
 		Branch             bool
 		Deployment         bool
 		DeploymentStatus   bool
+		Fork               bool
 		Issue              bool
 		IssueComment       bool
 		PullRequest        bool
 		PullRequestComment bool
 		Push               bool
 		Release            bool
+		Repository         bool
 		Review             bool
 		ReviewComment      bool
+		Status             bool
 		Tag                bool
 	}
 
@@ -145,6 +195,16 @@ type (
 		// ListLabels returns the labels on a repo
 		ListLabels(context.Context, string, ListOptions) ([]*Label, *Response, error)
 
+		// CreateLabel creates a new repository label.
+		CreateLabel(ctx context.Context, repo string, input *LabelInput) (*Label, *Response, error)
+
+		// UpdateLabel renames or edits a repository label.
+		// Empty input fields are left unchanged.
+		UpdateLabel(ctx context.Context, repo, name string, input *LabelInput) (*Label, *Response, error)
+
+		// DeleteLabel deletes a repository label.
+		DeleteLabel(ctx context.Context, repo, name string) (*Response, error)
+
 		// ListHooks returns a list or repository hooks.
 		ListHooks(context.Context, string, ListOptions) ([]*Hook, *Response, error)
 
@@ -161,11 +221,17 @@ type (
 		Fork(context.Context, *RepositoryInput, string) (*Repository, *Response, error)
 
 		// CreateHook creates a new repository webhook.
+		// Events the provider cannot send are reported with
+		// an UnsupportedFieldsError once the hook is created.
 		CreateHook(context.Context, string, *HookInput) (*Hook, *Response, error)
 
 		// UpdateHook edit a repository webhook
 		UpdateHook(context.Context, string, *HookInput) (*Hook, *Response, error)
 
+		// EnsureHook creates, updates or dedupes repository
+		// webhooks so exactly one hook targets the input url.
+		EnsureHook(context.Context, string, *HookInput) (*EnsureHookResult, *Response, error)
+
 		// CreateStatus creates a new commit status.
 		CreateStatus(context.Context, string, string, *StatusInput) (*Status, *Response, error)
 
@@ -186,10 +252,18 @@ type (
 
 		// Delete deletes a repository
 		Delete(ctx context.Context, repo string) (*Response, error)
+
+		// Update changes the repository settings. Settings
+		// the provider does not support are reported with an
+		// UnsupportedFieldsError once the supported settings
+		// are applied.
+		Update(ctx context.Context, repo string, input *RepositorySettings) (*Repository, *Response, error)
+
+		// Rename changes the name of the repository.
+		Rename(ctx context.Context, repo, name string) (*Repository, *Response, error)
+
+		// Transfer moves the repository to the user or
+		// organization namespace.
+		Transfer(ctx context.Context, repo, namespace string) (*Repository, *Response, error)
 	}
 )
-
-// TODO(bradrydzewski): Add endpoint to get a repository deploy key
-// TODO(bradrydzewski): Add endpoint to list repository deploy keys
-// TODO(bradrydzewski): Add endpoint to create a repository deploy key
-// TODO(bradrydzewski): Add endpoint to delete a repository deploy key
@@ -0,0 +1,91 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Secret represents a CI secret or variable. Secret
+	// values are write-only and are never returned.
+	Secret struct {
+		Name string
+
+		// Environment is the environment the secret is
+		// scoped to, or empty for repository and
+		// organization secrets.
+		Environment string
+
+		// Masked secrets are hidden in CI logs.
+		Masked bool
+
+		// Protected secrets are only exposed to pipelines
+		// running on protected branches and tags.
+		Protected bool
+
+		Created time.Time
+		Updated time.Time
+	}
+
+	// SecretInput provides the input fields required for
+	// creating or updating a CI secret or variable.
+	SecretInput struct {
+		Name      string
+		Value     string
+		Masked    bool
+		Protected bool
+
+		// Visibility restricts the repositories that can use
+		// an organization secret to all, private or selected
+		// repositories. If empty, existing secrets keep their
+		// visibility.
+		Visibility string
+
+		// SelectedRepositoryIDs are the repositories that can
+		// use an organization secret with selected visibility.
+		SelectedRepositoryIDs []string
+	}
+
+	// SecretService provides access to CI secrets and
+	// variables at repository, environment and organization
+	// level. Set creates the secret, or updates it if it
+	// already exists.
+	SecretService interface {
+		// List returns the repository secrets.
+		List(ctx context.Context, repo string, opts ListOptions) ([]*Secret, *Response, error)
+
+		// Set creates or updates a repository secret.
+		Set(ctx context.Context, repo string, input *SecretInput) (*Response, error)
+
+		// Delete deletes a repository secret.
+		Delete(ctx context.Context, repo, name string) (*Response, error)
+
+		// ListEnvironment returns the secrets of a
+		// repository deployment environment.
+		ListEnvironment(ctx context.Context, repo, env string, opts ListOptions) ([]*Secret, *Response, error)
+
+		// SetEnvironment creates or updates a secret of a
+		// repository deployment environment.
+		SetEnvironment(ctx context.Context, repo, env string, input *SecretInput) (*Response, error)
+
+		// DeleteEnvironment deletes a secret of a
+		// repository deployment environment.
+		DeleteEnvironment(ctx context.Context, repo, env, name string) (*Response, error)
+
+		// ListOrganization returns the organization, group
+		// or workspace secrets.
+		ListOrganization(ctx context.Context, org string, opts ListOptions) ([]*Secret, *Response, error)
+
+		// SetOrganization creates or updates an
+		// organization, group or workspace secret.
+		SetOrganization(ctx context.Context, org string, input *SecretInput) (*Response, error)
+
+		// DeleteOrganization deletes an organization, group
+		// or workspace secret.
+		DeleteOrganization(ctx context.Context, org, name string) (*Response, error)
+	}
+)
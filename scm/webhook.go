@@ -21,6 +21,8 @@ const (
 	WebhookKindCheckRun WebhookKind = "check_run"
 	// WebhookKindCheckSuite is for check suite events
 	WebhookKindCheckSuite WebhookKind = "check_suite"
+	// WebhookKindCommitComment is for commit comment events
+	WebhookKindCommitComment WebhookKind = "commit_comment"
 	// WebhookKindDeploy is for deploy events
 	WebhookKindDeploy WebhookKind = "deploy"
 	// WebhookKindDeploymentStatus is for deployment status events
@@ -35,10 +37,26 @@ const (
 	WebhookKindIssue WebhookKind = "issue"
 	// WebhookKindIssueComment is for issue comment events
 	WebhookKindIssueComment WebhookKind = "issue_comment"
+	// WebhookKindJob is for CI job events
+	WebhookKindJob WebhookKind = "job"
 	// WebhookKindLabel is for label events
 	WebhookKindLabel WebhookKind = "label"
+	// WebhookKindMember is for repository collaborator events
+	WebhookKindMember WebhookKind = "member"
+	// WebhookKindMembership is for team membership events
+	WebhookKindMembership WebhookKind = "membership"
+	// WebhookKindMergeGroup is for merge group events
+	WebhookKindMergeGroup WebhookKind = "merge_group"
+	// WebhookKindMilestone is for milestone events
+	WebhookKindMilestone WebhookKind = "milestone"
+	// WebhookKindOrganization is for organization events
+	WebhookKindOrganization WebhookKind = "organization"
 	// WebhookKindPing is for ping events
 	WebhookKindPing WebhookKind = "ping"
+	// WebhookKindPipeline is for CI pipeline events
+	WebhookKindPipeline WebhookKind = "pipeline"
+	// WebhookKindPublic is for repository visibility events
+	WebhookKindPublic WebhookKind = "public"
 	// WebhookKindPullRequest is for pull request events
 	WebhookKindPullRequest WebhookKind = "pull_request"
 	// WebhookKindPullRequestComment is for pull request comment events
@@ -49,6 +67,8 @@ const (
 	WebhookKindRelease WebhookKind = "release"
 	// WebhookKindRepository is for repository events
 	WebhookKindRepository WebhookKind = "repository"
+	// WebhookKindRepositoryDispatch is for repository dispatch events
+	WebhookKindRepositoryDispatch WebhookKind = "repository_dispatch"
 	// WebhookKindReview is for review events
 	WebhookKindReview WebhookKind = "review"
 	// WebhookKindReviewCommentHook is for review comment events
@@ -59,8 +79,14 @@ const (
 	WebhookKindStatus WebhookKind = "status"
 	// WebhookKindTag is for tag events
 	WebhookKindTag WebhookKind = "tag"
+	// WebhookKindTeam is for team events
+	WebhookKindTeam WebhookKind = "team"
 	// WebhookKindWatch is for watch events
 	WebhookKindWatch WebhookKind = "watch"
+	// WebhookKindWorkflowJob is for workflow job events
+	WebhookKindWorkflowJob WebhookKind = "workflow_job"
+	// WebhookKindWorkflowRun is for workflow run events
+	WebhookKindWorkflowRun WebhookKind = "workflow_run"
 )
 
 var (
@@ -88,7 +114,10 @@ type (
 
 	// PingHook a ping webhook.
 	PingHook struct {
-		Repo         Repository
+		Repo Repository
+		// Organization is set when the ping is sent to
+		// an organization webhook.
+		Organization Organization
 		Sender       User
 		Installation *InstallationRef
 		GUID         string
@@ -96,8 +125,16 @@ type (
 
 	// PushCommit represents general info about a commit.
 	PushCommit struct {
-		ID       string
-		Message  string
+		ID        string
+		Message   string
+		Author    Signature
+		Committer Signature
+		Timestamp time.Time
+		Link      string
+		// Distinct is true if the commit is pushed for the
+		// first time. It is only set by providers that
+		// report it.
+		Distinct bool
 		Added    []string
 		Removed  []string
 		Modified []string
@@ -135,6 +172,7 @@ type (
 	CheckRunHook struct {
 		Action       Action
 		Repo         Repository
+		CheckRun     CheckRun
 		Sender       User
 		Label        Label
 		Installation *InstallationRef
@@ -144,11 +182,24 @@ type (
 	CheckSuiteHook struct {
 		Action       Action
 		Repo         Repository
+		CheckSuite   CheckSuite
 		Sender       User
 		Label        Label
 		Installation *InstallationRef
 	}
 
+	// CommitCommentHook represents a comment on a commit,
+	// eg commit_comment.
+	CommitCommentHook struct {
+		Action       Action
+		Repo         Repository
+		Commit       Commit
+		Comment      Comment
+		Sender       User
+		GUID         string
+		Installation *InstallationRef
+	}
+
 	// DeployHook represents a deployment event.
 	// This is currently a GitHub-specific event type.
 	DeployHook struct {
@@ -175,7 +226,12 @@ type (
 
 	// ForkHook represents a fork event
 	ForkHook struct {
-		Repo         Repository
+		Repo Repository
+
+		// Fork is the repository created by the fork, if
+		// the provider includes it.
+		Fork Repository
+
 		Sender       User
 		Installation *InstallationRef
 	}
@@ -195,6 +251,7 @@ type (
 		Action       Action
 		Repo         Repository
 		Issue        Issue
+		Label        Label
 		Sender       User
 		Installation *InstallationRef
 	}
@@ -235,6 +292,17 @@ type (
 		NodeID string
 	}
 
+	// JobHook represents a CI job event, eg a GitLab
+	// Job Hook.
+	JobHook struct {
+		Action       Action
+		Repo         Repository
+		Job          PipelineJob
+		Commit       Commit
+		Sender       User
+		Installation *InstallationRef
+	}
+
 	// LabelHook represents a label event
 	LabelHook struct {
 		Action       Action
@@ -244,6 +312,19 @@ type (
 		Installation *InstallationRef
 	}
 
+	// PipelineHook represents a CI pipeline event, eg a
+	// GitLab Pipeline Hook.
+	PipelineHook struct {
+		Action       Action
+		Repo         Repository
+		Pipeline     Pipeline
+		Jobs         []PipelineJob
+		Commit       Commit
+		PullRequest  *PullRequest
+		Sender       User
+		Installation *InstallationRef
+	}
+
 	// ReleaseHook represents a release event
 	ReleaseHook struct {
 		Action       Action
@@ -256,8 +337,11 @@ type (
 
 	// RepositoryHook represents a repository event
 	RepositoryHook struct {
-		Action       Action
-		Repo         Repository
+		Action Action
+		Repo   Repository
+		// Previous holds the repository before a rename,
+		// when provided by the driver.
+		Previous     *Repository
 		Sender       User
 		Installation *InstallationRef
 	}
@@ -266,11 +350,118 @@ type (
 	StatusHook struct {
 		Action       Action
 		Repo         Repository
+		Status       Status
+		Commit       Commit
+		Branches     []Reference
 		Sender       User
 		Label        Label
 		Installation *InstallationRef
 	}
 
+	// WorkflowRunHook represents a workflow run event,
+	// eg workflow_run.
+	WorkflowRunHook struct {
+		Action       Action
+		Repo         Repository
+		WorkflowRun  WorkflowRun
+		Sender       User
+		Installation *InstallationRef
+	}
+
+	// WorkflowJobHook represents a workflow job event,
+	// eg workflow_job.
+	WorkflowJobHook struct {
+		Action       Action
+		Repo         Repository
+		WorkflowJob  WorkflowJob
+		Sender       User
+		Installation *InstallationRef
+	}
+
+	// MergeGroupHook represents a merge queue event,
+	// eg merge_group.
+	MergeGroupHook struct {
+		Action       Action
+		Repo         Repository
+		MergeGroup   MergeGroup
+		Reason       string
+		Sender       User
+		Installation *InstallationRef
+	}
+
+	// MemberHook represents a repository collaborator
+	// event, eg member.
+	MemberHook struct {
+		Action       Action
+		Repo         Repository
+		Member       User
+		Permission   string
+		Sender       User
+		Installation *InstallationRef
+	}
+
+	// MembershipHook represents a team membership event,
+	// eg membership.
+	MembershipHook struct {
+		Action       Action
+		Scope        string
+		Member       User
+		Team         Team
+		Organization Organization
+		Sender       User
+		Installation *InstallationRef
+	}
+
+	// TeamHook represents a team event, eg team.
+	TeamHook struct {
+		Action       Action
+		Team         Team
+		Organization Organization
+		Repo         Repository
+		Sender       User
+		Installation *InstallationRef
+	}
+
+	// OrganizationHook represents an organization event,
+	// eg organization.
+	OrganizationHook struct {
+		Action       Action
+		Organization Organization
+		Member       User
+		Membership   Membership
+		Sender       User
+		Installation *InstallationRef
+	}
+
+	// MilestoneHook represents a milestone event, eg
+	// milestone.
+	MilestoneHook struct {
+		Action       Action
+		Repo         Repository
+		Milestone    Milestone
+		Sender       User
+		Installation *InstallationRef
+	}
+
+	// PublicHook represents a repository being made
+	// public, eg public.
+	PublicHook struct {
+		Repo         Repository
+		Sender       User
+		Installation *InstallationRef
+	}
+
+	// RepositoryDispatchHook represents a custom event
+	// triggered through the API, eg repository_dispatch.
+	RepositoryDispatchHook struct {
+		EventType     string
+		Branch        string
+		ClientPayload map[string]interface{}
+		Repo          Repository
+		Sender        User
+		Installation  *InstallationRef
+	}
+
 	// Account represents the account of a GitHub app install
 	Account struct {
 		ID    int
@@ -290,9 +481,17 @@ type (
 		Repo Repository
 	}
 
+	// PullRequestHookReviewers represents the reviewers
+	// added to and removed from a PR
+	PullRequestHookReviewers struct {
+		Added   []User
+		Removed []User
+	}
+
 	// PullRequestHookChanges represents the changes in a PR
 	PullRequestHookChanges struct {
-		Base PullRequestHookBranch
+		Base      PullRequestHookBranch
+		Reviewers PullRequestHookReviewers
 	}
 
 	// PullRequestHook represents an pull request event,
@@ -353,6 +552,7 @@ type (
 		BranchHook                 *BranchHook                 `json:",omitempty"`
 		CheckRunHook               *CheckRunHook               `json:",omitempty"`
 		CheckSuiteHook             *CheckSuiteHook             `json:",omitempty"`
+		CommitCommentHook          *CommitCommentHook          `json:",omitempty"`
 		DeployHook                 *DeployHook                 `json:",omitempty"`
 		DeploymentStatusHook       *DeploymentStatusHook       `json:",omitempty"`
 		ForkHook                   *ForkHook                   `json:",omitempty"`
@@ -361,14 +561,28 @@ type (
 		IssueCommentHook           *IssueCommentHook           `json:",omitempty"`
 		InstallationHook           *InstallationHook           `json:",omitempty"`
 		InstallationRepositoryHook *InstallationRepositoryHook `json:",omitempty"`
+		JobHook                    *JobHook                    `json:",omitempty"`
 		LabelHook                  *LabelHook                  `json:",omitempty"`
+		PipelineHook               *PipelineHook               `json:",omitempty"`
 		ReleaseHook                *ReleaseHook                `json:",omitempty"`
 		RepositoryHook             *RepositoryHook             `json:",omitempty"`
 		PullRequestHook            *PullRequestHook            `json:",omitempty"`
 		PullRequestCommentHook     *PullRequestCommentHook     `json:",omitempty"`
+		ReviewHook                 *ReviewHook                 `json:",omitempty"`
 		ReviewCommentHook          *ReviewCommentHook          `json:",omitempty"`
 		WatchHook                  *WatchHook                  `json:",omitempty"`
 		StarHook                   *StarHook                   `json:",omitempty"`
+		WorkflowRunHook            *WorkflowRunHook            `json:",omitempty"`
+		WorkflowJobHook            *WorkflowJobHook            `json:",omitempty"`
+		MergeGroupHook             *MergeGroupHook             `json:",omitempty"`
+		MemberHook                 *MemberHook                 `json:",omitempty"`
+		MembershipHook             *MembershipHook             `json:",omitempty"`
+		TeamHook                   *TeamHook                   `json:",omitempty"`
+		OrganizationHook           *OrganizationHook           `json:",omitempty"`
+		MilestoneHook              *MilestoneHook              `json:",omitempty"`
+		PublicHook                 *PublicHook                 `json:",omitempty"`
+		RepositoryDispatchHook     *RepositoryDispatchHook     `json:",omitempty"`
+		StatusHook                 *StatusHook                 `json:",omitempty"`
 	}
 
 	// SecretFunc provides the Webhook parser with the
@@ -381,6 +595,16 @@ type (
 		// Parse returns the parsed the repository webhook payload.
 		Parse(req *http.Request, fn SecretFunc) (Webhook, error)
 	}
+
+	// WebhookGenerator renders webhooks as provider native
+	// http requests, the inverse of WebhookService.Parse. It
+	// is implemented by the WebhookService of drivers that
+	// support generating webhooks.
+	WebhookGenerator interface {
+		// Generate returns the webhook request signed with
+		// the secret.
+		Generate(hook Webhook, secret string) (*http.Request, error)
+	}
 )
 
 // Kind returns the kind of webhook
@@ -392,6 +616,9 @@ func (h *PushHook) Kind() WebhookKind { return WebhookKindPush }
 // Kind returns the kind of webhook
 func (h *BranchHook) Kind() WebhookKind { return WebhookKindBranch }
 
+// Kind returns the kind of webhook
+func (h *CommitCommentHook) Kind() WebhookKind { return WebhookKindCommitComment }
+
 // Kind returns the kind of webhook
 func (h *DeployHook) Kind() WebhookKind { return WebhookKindDeploy }
 
@@ -452,6 +679,42 @@ func (h *WatchHook) Kind() WebhookKind { return WebhookKindWatch }
 // Kind returns the kind of webhook
 func (h *StarHook) Kind() WebhookKind { return WebhookKindStar }
 
+// Kind returns the kind of webhook
+func (h *PipelineHook) Kind() WebhookKind { return WebhookKindPipeline }
+
+// Kind returns the kind of webhook
+func (h *JobHook) Kind() WebhookKind { return WebhookKindJob }
+
+// Kind returns the kind of webhook
+func (h *WorkflowRunHook) Kind() WebhookKind { return WebhookKindWorkflowRun }
+
+// Kind returns the kind of webhook
+func (h *WorkflowJobHook) Kind() WebhookKind { return WebhookKindWorkflowJob }
+
+// Kind returns the kind of webhook
+func (h *MergeGroupHook) Kind() WebhookKind { return WebhookKindMergeGroup }
+
+// Kind returns the kind of webhook
+func (h *MemberHook) Kind() WebhookKind { return WebhookKindMember }
+
+// Kind returns the kind of webhook
+func (h *MembershipHook) Kind() WebhookKind { return WebhookKindMembership }
+
+// Kind returns the kind of webhook
+func (h *TeamHook) Kind() WebhookKind { return WebhookKindTeam }
+
+// Kind returns the kind of webhook
+func (h *OrganizationHook) Kind() WebhookKind { return WebhookKindOrganization }
+
+// Kind returns the kind of webhook
+func (h *MilestoneHook) Kind() WebhookKind { return WebhookKindMilestone }
+
+// Kind returns the kind of webhook
+func (h *PublicHook) Kind() WebhookKind { return WebhookKindPublic }
+
+// Kind returns the kind of webhook
+func (h *RepositoryDispatchHook) Kind() WebhookKind { return WebhookKindRepositoryDispatch }
+
 // Repository defines the repository webhook and provides a convenient way to get the associated repository without
 // having to cast the type.
 func (h *PingHook) Repository() Repository { return h.Repo }
@@ -464,6 +727,10 @@ func (h *PushHook) Repository() Repository { return h.Repo }
 // having to cast the type.
 func (h *BranchHook) Repository() Repository { return h.Repo }
 
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *CommitCommentHook) Repository() Repository { return h.Repo }
+
 // Repository defines the repository webhook and provides a convenient way to get the associated repository without
 // having to cast the type.
 func (h *DeployHook) Repository() Repository { return h.Repo }
@@ -536,6 +803,54 @@ func (h *WatchHook) Repository() Repository { return h.Repo }
 // having to cast the type.
 func (h *StarHook) Repository() Repository { return h.Repo }
 
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *PipelineHook) Repository() Repository { return h.Repo }
+
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *JobHook) Repository() Repository { return h.Repo }
+
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *WorkflowRunHook) Repository() Repository { return h.Repo }
+
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *WorkflowJobHook) Repository() Repository { return h.Repo }
+
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *MergeGroupHook) Repository() Repository { return h.Repo }
+
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *MemberHook) Repository() Repository { return h.Repo }
+
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *MembershipHook) Repository() Repository { return Repository{} }
+
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *TeamHook) Repository() Repository { return h.Repo }
+
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *OrganizationHook) Repository() Repository { return Repository{} }
+
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *MilestoneHook) Repository() Repository { return h.Repo }
+
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *PublicHook) Repository() Repository { return h.Repo }
+
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *RepositoryDispatchHook) Repository() Repository { return h.Repo }
+
 // Repository defines the repository webhook and provides a convenient way to get the associated repository without
 // having to cast the type.
 func (h *InstallationHook) Repository() Repository {
@@ -566,6 +881,10 @@ func (h *PushHook) GetInstallationRef() *InstallationRef { return h.Installation
 // GitHub App
 func (h *BranchHook) GetInstallationRef() *InstallationRef { return h.Installation }
 
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *CommitCommentHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
 // GetInstallationRef returns the installation reference if the webhook is invoked on a
 // GitHub App
 func (h *DeployHook) GetInstallationRef() *InstallationRef { return h.Installation }
@@ -638,6 +957,54 @@ func (h *WatchHook) GetInstallationRef() *InstallationRef { return h.Installatio
 // GitHub App
 func (h *StarHook) GetInstallationRef() *InstallationRef { return nil }
 
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *PipelineHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *JobHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *WorkflowRunHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *WorkflowJobHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *MergeGroupHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *MemberHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *MembershipHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *TeamHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *OrganizationHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *MilestoneHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *PublicHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *RepositoryDispatchHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
 // GetInstallationRef returns the installation reference if the webhook is invoked on a
 // GitHub App
 func (h *InstallationHook) GetInstallationRef() *InstallationRef {
@@ -660,6 +1027,89 @@ func (h *InstallationRepositoryHook) GetInstallationRef() *InstallationRef {
 	}
 }
 
+// NewWebhookWrapper wraps the webhook so it can be marshaled
+// and unmarshaled without knowing its type.
+func NewWebhookWrapper(hook Webhook) (*WebhookWrapper, error) {
+	w := &WebhookWrapper{}
+	switch v := hook.(type) {
+	case *PingHook:
+		w.PingHook = v
+	case *PushHook:
+		w.PushHook = v
+	case *BranchHook:
+		w.BranchHook = v
+	case *CheckRunHook:
+		w.CheckRunHook = v
+	case *CheckSuiteHook:
+		w.CheckSuiteHook = v
+	case *CommitCommentHook:
+		w.CommitCommentHook = v
+	case *DeployHook:
+		w.DeployHook = v
+	case *DeploymentStatusHook:
+		w.DeploymentStatusHook = v
+	case *ForkHook:
+		w.ForkHook = v
+	case *TagHook:
+		w.TagHook = v
+	case *IssueHook:
+		w.IssueHook = v
+	case *IssueCommentHook:
+		w.IssueCommentHook = v
+	case *InstallationHook:
+		w.InstallationHook = v
+	case *InstallationRepositoryHook:
+		w.InstallationRepositoryHook = v
+	case *JobHook:
+		w.JobHook = v
+	case *LabelHook:
+		w.LabelHook = v
+	case *PipelineHook:
+		w.PipelineHook = v
+	case *ReleaseHook:
+		w.ReleaseHook = v
+	case *RepositoryHook:
+		w.RepositoryHook = v
+	case *PullRequestHook:
+		w.PullRequestHook = v
+	case *PullRequestCommentHook:
+		w.PullRequestCommentHook = v
+	case *ReviewHook:
+		w.ReviewHook = v
+	case *ReviewCommentHook:
+		w.ReviewCommentHook = v
+	case *WatchHook:
+		w.WatchHook = v
+	case *StarHook:
+		w.StarHook = v
+	case *WorkflowRunHook:
+		w.WorkflowRunHook = v
+	case *WorkflowJobHook:
+		w.WorkflowJobHook = v
+	case *MergeGroupHook:
+		w.MergeGroupHook = v
+	case *MemberHook:
+		w.MemberHook = v
+	case *MembershipHook:
+		w.MembershipHook = v
+	case *TeamHook:
+		w.TeamHook = v
+	case *OrganizationHook:
+		w.OrganizationHook = v
+	case *MilestoneHook:
+		w.MilestoneHook = v
+	case *PublicHook:
+		w.PublicHook = v
+	case *RepositoryDispatchHook:
+		w.RepositoryDispatchHook = v
+	case *StatusHook:
+		w.StatusHook = v
+	default:
+		return nil, fmt.Errorf("unsupported webhook")
+	}
+	return w, nil
+}
+
 // ToWebhook converts the webhook wrapper to a webhook
 func (h *WebhookWrapper) ToWebhook() (Webhook, error) {
 	if h == nil {
@@ -680,6 +1130,9 @@ func (h *WebhookWrapper) ToWebhook() (Webhook, error) {
 	if h.CheckSuiteHook != nil {
 		return h.CheckSuiteHook, nil
 	}
+	if h.CommitCommentHook != nil {
+		return h.CommitCommentHook, nil
+	}
 	if h.DeployHook != nil {
 		return h.DeployHook, nil
 	}
@@ -704,9 +1157,18 @@ func (h *WebhookWrapper) ToWebhook() (Webhook, error) {
 	if h.InstallationRepositoryHook != nil {
 		return h.InstallationRepositoryHook, nil
 	}
+	if h.JobHook != nil {
+		return h.JobHook, nil
+	}
 	if h.LabelHook != nil {
 		return h.LabelHook, nil
 	}
+	if h.PipelineHook != nil {
+		return h.PipelineHook, nil
+	}
+	if h.ReleaseHook != nil {
+		return h.ReleaseHook, nil
+	}
 	if h.RepositoryHook != nil {
 		return h.RepositoryHook, nil
 	}
@@ -716,6 +1178,9 @@ func (h *WebhookWrapper) ToWebhook() (Webhook, error) {
 	if h.PullRequestCommentHook != nil {
 		return h.PullRequestCommentHook, nil
 	}
+	if h.ReviewHook != nil {
+		return h.ReviewHook, nil
+	}
 	if h.ReviewCommentHook != nil {
 		return h.ReviewCommentHook, nil
 	}
@@ -725,5 +1190,38 @@ func (h *WebhookWrapper) ToWebhook() (Webhook, error) {
 	if h.StarHook != nil {
 		return h.StarHook, nil
 	}
+	if h.WorkflowRunHook != nil {
+		return h.WorkflowRunHook, nil
+	}
+	if h.WorkflowJobHook != nil {
+		return h.WorkflowJobHook, nil
+	}
+	if h.MergeGroupHook != nil {
+		return h.MergeGroupHook, nil
+	}
+	if h.MemberHook != nil {
+		return h.MemberHook, nil
+	}
+	if h.MembershipHook != nil {
+		return h.MembershipHook, nil
+	}
+	if h.TeamHook != nil {
+		return h.TeamHook, nil
+	}
+	if h.OrganizationHook != nil {
+		return h.OrganizationHook, nil
+	}
+	if h.MilestoneHook != nil {
+		return h.MilestoneHook, nil
+	}
+	if h.PublicHook != nil {
+		return h.PublicHook, nil
+	}
+	if h.RepositoryDispatchHook != nil {
+		return h.RepositoryDispatchHook, nil
+	}
+	if h.StatusHook != nil {
+		return h.StatusHook, nil
+	}
 	return nil, fmt.Errorf("unsupported webhook")
 }
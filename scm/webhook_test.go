@@ -20,6 +20,12 @@ func TestWebhookWrapper(t *testing.T) {
 				require.NotNil(t, wh.PushHook, "no push hook for test %s", name)
 			},
 		},
+		{
+			name: "workflow_run.json",
+			verify: func(name string, wh *scm.WebhookWrapper) {
+				require.NotNil(t, wh.WorkflowRunHook, "no workflow run hook for test %s", name)
+			},
+		},
 	}
 
 	dir := filepath.Join("test_data", "webhooks")
@@ -42,3 +48,26 @@ func TestWebhookWrapper(t *testing.T) {
 		require.NotNil(t, hook, "nil wehhook returned")
 	}
 }
+
+func TestNewWebhookWrapper(t *testing.T) {
+	hooks := []scm.Webhook{
+		&scm.PushHook{Ref: "refs/heads/master"},
+		&scm.PullRequestHook{Action: scm.ActionOpen, PullRequest: scm.PullRequest{Number: 1}},
+		&scm.ReviewHook{Action: scm.ActionSubmitted},
+	}
+	for _, hook := range hooks {
+		wh, err := scm.NewWebhookWrapper(hook)
+		require.NoError(t, err, "failed to wrap %s hook", hook.Kind())
+
+		data, err := json.Marshal(wh)
+		require.NoError(t, err, "failed to marshal %s hook", hook.Kind())
+
+		wh = &scm.WebhookWrapper{}
+		err = json.Unmarshal(data, wh)
+		require.NoError(t, err, "failed to unmarshal %s hook", hook.Kind())
+
+		got, err := wh.ToWebhook()
+		require.NoError(t, err, "failed to parse %s hook", hook.Kind())
+		require.Equal(t, hook, got)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "time"
+
+type (
+	// WorkflowRun represents a single run of a workflow,
+	// eg a GitHub Actions workflow run.
+	WorkflowRun struct {
+		ID           int64
+		WorkflowID   int64
+		Name         string
+		DisplayTitle string
+		Path         string
+		Event        string
+		Status       string
+		Conclusion   string
+		RunNumber    int
+		RunAttempt   int
+		HeadBranch   string
+		HeadSha      string
+		PullRequests []int
+		Link         string
+		Actor        User
+		Created      time.Time
+		Updated      time.Time
+		Started      time.Time
+	}
+
+	// WorkflowJob represents a single job of a workflow
+	// run, eg a GitHub Actions workflow job.
+	WorkflowJob struct {
+		ID           int64
+		RunID        int64
+		RunAttempt   int
+		Name         string
+		WorkflowName string
+		HeadBranch   string
+		HeadSha      string
+		Status       string
+		Conclusion   string
+		Labels       []string
+		RunnerName   string
+		Link         string
+		Steps        []WorkflowStep
+		Started      time.Time
+		Completed    time.Time
+	}
+
+	// WorkflowStep represents a step of a workflow job.
+	WorkflowStep struct {
+		Number     int
+		Name       string
+		Status     string
+		Conclusion string
+		Started    time.Time
+		Completed  time.Time
+	}
+
+	// MergeGroup represents a group of pull requests that
+	// are tested together by a merge queue.
+	MergeGroup struct {
+		HeadSha    string
+		HeadRef    string
+		BaseSha    string
+		BaseRef    string
+		HeadCommit Commit
+	}
+)